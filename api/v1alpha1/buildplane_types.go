@@ -26,6 +26,11 @@ type BuildPlaneSpec struct {
 type BuildPlaneStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	// Cluster is the observed state of the build plane cluster
+	// +optional
+	Cluster *ClusterStatus `json:"cluster,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -52,3 +57,11 @@ type BuildPlaneList struct {
 func init() {
 	SchemeBuilder.Register(&BuildPlane{}, &BuildPlaneList{})
 }
+
+func (b *BuildPlane) GetConditions() []metav1.Condition {
+	return b.Status.Conditions
+}
+
+func (b *BuildPlane) SetConditions(conditions []metav1.Condition) {
+	b.Status.Conditions = conditions
+}
@@ -56,6 +56,9 @@ type ComponentProfile struct {
 
 // ComponentReleaseStatus defines the observed state of ComponentRelease.
 type ComponentReleaseStatus struct {
+	// Conditions represent the latest available observations of the ComponentRelease's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -92,6 +95,16 @@ type ComponentReleaseOwner struct {
 	ComponentName string `json:"componentName"`
 }
 
+// GetConditions returns the conditions from the status
+func (r *ComponentRelease) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (r *ComponentRelease) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&ComponentRelease{}, &ComponentReleaseList{})
 }
@@ -31,6 +31,14 @@ type ComponentTypeSpec struct {
 	// At least one resource must be defined with an id matching the workloadType
 	// +kubebuilder:validation:MinItems=1
 	Resources []ResourceTemplate `json:"resources"`
+
+	// HealthChecks declare how the health of resource kinds emitted by this component type is determined.
+	// A health check overrides the built-in health check for the same apiVersion and kind.
+	// +optional
+	// +listType=map
+	// +listMapKey=apiVersion
+	// +listMapKey=kind
+	HealthChecks []HealthCheck `json:"healthChecks,omitempty"`
 }
 
 // ComponentTypeSchema defines the configurable parameters for a component type
@@ -106,6 +114,29 @@ type ResourceTemplate struct {
 	Template *runtime.RawExtension `json:"template"`
 }
 
+// HealthCheck declares a CEL health expression for a resource kind
+type HealthCheck struct {
+	// APIVersion of the resources this health check applies to
+	// Example: "monitoring.coreos.com/v1"
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the resources this health check applies to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Expression is a CEL expression enclosed in ${...} that is evaluated against the live resource,
+	// which is available as "object". It must evaluate to one of the health statuses
+	// (Healthy, Progressing, Degraded, Suspended or Unknown), or to a boolean where true means
+	// Healthy and false means Progressing.
+	// Example: "${has(object.status.ready) && object.status.ready ? 'Healthy' : 'Progressing'}"
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\$\{.+\}$`
+	Expression string `json:"expression"`
+}
+
 // AllowedWorkflow references a Workflow CR that developers can use for this component type.
 type AllowedWorkflow struct {
 	// Name is the name of the Workflow CR
@@ -116,6 +147,16 @@ type AllowedWorkflow struct {
 
 // ComponentTypeStatus defines the observed state of ComponentType.
 type ComponentTypeStatus struct {
+	// ObservedGeneration is the generation of the ComponentType that was last validated
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the ComponentType's state.
+	// The TemplatesValid condition reports the expressions that fail static checking.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -145,3 +186,11 @@ type ComponentTypeList struct {
 func init() {
 	SchemeBuilder.Register(&ComponentType{}, &ComponentTypeList{})
 }
+
+func (ct *ComponentType) GetConditions() []metav1.Condition {
+	return ct.Status.Conditions
+}
+
+func (ct *ComponentType) SetConditions(conditions []metav1.Condition) {
+	ct.Status.Conditions = conditions
+}
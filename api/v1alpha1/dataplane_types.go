@@ -9,9 +9,13 @@ import (
 
 // ValueFrom defines a common pattern for referencing secrets or providing inline values
 type ValueFrom struct {
-	// SecretRef is a reference to a secret containing the value
+	// SecretRef is the name of a secret in the namespace of the referencing resource that contains the value
 	// +optional
 	SecretRef string `json:"secretRef,omitempty"`
+	// Key is the key of the value in the secret referenced by SecretRef.
+	// Defaults to the conventional key of the value, such as ca.crt, tls.crt, tls.key or token.
+	// +optional
+	Key string `json:"key,omitempty"`
 	// Value is the inline value (optional fallback)
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -41,6 +45,56 @@ type KubernetesAuth struct {
 	// BearerToken contains the bearer token authentication configuration
 	// +optional
 	BearerToken *ValueFrom `json:"bearerToken,omitempty"`
+	// Exec obtains credentials by running a client-go credential plugin, such as the token plugins of
+	// managed Kubernetes services
+	// +optional
+	Exec *ExecAuth `json:"exec,omitempty"`
+	// OIDC obtains bearer tokens from an OpenID Connect provider with the client credentials grant
+	// +optional
+	OIDC *OIDCAuth `json:"oidc,omitempty"`
+}
+
+// ExecAuth defines a client-go credential plugin that provides the credentials for the cluster
+type ExecAuth struct {
+	// Command is the credential plugin to run. It must be allowed by the
+	// --cluster-auth-exec-commands flag of the controller.
+	// +kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+	// Args are the arguments passed to the command
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env are additional environment variables set for the command
+	// +optional
+	Env []ExecEnvVar `json:"env,omitempty"`
+	// APIVersion is the version of the client.authentication.k8s.io ExecCredential API used by the plugin
+	// +optional
+	// +kubebuilder:default="client.authentication.k8s.io/v1"
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// ExecEnvVar defines an environment variable of a credential plugin
+type ExecEnvVar struct {
+	// Name of the environment variable
+	Name string `json:"name"`
+	// Value of the environment variable
+	Value string `json:"value"`
+}
+
+// OIDCAuth defines how bearer tokens are obtained from an OpenID Connect provider
+type OIDCAuth struct {
+	// TokenURL is the token endpoint of the OpenID Connect provider
+	// +kubebuilder:validation:Pattern=`^https://`
+	TokenURL string `json:"tokenURL"`
+	// ClientID is the client identifier registered with the provider
+	ClientID string `json:"clientID"`
+	// ClientSecret contains the client secret. The key defaults to client-secret when it is read from a secret.
+	ClientSecret ValueFrom `json:"clientSecret"`
+	// Scopes are the scopes requested for the token
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+	// Audience is the audience requested for the token, when the provider requires one
+	// +optional
+	Audience string `json:"audience,omitempty"`
 }
 
 // MTLSAuth defines certificate-based authentication (mTLS)
@@ -110,12 +164,40 @@ type DataPlaneSpec struct {
 	Observer ObserverAPI `json:"observer,omitempty"`
 }
 
+// ClusterStatus defines the observed state of the Kubernetes cluster of a DataPlane or BuildPlane,
+// as reported by the latest probe of the cluster.
+type ClusterStatus struct {
+	// ServerVersion is the Kubernetes version reported by the API server
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+	// Capabilities lists the capabilities OpenChoreo relies on and whether they are installed in the cluster
+	// +optional
+	Capabilities []ClusterCapabilityStatus `json:"capabilities,omitempty"`
+	// LastProbeTime is the time the cluster was last probed
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+}
+
+// ClusterCapabilityStatus defines whether a capability is installed in a cluster
+type ClusterCapabilityStatus struct {
+	// Name of the capability, such as GatewayAPI or ArgoWorkflows
+	Name string `json:"name"`
+	// Available is set when all the custom resources of the capability are installed
+	Available bool `json:"available"`
+	// MissingResources lists the custom resources of the capability that are not installed
+	// +optional
+	MissingResources []string `json:"missingResources,omitempty"`
+}
+
 // DataPlaneStatus defines the observed state of DataPlane.
 type DataPlaneStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	// Cluster is the observed state of the data plane cluster
+	// +optional
+	Cluster *ClusterStatus `json:"cluster,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,28 @@ type TargetEnvironmentRef struct {
 	// IsManualApprovalRequired indicates if manual approval is needed for promotion
 	// +optional
 	IsManualApprovalRequired bool `json:"isManualApprovalRequired,omitempty"`
+	// Approvers lists the subjects (JWT "sub" claims) allowed to approve promotions to this environment.
+	// Promotions to an environment that requires approval cannot be approved if this list is empty.
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+}
+
+// ApprovalRequired reports whether promotions to the target environment must be approved
+func (t *TargetEnvironmentRef) ApprovalRequired() bool {
+	return t.RequiresApproval || t.IsManualApprovalRequired
+}
+
+// IsApprover reports whether the given subject is allowed to approve promotions to the target environment
+func (t *TargetEnvironmentRef) IsApprover(subject string) bool {
+	if subject == "" {
+		return false
+	}
+	for _, approver := range t.Approvers {
+		if approver == subject {
+			return true
+		}
+	}
+	return false
 }
 
 // PromotionPath defines a path for promoting between environments
@@ -19,6 +19,42 @@ type EnvironmentSpec struct {
 	DataPlaneRef string        `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewayConfig `json:"gateway,omitempty"`
+
+	// GitOps exports the resources deployed to the environment into a Git repository
+	// +optional
+	GitOps *EnvironmentGitOps `json:"gitOps,omitempty"`
+}
+
+// GitOpsMode defines whether the resources exported to Git are also applied by OpenChoreo
+// +kubebuilder:validation:Enum=Mirror;External
+type GitOpsMode string
+
+const (
+	// GitOpsModeMirror applies the resources with the release controller and mirrors them into Git
+	GitOpsModeMirror GitOpsMode = "Mirror"
+	// GitOpsModeExternal leaves applying the resources from Git to an external GitOps tool
+	GitOpsModeExternal GitOpsMode = "External"
+)
+
+// EnvironmentGitOps configures the Git repository that the rendered Releases of an environment are written to.
+// The resources of each component are written as YAML under <org>/<project>/<component>/<env>/,
+// and the directory is removed when the ReleaseBinding of the component is deleted.
+type EnvironmentGitOps struct {
+	// HTTPS or SSH URL of the repository
+	RepoURL string `json:"repoURL"`
+	// Branch to commit into, defaults to the default branch of the repository
+	// +optional
+	Branch string `json:"branch,omitempty"`
+	// Reference to a Secret with the write credentials, with the same keys as the authSecretRef of a GitCommitRequest
+	// +optional
+	AuthSecretRef string `json:"authSecretRef,omitempty"`
+	// Open a pull request for each change instead of committing to the branch
+	// +optional
+	PullRequest *GitPullRequest `json:"pullRequest,omitempty"`
+	// Mode defines who applies the resources to the data plane. Defaults to Mirror.
+	// +kubebuilder:default=Mirror
+	// +optional
+	Mode GitOpsMode `json:"mode,omitempty"`
 }
 
 // EnvironmentStatus defines the observed state of Environment.
@@ -11,6 +11,7 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // GitCommitRequestSpec defines the desired state of GitCommitRequest.
+// +kubebuilder:validation:XValidation:rule="!has(self.pullRequest) || !has(self.pullRequest.headBranch) || !has(self.branch) || self.pullRequest.headBranch != self.branch",message="spec.pullRequest.headBranch must differ from spec.branch"
 type GitCommitRequestSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -24,11 +25,45 @@ type GitCommitRequestSpec struct {
 	// Author information for the commit
 	Author GitCommitAuthor `json:"author,omitempty"`
 	// Reference to a Secret that contains write credentials
-	// data["username"], data["password"] for HTTPS  **or**
-	// data["ssh-privatekey"] for SSH
+	// data["username"], data["password"] or data["token"] for HTTPS  **or**
+	// data["ssh-privatekey"] and data["known_hosts"] for SSH.
+	// data["token"] is also used to open pull requests.
 	AuthSecretRef string `json:"authSecretRef,omitempty"`
 	// Files to create or patch
 	Files []FileEdit `json:"files"`
+	// Open a pull request into the branch instead of pushing the commit to it
+	// +optional
+	PullRequest *GitPullRequest `json:"pullRequest,omitempty"`
+}
+
+// GitProvider is the service hosting a Git repository
+// +kubebuilder:validation:Enum=github;gitlab;gitea
+type GitProvider string
+
+const (
+	GitProviderGitHub GitProvider = "github"
+	GitProviderGitLab GitProvider = "gitlab"
+	GitProviderGitea  GitProvider = "gitea"
+)
+
+// GitPullRequest configures the pull request (merge request on GitLab) that is opened with the commit
+type GitPullRequest struct {
+	// Provider hosting the repository
+	Provider GitProvider `json:"provider"`
+	// Base URL of the API of the provider, e.g. https://api.github.com.
+	// Defaults to the API served by the host of the repository: https://api.github.com for github.com, https://<host>/api/v3 for other GitHub hosts, https://<host>/api/v4 for GitLab and https://<host>/api/v1 for Gitea.
+	// +optional
+	APIURL string `json:"apiURL,omitempty"`
+	// Branch the commit is pushed to, defaults to openchoreo/<name of the GitCommitRequest>.
+	// It must differ from the branch of the pull request. Only branches under openchoreo/ are force-pushed.
+	// +optional
+	HeadBranch string `json:"headBranch,omitempty"`
+	// Title of the pull request, defaults to the commit message
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Description of the pull request
+	// +optional
+	Body string `json:"body,omitempty"`
 }
 
 type GitCommitAuthor struct {
@@ -40,6 +75,7 @@ type FileEdit struct {
 	Path    string `json:"path"`              // path inside repo
 	Content string `json:"content,omitempty"` // full replacement
 	Patch   string `json:"patch,omitempty"`   // optional RFC-6902 JSON patch
+	Delete  bool   `json:"delete,omitempty"`  // remove the file or directory if it exists
 }
 
 // GitCommitRequestStatus defines the observed state of GitCommitRequest.
@@ -50,6 +86,7 @@ type GitCommitRequestStatus struct {
 	Phase          string `json:"phase,omitempty"`          // Pending|Succeeded|Failed
 	ObservedSHA    string `json:"observedSHA,omitempty"`    // last commit SHA
 	ObservedBranch string `json:"observedBranch,omitempty"` // branch we pushed
+	PullRequestURL string `json:"pullRequestURL,omitempty"` // pull request we opened
 	Message        string `json:"message,omitempty"`
 }
 
@@ -0,0 +1,119 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PromotionRequestPhase represents the approval state of a PromotionRequest
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected
+type PromotionRequestPhase string
+
+const (
+	// PromotionRequestPhasePending means the promotion is waiting for an approver
+	PromotionRequestPhasePending PromotionRequestPhase = "Pending"
+	// PromotionRequestPhaseApproved means the promotion was approved and the release was bound to the target environment
+	PromotionRequestPhaseApproved PromotionRequestPhase = "Approved"
+	// PromotionRequestPhaseRejected means the promotion was rejected
+	PromotionRequestPhaseRejected PromotionRequestPhase = "Rejected"
+)
+
+// PromotionRequestSpec defines the desired state of PromotionRequest.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type PromotionRequestSpec struct {
+	// Owner identifies the component and project this PromotionRequest belongs to
+	// +kubebuilder:validation:Required
+	Owner PromotionRequestOwner `json:"owner"`
+
+	// SourceEnvironment is the environment the release is promoted from
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironment is the environment the release is promoted to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// ReleaseName is the ComponentRelease bound to the source environment when the promotion was requested.
+	// This is the release that gets bound to the target environment once the request is approved.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ReleaseName string `json:"releaseName"`
+
+	// RequestedBy is the subject that requested the promotion
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// PromotionRequestOwner identifies the component this PromotionRequest belongs to
+type PromotionRequestOwner struct {
+	// ProjectName is the name of the project that owns this component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// PromotionRequestStatus defines the observed state of PromotionRequest.
+type PromotionRequestStatus struct {
+	// Phase is the approval state of the promotion
+	// +optional
+	Phase PromotionRequestPhase `json:"phase,omitempty"`
+
+	// DecidedBy is the subject that approved or rejected the promotion
+	// +optional
+	DecidedBy string `json:"decidedBy,omitempty"`
+
+	// DecidedAt is the time the promotion was approved or rejected
+	// +optional
+	DecidedAt *metav1.Time `json:"decidedAt,omitempty"`
+
+	// Comment is the optional comment given with the decision
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=promreq;promreqs
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceEnvironment`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetEnvironment`
+// +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.spec.releaseName`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PromotionRequest is the Schema for the promotionrequests API.
+// It represents a request to promote a component into an environment that requires approval.
+type PromotionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PromotionRequestSpec   `json:"spec,omitempty"`
+	Status PromotionRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionRequestList contains a list of PromotionRequest.
+type PromotionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PromotionRequest `json:"items"`
+}
+
+// IsPending reports whether the PromotionRequest is still waiting for a decision
+func (p *PromotionRequest) IsPending() bool {
+	return p.Status.Phase == "" || p.Status.Phase == PromotionRequestPhasePending
+}
+
+func init() {
+	SchemeBuilder.Register(&PromotionRequest{}, &PromotionRequestList{})
+}
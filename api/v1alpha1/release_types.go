@@ -39,8 +39,49 @@ type ReleaseSpec struct {
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
 	// +optional
 	ProgressingInterval *metav1.Duration `json:"progressingInterval,omitempty"`
+
+	// HealthChecks declare CEL health expressions for resource kinds in this release.
+	// They take precedence over the built-in health checks.
+	// +optional
+	HealthChecks []HealthCheck `json:"healthChecks,omitempty"`
+
+	// DriftPolicy controls what happens when resources in the data plane are changed out-of-band.
+	// AutoHeal re-applies the desired state, Report only reports the drift in the status.
+	// Defaults to AutoHeal if not specified.
+	// +kubebuilder:default=AutoHeal
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// ApplyMode defines who applies the resources to the data plane.
+	// Direct applies them with the release controller, External leaves them to an external GitOps tool
+	// and only observes them. Defaults to Direct if not specified.
+	// +kubebuilder:default=Direct
+	// +optional
+	ApplyMode ReleaseApplyMode `json:"applyMode,omitempty"`
 }
 
+// ReleaseApplyMode defines who applies the resources of a Release to the data plane.
+// +kubebuilder:validation:Enum=Direct;External
+type ReleaseApplyMode string
+
+const (
+	// ReleaseApplyModeDirect applies the resources with the release controller.
+	ReleaseApplyModeDirect ReleaseApplyMode = "Direct"
+	// ReleaseApplyModeExternal leaves applying the resources to an external GitOps tool.
+	ReleaseApplyModeExternal ReleaseApplyMode = "External"
+)
+
+// DriftPolicy defines how the release controller reacts to resources that drifted from the desired state.
+// +kubebuilder:validation:Enum=AutoHeal;Report
+type DriftPolicy string
+
+const (
+	// DriftPolicyAutoHeal re-applies drifted resources to restore the desired state.
+	DriftPolicyAutoHeal DriftPolicy = "AutoHeal"
+	// DriftPolicyReport leaves drifted resources untouched and only reports the drift.
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
 // ReleaseStatus defines the observed state of Release.
 type ReleaseStatus struct {
 	// Resources contain the list of resources that have been successfully applied to the data plane
@@ -134,6 +175,33 @@ type ResourceStatus struct {
 	// LastObservedTime stores the last time the status was observed
 	// +optional
 	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// AppliedHash is the hash of the resource as it was last applied to the data plane.
+	// It distinguishes out-of-band changes from changes made to the release itself.
+	// +optional
+	AppliedHash string `json:"appliedHash,omitempty"`
+
+	// Drift describes the out-of-band changes detected on the resource in the data plane.
+	// +optional
+	Drift *ResourceDrift `json:"drift,omitempty"`
+}
+
+// ResourceDrift describes how a live resource in the data plane drifted from the applied resource.
+type ResourceDrift struct {
+	// Fields are the paths of the fields that were changed out-of-band
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+
+	// Deleted indicates that the resource was deleted out-of-band
+	// +optional
+	Deleted bool `json:"deleted,omitempty"`
+
+	// Healed indicates that the drift was reverted by re-applying the resource
+	// +optional
+	Healed bool `json:"healed,omitempty"`
+
+	// DetectedAt is the time the drift was detected
+	DetectedAt metav1.Time `json:"detectedAt"`
 }
 
 // HealthStatus represents the health of a resource
@@ -58,6 +58,58 @@ type ReleaseBindingSpec struct {
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	WorkloadOverrides *WorkloadOverrideTemplateSpec `json:"workloadOverrides,omitempty"`
+
+	// DriftPolicy controls how out-of-band changes to the resources in the data plane are handled.
+	// It is passed on to the Release, which defaults to AutoHeal.
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// RolloutStrategy controls how a newly bound release replaces the running release.
+	// When not specified, the running release is replaced in one go.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// RolloutStrategy defines how a newly bound release is rolled out to the environment
+type RolloutStrategy struct {
+	// Canary rolls out the new release next to the running release and shifts traffic to it in steps
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+}
+
+// CanaryStrategy defines a progressive canary rollout.
+// The new release is rendered as a second workload and receives the weight of each step through
+// the HTTPRoutes of the running release. The rollout is promoted after the last step.
+type CanaryStrategy struct {
+	// Steps are the traffic weights the canary goes through, in order
+	// +kubebuilder:validation:MinItems=1
+	Steps []CanaryStep `json:"steps"`
+
+	// Analysis aborts the rollout when the canary does not meet the given thresholds
+	// +optional
+	Analysis *CanaryAnalysis `json:"analysis,omitempty"`
+}
+
+// CanaryStep defines the traffic weight of the canary for a duration
+type CanaryStep struct {
+	// Weight is the percentage of the traffic routed to the canary
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Pause is how long the canary stays at this step before it is analyzed and moved to the next step
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	Pause metav1.Duration `json:"pause"`
+}
+
+// CanaryAnalysis defines the thresholds the canary is analyzed against at the end of each step.
+// The HTTP metrics of the canary are read from the observer of the data plane.
+type CanaryAnalysis struct {
+	// MaxErrorRate is the highest percentage of failed HTTP requests tolerated for the canary
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxErrorRate int32 `json:"maxErrorRate"`
 }
 
 // ReleaseBindingOwner identifies the component this ReleaseBinding belongs to
@@ -78,6 +130,171 @@ type ReleaseBindingStatus struct {
 	// Conditions represent the latest available observations of the ReleaseBinding's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ReleaseHistory records the ComponentReleases that have been bound to the environment,
+	// ordered from oldest to newest. The last entry is the currently bound release.
+	// The history is bounded; the oldest entries are dropped first.
+	// +optional
+	ReleaseHistory []ReleaseHistoryEntry `json:"releaseHistory,omitempty"`
+
+	// Approvals is the audit trail of approved PromotionRequests that bound a release to the environment,
+	// ordered from oldest to newest. The trail is bounded; the oldest entries are dropped first.
+	// +optional
+	Approvals []PromotionApproval `json:"approvals,omitempty"`
+
+	// Rollout reports the progress of the rollout strategy
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// Connections records the endpoints of other components the workload connects to in the environment.
+	// Components with dependents are reported when they are deleted.
+	// +optional
+	Connections []ConnectionStatus `json:"connections,omitempty"`
+
+	// GitOps reports the export of the Releases into the GitOps repository of the environment
+	// +optional
+	GitOps *GitOpsExportStatus `json:"gitOps,omitempty"`
+}
+
+// GitOpsExportStatus reports the export of the rendered Releases into the GitOps repository
+type GitOpsExportStatus struct {
+	// Path is the directory of the repository the resources are written to
+	Path string `json:"path"`
+
+	// CommitRequest is the name of the GitCommitRequest of the latest export
+	CommitRequest string `json:"commitRequest"`
+
+	// Phase is the phase of the GitCommitRequest of the latest export
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Message describes the outcome of the latest export
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// CommitSHA is the commit of the latest successful export
+	// +optional
+	CommitSHA string `json:"commitSHA,omitempty"`
+
+	// PullRequestURL is the pull request of the latest successful export, when pull requests are enabled
+	// +optional
+	PullRequestURL string `json:"pullRequestURL,omitempty"`
+}
+
+// ConnectionVisibility is the network visibility level through which a connection reaches its endpoint
+type ConnectionVisibility string
+
+const (
+	// ConnectionVisibilityProject reaches the endpoint within the project through the cluster network
+	ConnectionVisibilityProject ConnectionVisibility = "project"
+	// ConnectionVisibilityOrganization reaches the endpoint of another project through the cluster network
+	ConnectionVisibilityOrganization ConnectionVisibility = "organization"
+	// ConnectionVisibilityPublic reaches the endpoint of another project through the public gateway
+	ConnectionVisibilityPublic ConnectionVisibility = "public"
+)
+
+// ConnectionStatus records an API connection of the workload to the endpoint of another component
+type ConnectionStatus struct {
+	// Name is the name of the connection in the workload
+	Name string `json:"name"`
+
+	// ProjectName is the project of the target component
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the target component
+	ComponentName string `json:"componentName"`
+
+	// Endpoint is the name of the target endpoint
+	Endpoint string `json:"endpoint"`
+
+	// Visibility is the network visibility level through which the endpoint is reached
+	// +optional
+	Visibility ConnectionVisibility `json:"visibility,omitempty"`
+
+	// URL is the resolved address of the endpoint in the environment.
+	// It is empty while the connection is not resolved.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// RolloutPhase is the phase of a rollout
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing indicates that the canary is going through the rollout steps
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhaseSucceeded indicates that the bound release is fully rolled out
+	RolloutPhaseSucceeded RolloutPhase = "Succeeded"
+	// RolloutPhaseAborted indicates that the canary failed its analysis and was removed
+	RolloutPhaseAborted RolloutPhase = "Aborted"
+)
+
+// RolloutStatus reports the progress of a rollout
+type RolloutStatus struct {
+	// Phase is the phase of the rollout
+	Phase RolloutPhase `json:"phase"`
+
+	// StableRelease is the name of the ComponentRelease serving the traffic that is not routed to the canary
+	StableRelease string `json:"stableRelease"`
+
+	// CanaryRelease is the name of the ComponentRelease being rolled out
+	// +optional
+	CanaryRelease string `json:"canaryRelease,omitempty"`
+
+	// CurrentStep is the index of the current canary step
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// CurrentWeight is the percentage of the traffic routed to the canary
+	// +optional
+	CurrentWeight int32 `json:"currentWeight,omitempty"`
+
+	// StepStartedAt is the time the current step started
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// ErrorRate is the percentage of failed HTTP requests of the canary observed by the last analysis
+	// +optional
+	ErrorRate string `json:"errorRate,omitempty"`
+
+	// Message is a human readable description of the rollout state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PromotionApproval records who approved a promotion into the environment
+type PromotionApproval struct {
+	// PromotionRequest is the name of the approved PromotionRequest
+	PromotionRequest string `json:"promotionRequest"`
+
+	// ReleaseName is the name of the ComponentRelease that was promoted
+	ReleaseName string `json:"releaseName"`
+
+	// SourceEnvironment is the environment the release was promoted from
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// RequestedBy is the subject that requested the promotion
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// ApprovedBy is the subject that approved the promotion
+	ApprovedBy string `json:"approvedBy"`
+
+	// ApprovedAt is the time the promotion was approved
+	ApprovedAt metav1.Time `json:"approvedAt"`
+
+	// Comment is the optional comment given by the approver
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// ReleaseHistoryEntry records a ComponentRelease that was bound to the environment
+type ReleaseHistoryEntry struct {
+	// ReleaseName is the name of the ComponentRelease that was bound
+	ReleaseName string `json:"releaseName"`
+
+	// BoundAt is the time the controller first observed the release on the binding
+	BoundAt metav1.Time `json:"boundAt"`
 }
 
 // +kubebuilder:object:root=true
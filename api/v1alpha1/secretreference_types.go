@@ -88,6 +88,27 @@ type SecretStoreReference struct {
 
 	// Kind of resource (ExternalSecret, ClusterExternalSecret)
 	Kind string `json:"kind"`
+
+	// Environment in which the SecretReference is used
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// DataPlane where the ExternalSecret was created
+	// +optional
+	DataPlane string `json:"dataPlane,omitempty"`
+
+	// Ready indicates whether the ExternalSecret has synced the secret from the secret store
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// LastRefreshTime is when the ExternalSecret last synced the secret from the secret store
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+
+	// Materialized indicates whether the controller created an ExternalSecret named after the SecretReference
+	// in the namespace. Ready and LastRefreshTime are only tracked for materialized secrets.
+	// +optional
+	Materialized bool `json:"materialized,omitempty"`
 }
 
 // SecretReferenceStatus defines the observed state of SecretReference.
@@ -132,3 +153,11 @@ type SecretReferenceList struct {
 func init() {
 	SchemeBuilder.Register(&SecretReference{}, &SecretReferenceList{})
 }
+
+func (s *SecretReference) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+func (s *SecretReference) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
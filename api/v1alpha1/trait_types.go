@@ -94,8 +94,27 @@ type TraitPatch struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
 	Operations []JSONPatchOperation `json:"operations"`
+
+	// OnTestFailure controls what happens when a test operation fails for a target resource.
+	// Fail aborts rendering, Skip leaves the target unchanged so that the patch only applies
+	// to targets passing the tests. The operations are applied atomically in both cases.
+	// Defaults to Fail if not specified.
+	// +kubebuilder:default=Fail
+	// +optional
+	OnTestFailure PatchTestFailurePolicy `json:"onTestFailure,omitempty"`
 }
 
+// PatchTestFailurePolicy defines how a trait patch reacts to a failed test operation.
+// +kubebuilder:validation:Enum=Fail;Skip
+type PatchTestFailurePolicy string
+
+const (
+	// PatchTestFailurePolicyFail aborts rendering when a test operation fails.
+	PatchTestFailurePolicyFail PatchTestFailurePolicy = "Fail"
+	// PatchTestFailurePolicySkip skips the patch for targets failing a test operation.
+	PatchTestFailurePolicySkip PatchTestFailurePolicy = "Skip"
+)
+
 // PatchTarget specifies which resource to modify
 type PatchTarget struct {
 	// Group is the API group of the resource (e.g., "apps", "batch")
@@ -120,22 +139,33 @@ type PatchTarget struct {
 }
 
 // JSONPatchOperation defines a JSONPatch operation
-// Supports standard operations (add, replace, remove) plus mergeShallow for map overlays
+// Supports the RFC 6902 operations plus mergeShallow and mergeDeep for map overlays
+// +kubebuilder:validation:XValidation:rule="self.op in ['move', 'copy'] ? has(self.from) : !has(self.from)",message="from is required for move and copy operations and not allowed otherwise"
+// +kubebuilder:validation:XValidation:rule="!(self.op in ['remove', 'move', 'copy']) || !has(self.value)",message="value is not allowed for remove, move and copy operations"
 type JSONPatchOperation struct {
 	// Op is the operation type
-	// Standard operations: add, replace, remove (RFC 6902)
-	// OpenChoreo extension: mergeShallow (overlays top-level map keys)
+	// Standard operations: add, replace, remove, move, copy, test (RFC 6902)
+	// OpenChoreo extensions: mergeShallow (overlays top-level map keys),
+	// mergeDeep (merges maps recursively and lists of objects by their name field)
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=add;replace;remove;mergeShallow
+	// +kubebuilder:validation:Enum=add;replace;remove;move;copy;test;mergeShallow;mergeDeep
 	Op string `json:"op"`
 
+	// From is the JSON Pointer to the field to move or copy (for move/copy operations)
+	// Supports the same array filters as Path, but must resolve to a single field
+	// +optional
+	From string `json:"from,omitempty"`
+
 	// Path is the JSON Pointer to the field to modify (RFC 6901)
 	// Supports array filters: /spec/containers/[?(@.name=='app')]/volumeMounts/-
+	// Filters other than equality are CEL predicates with @ bound to the array element:
+	// /spec/containers/[?(@.ports.exists(p, p.containerPort == 8080))]/env/-
 	// +kubebuilder:validation:Required
 	Path string `json:"path"`
 
-	// Value is the value to set (for add/replace/mergeShallow operations)
-	// Not used for remove operations
+	// Value is the value to set (for add/replace/mergeShallow/mergeDeep operations)
+	// or to compare against (for test operations)
+	// Not used for remove, move and copy operations
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Value *runtime.RawExtension `json:"value,omitempty"`
@@ -143,6 +173,16 @@ type JSONPatchOperation struct {
 
 // TraitStatus defines the observed state of Trait.
 type TraitStatus struct {
+	// ObservedGeneration is the generation of the Trait that was last validated
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the Trait's state.
+	// The TemplatesValid condition reports the expressions that fail static checking.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -171,3 +211,11 @@ type TraitList struct {
 func init() {
 	SchemeBuilder.Register(&Trait{}, &TraitList{})
 }
+
+func (t *Trait) GetConditions() []metav1.Condition {
+	return t.Status.Conditions
+}
+
+func (t *Trait) SetConditions(conditions []metav1.Condition) {
+	t.Status.Conditions = conditions
+}
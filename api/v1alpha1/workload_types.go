@@ -71,6 +71,23 @@ type WorkloadEndpoint struct {
 	// This can be used to define the actual API definition of the endpoint that is exposed by the workload.
 	// +optional
 	Schema *Schema `json:"schema,omitempty"`
+
+	// Network visibility levels the endpoint is exposed at, in addition to the project of the component.
+	// Components of other projects can only connect to the endpoint when it is visible to the
+	// organization or to the public.
+	// +optional
+	NetworkVisibilities *WorkloadEndpointVisibility `json:"networkVisibilities,omitempty"`
+}
+
+// WorkloadEndpointVisibility defines the network visibility levels a workload endpoint is exposed at.
+type WorkloadEndpointVisibility struct {
+	// When enabled, the endpoint is accessible to the components of other projects within the same organization.
+	// +optional
+	Organization *bool `json:"organization,omitempty"`
+
+	// When enabled, the endpoint is accessible externally
+	// +optional
+	Public *bool `json:"public,omitempty"`
 }
 
 // Schema defines the API definition for an endpoint.
@@ -79,38 +96,152 @@ type Schema struct {
 	Content string `json:"content,omitempty"`
 }
 
-// WorkloadConnection represents an internal API connection
+// WorkloadConnection represents a connection of the workload to an internal API, a database,
+// a message queue or an external service.
+// +kubebuilder:validation:XValidation:rule="self.type == 'database' ? has(self.database) : !has(self.database)",message="database must be set if and only if type is database"
+// +kubebuilder:validation:XValidation:rule="self.type == 'queue' ? has(self.queue) : !has(self.queue)",message="queue must be set if and only if type is queue"
+// +kubebuilder:validation:XValidation:rule="self.type == 'external' ? has(self.external) : !has(self.external)",message="external must be set if and only if type is external"
 type WorkloadConnection struct {
-	// Type of connection - only "api" for now
+	// Type of connection: api, database, queue or external
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=api
+	// +kubebuilder:validation:Enum=api;database;queue;external
 	Type string `json:"type"`
 
-	// Parameters for connection configuration (dynamic key-value pairs)
+	// Parameters for connection configuration (dynamic key-value pairs).
+	// API connections use the componentName and endpoint parameters to locate the target endpoint,
+	// and the optional projectName parameter to connect to a component of another project.
 	// +optional
 	Params map[string]string `json:"params,omitempty"`
 
+	// Database holds the parameters of a database connection.
+	// +optional
+	Database *DatabaseConnection `json:"database,omitempty"`
+
+	// Queue holds the parameters of a message queue connection.
+	// +optional
+	Queue *QueueConnection `json:"queue,omitempty"`
+
+	// External holds the parameters of a connection to a registered third-party service.
+	// +optional
+	External *ExternalConnection `json:"external,omitempty"`
+
 	// Inject defines how connection details are injected into the workload
 	// +kubebuilder:validation:Required
 	Inject WorkloadConnectionInject `json:"inject"`
 }
 
+// DatabaseConnection defines a connection to a database.
+type DatabaseConnection struct {
+	// Engine of the database, e.g. postgresql or mysql. Used as the scheme of the connection URL.
+	// +optional
+	Engine string `json:"engine,omitempty"`
+
+	// Host name or address of the database server.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port of the database server.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Name of the database.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Credentials of the database user, such as the username and password keys.
+	// +optional
+	Credentials *ConnectionCredentials `json:"credentials,omitempty"`
+}
+
+// QueueConnection defines a connection to a message queue.
+type QueueConnection struct {
+	// BrokerURL is the URL of the message broker, e.g. amqp://rabbitmq:5672 or kafka://kafka:9092.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9+.-]*://.+`
+	BrokerURL string `json:"brokerURL"`
+
+	// Topic or queue name to publish to or consume from.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Topic string `json:"topic"`
+
+	// Credentials for the broker.
+	// +optional
+	Credentials *ConnectionCredentials `json:"credentials,omitempty"`
+}
+
+// ExternalConnection defines a connection to a registered third-party endpoint.
+type ExternalConnection struct {
+	// URL of the external endpoint.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9+.-]*://.+`
+	URL string `json:"url"`
+
+	// Credentials for the external service, such as an API key.
+	// +optional
+	Credentials *ConnectionCredentials `json:"credentials,omitempty"`
+}
+
+// ConnectionCredentials references the SecretReference holding the credentials of a connection.
+// The credentials are injected by the keys of their secrets in the SecretReference.
+type ConnectionCredentials struct {
+	// SecretRef is the name of the SecretReference holding the credentials.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SecretRef string `json:"secretRef"`
+}
+
 // WorkloadConnectionInject defines how connection details are injected
 type WorkloadConnectionInject struct {
 	// Environment variables to inject
-	// +kubebuilder:validation:Required
-	Env []WorkloadConnectionEnvVar `json:"env"`
+	// +optional
+	Env []WorkloadConnectionEnvVar `json:"env,omitempty"`
+
+	// Files to mount
+	// +optional
+	Files []WorkloadConnectionFile `json:"files,omitempty"`
 }
 
 // WorkloadConnectionEnvVar defines an environment variable injection
+// +kubebuilder:validation:XValidation:rule="has(self.value) != has(self.secretKey)",message="exactly one of value or secretKey must be set"
 type WorkloadConnectionEnvVar struct {
 	// Environment variable name
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
 	// Template value using connection properties (e.g., "{{ .url }}")
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// SecretKey injects a secret of the connection credentials, by its key in the SecretReference
+	// (e.g., "password").
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+}
+
+// WorkloadConnectionFile defines a file injection
+// +kubebuilder:validation:XValidation:rule="has(self.value) != has(self.secretKey)",message="exactly one of value or secretKey must be set"
+type WorkloadConnectionFile struct {
+	// The file key/name.
 	// +kubebuilder:validation:Required
-	Value string `json:"value"`
+	Key string `json:"key"`
+
+	// The mount path where the file will be mounted.
+	// +kubebuilder:validation:Required
+	MountPath string `json:"mountPath"`
+
+	// Template content using connection properties (e.g., "{{ .host }}:{{ .port }}")
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// SecretKey mounts a secret of the connection credentials, by its key in the SecretReference
+	// (e.g., "ca.crt").
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
 }
 
 // WorkloadTemplateSpec defines the desired state of Workload.
@@ -158,9 +289,16 @@ const (
 	WorkloadTypeWebApplication WorkloadType = "WebApplication"
 )
 
-// ConnectionTypeAPI represents an API connection type
+// Connection types
 const (
+	// ConnectionTypeAPI represents a connection to an endpoint of another component
 	ConnectionTypeAPI = "api"
+	// ConnectionTypeDatabase represents a database connection
+	ConnectionTypeDatabase = "database"
+	// ConnectionTypeQueue represents a message queue connection
+	ConnectionTypeQueue = "queue"
+	// ConnectionTypeExternal represents a connection to a registered third-party endpoint
+	ConnectionTypeExternal = "external"
 )
 
 // WorkloadStatus defines the observed state of Workload.
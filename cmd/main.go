@@ -4,9 +4,12 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
+	"time"
 
 	// +kubebuilder:scaffold:imports
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -23,6 +26,8 @@ import (
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/controller/api"
 	"github.com/openchoreo/openchoreo/internal/controller/apibinding"
 	"github.com/openchoreo/openchoreo/internal/controller/apiclass"
@@ -65,6 +70,7 @@ import (
 	csisecretv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
+	"github.com/openchoreo/openchoreo/internal/template"
 	"github.com/openchoreo/openchoreo/internal/version"
 	webhookcorev1 "github.com/openchoreo/openchoreo/internal/webhook/v1"
 )
@@ -95,6 +101,12 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var enableLegacyCRDs bool
+	var componentReleaseRetentionLimit int
+	var templateExpressionCostLimit uint64
+	var templateRenderCostLimit uint64
+	var templateEvaluationTimeout time.Duration
+	var clusterAuthExecCommands string
+	var clusterProbeInterval time.Duration
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -108,6 +120,20 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.BoolVar(&enableLegacyCRDs, "enable-legacy-crds", false, // TODO <-- remove me
 		"If set, legacy CRDs will be enabled. This is only for the POC and will be removed in the future.")
+	flag.IntVar(&componentReleaseRetentionLimit, "component-release-retention-limit", 10,
+		"The number of ComponentReleases to retain per component. Older releases that are not referenced "+
+			"by a ReleaseBinding are deleted. Set to 0 to disable pruning.")
+	flag.Uint64Var(&templateExpressionCostLimit, "template-expression-cost-limit", template.DefaultExpressionCostLimit,
+		"The maximum CEL cost of evaluating a single ComponentType or Trait template expression. Set to 0 to disable the limit.")
+	flag.Uint64Var(&templateRenderCostLimit, "template-render-cost-limit", template.DefaultRenderCostLimit,
+		"The maximum total CEL cost of the template expressions evaluated to render a component. Set to 0 to disable the limit.")
+	flag.DurationVar(&templateEvaluationTimeout, "template-evaluation-timeout", 10*time.Second,
+		"The maximum time spent evaluating the templates to render a component. Set to 0 to disable the timeout.")
+	flag.StringVar(&clusterAuthExecCommands, "cluster-auth-exec-commands", "",
+		"Comma separated list of the credential plugin commands that DataPlanes and BuildPlanes may run with exec "+
+			"authentication. Exec authentication is disabled when empty.")
+	flag.DurationVar(&clusterProbeInterval, "cluster-probe-interval", controller.DefaultClusterProbeInterval,
+		"How often the clusters of DataPlanes and BuildPlanes are probed for reachability and installed capabilities.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -116,6 +142,10 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if clusterAuthExecCommands != "" {
+		kubernetesClient.SetAllowedExecCommands(strings.Split(clusterAuthExecCommands, ","))
+	}
+
 	setupLog.Info("starting controller manager", version.GetLogKeyValues()...)
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
@@ -183,6 +213,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The clients of the dataplane and buildplane clusters are shared across the controllers,
+	// and evicted once the DataPlane or BuildPlane is deleted. Secrets holding their credentials are read
+	// from the API server, and only the metadata of secrets is cached to notice when they are rotated.
+	k8sClientMgr := kubernetesClient.NewManager()
+	k8sClientMgr.ReadSecretsWith(mgr.GetAPIReader(), mgr.GetCache())
+	if err := k8sClientMgr.RemoveClientsOnDelete(context.Background(), mgr.GetCache()); err != nil {
+		setupLog.Error(err, "unable to watch the deletion of DataPlanes and BuildPlanes")
+		os.Exit(1)
+	}
+
 	// -----------------------------------------------------------------------------
 	// Setup controllers with the controller manager
 	// -----------------------------------------------------------------------------
@@ -203,15 +243,18 @@ func main() {
 			os.Exit(1)
 		}
 		if err = (&environment.Reconciler{
-			Client: mgr.GetClient(),
-			Scheme: mgr.GetScheme(),
+			Client:       mgr.GetClient(),
+			K8sClientMgr: k8sClientMgr,
+			Scheme:       mgr.GetScheme(),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Environment")
 			os.Exit(1)
 		}
 		if err = (&dataplane.Reconciler{
-			Client: mgr.GetClient(),
-			Scheme: mgr.GetScheme(),
+			Client:        mgr.GetClient(),
+			Scheme:        mgr.GetScheme(),
+			ProbeInterval: clusterProbeInterval,
+			SecretReader:  mgr.GetAPIReader(),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "DataPlane")
 			os.Exit(1)
@@ -238,15 +281,17 @@ func main() {
 			os.Exit(1)
 		}
 		if err = (&deployment.Reconciler{
-			Client: mgr.GetClient(),
-			Scheme: mgr.GetScheme(),
+			Client:       mgr.GetClient(),
+			K8sClientMgr: k8sClientMgr,
+			Scheme:       mgr.GetScheme(),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Deployment")
 			os.Exit(1)
 		}
 		if err = (&endpoint.Reconciler{
-			Client: mgr.GetClient(),
-			Scheme: mgr.GetScheme(),
+			Client:       mgr.GetClient(),
+			K8sClientMgr: k8sClientMgr,
+			Scheme:       mgr.GetScheme(),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Endpoint")
 			os.Exit(1)
@@ -285,13 +330,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Templates are authored by platform teams and evaluated in the reconcile loop,
+	// so their evaluation is bounded by cost limits and a timeout.
+	templateCostLimits := template.CostLimits{
+		PerExpression: templateExpressionCostLimit,
+		PerRender:     templateRenderCostLimit,
+	}
+	pipelineOptions := []componentpipeline.Option{
+		componentpipeline.WithCostLimits(templateCostLimits),
+		componentpipeline.WithEvaluationTimeout(templateEvaluationTimeout),
+	}
+
 	// ComponentDeployment controller
 	// Create a single pipeline instance shared across all reconciliations.
 	// This enables CEL environment caching for better performance (~4x faster after first render).
 	if err = (&componentdeployment.Reconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
-		Pipeline: componentpipeline.NewPipeline(),
+		Pipeline: componentpipeline.NewPipeline(pipelineOptions...),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ComponentDeployment")
 		os.Exit(1)
@@ -308,8 +364,9 @@ func main() {
 
 	// ComponentRelease controller
 	if err = (&componentrelease.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:         mgr.GetClient(),
+		Scheme:         mgr.GetScheme(),
+		RetentionLimit: componentReleaseRetentionLimit,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ComponentRelease")
 		os.Exit(1)
@@ -319,7 +376,7 @@ func main() {
 	if err = (&releasebinding.Reconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
-		Pipeline: componentpipeline.NewPipeline(),
+		Pipeline: componentpipeline.NewPipeline(pipelineOptions...),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ReleaseBinding")
 		os.Exit(1)
@@ -426,8 +483,10 @@ func main() {
 	}
 
 	if err = (&release.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:         mgr.GetClient(),
+		K8sClientMgr:   k8sClientMgr,
+		Scheme:         mgr.GetScheme(),
+		TemplateEngine: template.NewEngineWithOptions(template.WithCostLimits(templateCostLimits)),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Release")
 		os.Exit(1)
@@ -442,31 +501,36 @@ func main() {
 	}
 
 	if err := (&workflowrun.Reconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Pipeline: workflowpipeline.NewPipeline(),
+		Client:       mgr.GetClient(),
+		K8sClientMgr: k8sClientMgr,
+		Scheme:       mgr.GetScheme(),
+		Pipeline:     workflowpipeline.NewPipeline(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "WorkflowRun")
 		os.Exit(1)
 	}
 
 	if err := (&build.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:       mgr.GetClient(),
+		K8sClientMgr: k8sClientMgr,
+		Scheme:       mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Build")
 		os.Exit(1)
 	}
 	if err := (&buildplane.BuildPlaneReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		ProbeInterval: clusterProbeInterval,
+		SecretReader:  mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BuildPlane")
 		os.Exit(1)
 	}
 	if err = (&secretreference.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:       mgr.GetClient(),
+		K8sClientMgr: k8sClientMgr,
+		Scheme:       mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SecretReference")
 		os.Exit(1)
@@ -483,6 +547,18 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Project")
 			os.Exit(1)
 		}
+		if err = webhookcorev1.SetupComponentReleaseWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ComponentRelease")
+			os.Exit(1)
+		}
+		if err = webhookcorev1.SetupComponentWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Component")
+			os.Exit(1)
+		}
+		if err = webhookcorev1.SetupReleaseBindingWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ReleaseBinding")
+			os.Exit(1)
+		}
 	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
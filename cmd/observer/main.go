@@ -72,6 +72,7 @@ func main() {
 
 	// API routes - Logs
 	mux.HandleFunc("POST /api/logs/component/{componentId}", handler.GetComponentLogs)
+	mux.HandleFunc("GET /api/logs/component/{componentId}/stream", handler.StreamComponentLogs)
 	mux.HandleFunc("POST /api/logs/project/{projectId}", handler.GetProjectLogs)
 	mux.HandleFunc("POST /api/logs/gateway", handler.GetGatewayLogs)
 	mux.HandleFunc("POST /api/logs/org/{orgId}", handler.GetOrganizationLogs)
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -21,12 +22,18 @@ import (
 )
 
 var (
-	port = flag.Int("port", 8080, "port http server runs on")
+	port                    = flag.Int("port", 8080, "port http server runs on")
+	clusterAuthExecCommands = flag.String("cluster-auth-exec-commands", "",
+		"comma separated list of the credential plugin commands that build planes may run with exec authentication")
 )
 
 func main() {
 	flag.Parse()
 
+	if *clusterAuthExecCommands != "" {
+		kubernetesClient.SetAllowedExecCommands(strings.Split(*clusterAuthExecCommands, ","))
+	}
+
 	slogHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
 	baseLogger := slog.New(slogHandler)
 	slog.SetDefault(baseLogger)
@@ -0,0 +1,187 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package authtest provides a mock authorization server for testing the login flows of choreoctl.
+package authtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+const (
+	// IssuerPath is the path of the issuer, like most identity providers the issuer is not at the root
+	IssuerPath = "/realms/openchoreo"
+	// DeviceCode and UserCode are issued by the device authorization endpoint
+	DeviceCode = "device-code"
+	UserCode   = "ABCD-EFGH"
+)
+
+// TokenResponse is a response of the token endpoint.
+type TokenResponse struct {
+	Status int
+	Body   map[string]any
+}
+
+// Token returns a successful token response. The refresh token is omitted when it is empty.
+func Token(accessToken, refreshToken string, expiresIn int) TokenResponse {
+	body := map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   expiresIn,
+	}
+	if refreshToken != "" {
+		body["refresh_token"] = refreshToken
+	}
+	return TokenResponse{Status: http.StatusOK, Body: body}
+}
+
+// TokenError returns an error response of the token endpoint (RFC 6749 section 5.2).
+func TokenError(code string) TokenResponse {
+	return TokenResponse{Status: http.StatusBadRequest, Body: map[string]any{"error": code}}
+}
+
+// IdP is a mock authorization server that also serves the protected resource metadata (RFC 9728)
+// of an OpenChoreo API server, so that its URL can be used as the endpoint of the API server.
+//
+// The metadata is served by default at the RFC 8414 and the OpenID Connect discovery locations;
+// the Disable fields remove them to test the fallbacks of the discovery.
+type IdP struct {
+	*httptest.Server
+	Issuer string
+
+	DisableProtectedResourceMetadata   bool
+	DisableAuthorizationServerMetadata bool
+	DisableOpenIDConfiguration         bool
+	// RevocationStatus is the status returned by the revocation endpoint, 200 when it is 0
+	RevocationStatus int
+
+	mu             sync.Mutex
+	tokenResponses []TokenResponse
+	tokenRequests  []url.Values
+	revocations    []url.Values
+}
+
+// NewIdP starts a mock authorization server that is closed when the test ends.
+func NewIdP(t *testing.T) *IdP {
+	t.Helper()
+
+	idp := &IdP{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/oauth-protected-resource", func(w http.ResponseWriter, r *http.Request) {
+		if idp.DisableProtectedResourceMetadata {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"resource":              idp.URL,
+			"authorization_servers": []string{idp.Issuer},
+			"scopes_supported":      []string{"openid", "profile"},
+		})
+	})
+	mux.HandleFunc("/.well-known/oauth-authorization-server"+IssuerPath, func(w http.ResponseWriter, r *http.Request) {
+		if idp.DisableAuthorizationServerMetadata {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, idp.metadata())
+	})
+	mux.HandleFunc(IssuerPath+"/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		if idp.DisableOpenIDConfiguration {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, idp.metadata())
+	})
+	mux.HandleFunc(IssuerPath+"/device", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"device_code":               DeviceCode,
+			"user_code":                 UserCode,
+			"verification_uri":          idp.Issuer + "/device/verify",
+			"verification_uri_complete": idp.Issuer + "/device/verify?user_code=" + UserCode,
+			"expires_in":                60,
+			"interval":                  1,
+		})
+	})
+	mux.HandleFunc(IssuerPath+"/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		idp.mu.Lock()
+		idp.tokenRequests = append(idp.tokenRequests, r.PostForm)
+		response := TokenError("invalid_request")
+		if len(idp.tokenResponses) > 0 {
+			response = idp.tokenResponses[0]
+			// The last response is repeated for the following requests
+			if len(idp.tokenResponses) > 1 {
+				idp.tokenResponses = idp.tokenResponses[1:]
+			}
+		}
+		idp.mu.Unlock()
+		writeJSON(w, response.Status, response.Body)
+	})
+	mux.HandleFunc(IssuerPath+"/revoke", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		idp.mu.Lock()
+		idp.revocations = append(idp.revocations, r.PostForm)
+		idp.mu.Unlock()
+		status := idp.RevocationStatus
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.WriteHeader(status)
+	})
+
+	idp.Server = httptest.NewServer(mux)
+	idp.Issuer = idp.URL + IssuerPath
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+// RespondToToken sets the responses of the token endpoint, which are returned in order. The last
+// response is repeated for the following requests.
+func (idp *IdP) RespondToToken(responses ...TokenResponse) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.tokenResponses = responses
+}
+
+// TokenRequests returns the forms posted to the token endpoint.
+func (idp *IdP) TokenRequests() []url.Values {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return append([]url.Values(nil), idp.tokenRequests...)
+}
+
+// Revocations returns the forms posted to the revocation endpoint.
+func (idp *IdP) Revocations() []url.Values {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return append([]url.Values(nil), idp.revocations...)
+}
+
+func (idp *IdP) metadata() map[string]any {
+	return map[string]any{
+		"issuer":                           idp.Issuer,
+		"authorization_endpoint":           idp.Issuer + "/authorize",
+		"token_endpoint":                   idp.Issuer + "/token",
+		"device_authorization_endpoint":    idp.Issuer + "/device",
+		"revocation_endpoint":              idp.Issuer + "/revoke",
+		"scopes_supported":                 []string{"openid", "profile", "offline_access"},
+		"code_challenge_methods_supported": []string{"S256"},
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
+	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
+)
+
+// ErrNotLoggedIn is returned when the current context has no credentials.
+var ErrNotLoggedIn = errors.New("not logged in")
+
+// NewCredentials returns the credentials to store for a token issued by an authorization server.
+func NewCredentials(clientID string, server *ServerMetadata, token *oauth2.Token) *configContext.Credentials {
+	return &configContext.Credentials{
+		ClientID:           clientID,
+		Issuer:             server.Issuer,
+		TokenEndpoint:      server.TokenEndpoint,
+		RevocationEndpoint: server.RevocationEndpoint,
+		AccessToken:        token.AccessToken,
+		RefreshToken:       token.RefreshToken,
+		TokenType:          token.TokenType,
+		Expiry:             token.Expiry,
+	}
+}
+
+// CurrentContext returns the current context of the stored configuration.
+func CurrentContext(cfg *configContext.StoredConfig) (*configContext.Context, error) {
+	if cfg.CurrentContext == "" {
+		return nil, errors.New("no current context is set")
+	}
+	for i := range cfg.Contexts {
+		if cfg.Contexts[i].Name == cfg.CurrentContext {
+			return &cfg.Contexts[i], nil
+		}
+	}
+	return nil, fmt.Errorf("current context %q not found in config", cfg.CurrentContext)
+}
+
+// AccessToken returns a valid access token of the current context, refreshing it with the refresh
+// token when it has expired. Refreshed tokens are stored back into the context.
+func AccessToken(ctx context.Context, httpClient *http.Client) (string, error) {
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to load config: %w", err)
+	}
+	current, err := CurrentContext(cfg)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrNotLoggedIn, err)
+	}
+	creds := current.Credentials
+	if creds == nil {
+		return "", ErrNotLoggedIn
+	}
+
+	stored := credentialsToken(creds)
+	if stored.Valid() {
+		return stored.AccessToken, nil
+	}
+	if stored.RefreshToken == "" {
+		return "", fmt.Errorf("the session of context %q has expired, run 'choreoctl login' again", current.Name)
+	}
+
+	conf := &oauth2.Config{
+		ClientID: creds.ClientID,
+		Endpoint: oauth2.Endpoint{TokenURL: creds.TokenEndpoint, AuthStyle: oauth2.AuthStyleInParams},
+	}
+	if httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	}
+	token, err := conf.TokenSource(ctx, stored).Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh the session of context %q, run 'choreoctl login' again: %w",
+			current.Name, err)
+	}
+
+	creds.AccessToken = token.AccessToken
+	creds.TokenType = token.TokenType
+	creds.Expiry = token.Expiry
+	// Authorization servers rotating refresh tokens return a new one with every refresh
+	if token.RefreshToken != "" {
+		creds.RefreshToken = token.RefreshToken
+	}
+	if err := config.SaveStoredConfig(cfg); err != nil {
+		return "", fmt.Errorf("failed to save refreshed credentials: %w", err)
+	}
+	return token.AccessToken, nil
+}
+
+// Revoke revokes the tokens of the credentials at the revocation endpoint of the authorization
+// server (RFC 7009). The refresh token is revoked first, which also invalidates the access tokens
+// issued with it on most authorization servers.
+func Revoke(ctx context.Context, httpClient *http.Client, creds *configContext.Credentials) error {
+	if creds.RevocationEndpoint == "" {
+		return fmt.Errorf("authorization server %q does not support token revocation", creds.Issuer)
+	}
+
+	tokens := []struct{ value, hint string }{
+		{creds.RefreshToken, "refresh_token"},
+		{creds.AccessToken, "access_token"},
+	}
+	for _, token := range tokens {
+		if token.value == "" {
+			continue
+		}
+		if err := revokeToken(ctx, httpClient, creds, token.value, token.hint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func revokeToken(ctx context.Context, httpClient *http.Client, creds *configContext.Credentials, token, hint string) error {
+	form := url.Values{
+		"token":           {token},
+		"token_type_hint": {hint},
+		"client_id":       {creds.ClientID},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, creds.RevocationEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create revocation request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to revoke %s: %w", hint, err)
+	}
+	defer resp.Body.Close()
+
+	// Tokens that are already invalid are reported as revoked successfully
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to revoke %s: revocation endpoint returned status %d", hint, resp.StatusCode)
+	}
+	return nil
+}
+
+func credentialsToken(creds *configContext.Credentials) *oauth2.Token {
+	return &oauth2.Token{
+		AccessToken:  creds.AccessToken,
+		RefreshToken: creds.RefreshToken,
+		TokenType:    creds.TokenType,
+		Expiry:       creds.Expiry,
+	}
+}
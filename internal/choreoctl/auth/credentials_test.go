@@ -0,0 +1,197 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth/authtest"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
+	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
+)
+
+// saveCredentials stores a config in a temporary home directory whose current context has the
+// given credentials.
+func saveCredentials(t *testing.T, creds *configContext.Credentials) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	cfg := &configContext.StoredConfig{
+		CurrentContext: "dev",
+		Contexts:       []configContext.Context{{Name: "dev", Credentials: creds}},
+	}
+	if err := config.SaveStoredConfig(cfg); err != nil {
+		t.Fatalf("SaveStoredConfig() error = %v", err)
+	}
+}
+
+func TestAccessToken(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name             string
+		creds            *configContext.Credentials
+		response         authtest.TokenResponse
+		want             string
+		wantRefreshToken string
+		wantRefreshes    int
+		wantErr          string
+	}{
+		{
+			name:             "returns a valid access token",
+			creds:            &configContext.Credentials{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)},
+			want:             "access",
+			wantRefreshToken: "refresh",
+		},
+		{
+			name:             "refreshes an expired access token",
+			creds:            &configContext.Credentials{AccessToken: "old", RefreshToken: "refresh", Expiry: expired},
+			response:         authtest.Token("new", "", 3600),
+			want:             "new",
+			wantRefreshToken: "refresh",
+			wantRefreshes:    1,
+		},
+		{
+			name:             "stores a rotated refresh token",
+			creds:            &configContext.Credentials{AccessToken: "old", RefreshToken: "refresh", Expiry: expired},
+			response:         authtest.Token("new", "rotated", 3600),
+			want:             "new",
+			wantRefreshToken: "rotated",
+			wantRefreshes:    1,
+		},
+		{
+			name:          "fails when the refresh token is rejected",
+			creds:         &configContext.Credentials{AccessToken: "old", RefreshToken: "refresh", Expiry: expired},
+			response:      authtest.TokenError("invalid_grant"),
+			wantRefreshes: 1,
+			wantErr:       "invalid_grant",
+		},
+		{
+			name:    "fails when an expired session cannot be refreshed",
+			creds:   &configContext.Credentials{AccessToken: "old", Expiry: expired},
+			wantErr: "has expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			idp.RespondToToken(tt.response)
+			tt.creds.ClientID = "choreoctl"
+			tt.creds.TokenEndpoint = idp.Issuer + "/token"
+			saveCredentials(t, tt.creds)
+
+			got, err := AccessToken(context.Background(), http.DefaultClient)
+			requests := idp.TokenRequests()
+			if len(requests) != tt.wantRefreshes {
+				t.Fatalf("token requests = %d, want %d", len(requests), tt.wantRefreshes)
+			}
+			for _, form := range requests {
+				if form.Get("grant_type") != "refresh_token" || form.Get("refresh_token") != "refresh" ||
+					form.Get("client_id") != "choreoctl" {
+					t.Errorf("token request = %v, want a refresh of choreoctl", form)
+				}
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AccessToken() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AccessToken() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AccessToken() = %q, want %q", got, tt.want)
+			}
+
+			cfg, err := config.LoadStoredConfig()
+			if err != nil {
+				t.Fatalf("LoadStoredConfig() error = %v", err)
+			}
+			stored := cfg.Contexts[0].Credentials
+			if stored.AccessToken != tt.want || stored.RefreshToken != tt.wantRefreshToken {
+				t.Errorf("stored tokens = %q, %q, want %q, %q",
+					stored.AccessToken, stored.RefreshToken, tt.want, tt.wantRefreshToken)
+			}
+		})
+	}
+}
+
+func TestAccessTokenNotLoggedIn(t *testing.T) {
+	saveCredentials(t, nil)
+	if _, err := AccessToken(context.Background(), http.DefaultClient); !errors.Is(err, ErrNotLoggedIn) {
+		t.Errorf("AccessToken() error = %v, want %v", err, ErrNotLoggedIn)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	tests := []struct {
+		name     string
+		creds    *configContext.Credentials
+		status   int
+		want     []string
+		wantErr  string
+		noServer bool
+	}{
+		{
+			name:  "revokes the refresh token before the access token",
+			creds: &configContext.Credentials{AccessToken: "access", RefreshToken: "refresh"},
+			want:  []string{"refresh_token=refresh", "access_token=access"},
+		},
+		{
+			name:  "revokes only the access token without a refresh token",
+			creds: &configContext.Credentials{AccessToken: "access"},
+			want:  []string{"access_token=access"},
+		},
+		{
+			name:    "reports a failed revocation",
+			creds:   &configContext.Credentials{AccessToken: "access", RefreshToken: "refresh"},
+			status:  http.StatusServiceUnavailable,
+			want:    []string{"refresh_token=refresh"},
+			wantErr: "status 503",
+		},
+		{
+			name:     "fails without a revocation endpoint",
+			creds:    &configContext.Credentials{AccessToken: "access"},
+			noServer: true,
+			wantErr:  "does not support token revocation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			idp.RevocationStatus = tt.status
+			tt.creds.ClientID = "choreoctl"
+			tt.creds.Issuer = idp.Issuer
+			if !tt.noServer {
+				tt.creds.RevocationEndpoint = idp.Issuer + "/revoke"
+			}
+
+			err := Revoke(context.Background(), http.DefaultClient, tt.creds)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Revoke() error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Revoke() error = %v", err)
+			}
+
+			var got []string
+			for _, form := range idp.Revocations() {
+				if form.Get("client_id") != "choreoctl" {
+					t.Errorf("revocation client_id = %q, want choreoctl", form.Get("client_id"))
+				}
+				got = append(got, form.Get("token_type_hint")+"="+form.Get("token"))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("revoked %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	protectedResourceMetadataPath   = "/.well-known/oauth-protected-resource"
+	authorizationServerMetadataPath = "/.well-known/oauth-authorization-server"
+	openIDConfigurationPath         = "/.well-known/openid-configuration"
+)
+
+// ProtectedResourceMetadata is the OAuth 2.0 protected resource metadata (RFC 9728) published by the
+// OpenChoreo API server.
+type ProtectedResourceMetadata struct {
+	Resource             string   `json:"resource"`
+	AuthorizationServers []string `json:"authorization_servers"`
+	ScopesSupported      []string `json:"scopes_supported,omitempty"`
+}
+
+// ServerMetadata is the OAuth 2.0 authorization server metadata (RFC 8414) of the authorization
+// server trusted by the OpenChoreo API server.
+type ServerMetadata struct {
+	Issuer                        string   `json:"issuer"`
+	AuthorizationEndpoint         string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint                 string   `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint   string   `json:"device_authorization_endpoint,omitempty"`
+	RevocationEndpoint            string   `json:"revocation_endpoint,omitempty"`
+	ScopesSupported               []string `json:"scopes_supported,omitempty"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported,omitempty"`
+}
+
+// Discover finds the authorization server of the OpenChoreo API server from its protected resource
+// metadata, and returns the metadata of the authorization server along with the scopes to request.
+func Discover(ctx context.Context, httpClient *http.Client, apiEndpoint string) (*ServerMetadata, []string, error) {
+	resource := &ProtectedResourceMetadata{}
+	resourceURL := strings.TrimSuffix(apiEndpoint, "/") + protectedResourceMetadataPath
+	if err := getJSON(ctx, httpClient, resourceURL, resource); err != nil {
+		return nil, nil, fmt.Errorf("failed to get protected resource metadata: %w", err)
+	}
+	if len(resource.AuthorizationServers) == 0 {
+		return nil, nil, fmt.Errorf("the API server at %s does not advertise an authorization server", apiEndpoint)
+	}
+
+	server, err := discoverServer(ctx, httpClient, resource.AuthorizationServers[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scopes := slices.Clone(resource.ScopesSupported)
+	// Ask for a refresh token when the authorization server issues them through a scope
+	if slices.Contains(server.ScopesSupported, "offline_access") && !slices.Contains(scopes, "offline_access") {
+		scopes = append(scopes, "offline_access")
+	}
+	return server, scopes, nil
+}
+
+// discoverServer fetches the metadata of an authorization server, trying the RFC 8414 well-known
+// location first and falling back to the OpenID Connect discovery document.
+func discoverServer(ctx context.Context, httpClient *http.Client, issuer string) (*ServerMetadata, error) {
+	issuerURL, err := url.Parse(issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authorization server %q: %w", issuer, err)
+	}
+
+	// RFC 8414 inserts the well-known path between the host and the path of the issuer
+	wellKnown := *issuerURL
+	wellKnown.Path = authorizationServerMetadataPath + strings.TrimSuffix(issuerURL.Path, "/")
+	candidates := []string{
+		wellKnown.String(),
+		strings.TrimSuffix(issuer, "/") + openIDConfigurationPath,
+	}
+
+	var errs []string
+	for _, candidate := range candidates {
+		server := &ServerMetadata{}
+		if err := getJSON(ctx, httpClient, candidate, server); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if server.TokenEndpoint == "" {
+			errs = append(errs, fmt.Sprintf("%s does not define a token endpoint", candidate))
+			continue
+		}
+		return server, nil
+	}
+	return nil, fmt.Errorf("failed to discover authorization server %q: %s", issuer, strings.Join(errs, "; "))
+}
+
+func getJSON(ctx context.Context, httpClient *http.Client, target string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", target, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", target, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", target, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth/authtest"
+)
+
+func TestDiscover(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(idp *authtest.IdP)
+		wantErr string
+	}{
+		{
+			name:  "authorization server metadata",
+			setup: func(idp *authtest.IdP) { idp.DisableOpenIDConfiguration = true },
+		},
+		{
+			name:  "falls back to the OpenID configuration",
+			setup: func(idp *authtest.IdP) { idp.DisableAuthorizationServerMetadata = true },
+		},
+		{
+			name: "fails without any authorization server metadata",
+			setup: func(idp *authtest.IdP) {
+				idp.DisableAuthorizationServerMetadata = true
+				idp.DisableOpenIDConfiguration = true
+			},
+			wantErr: "failed to discover authorization server",
+		},
+		{
+			name:    "fails without protected resource metadata",
+			setup:   func(idp *authtest.IdP) { idp.DisableProtectedResourceMetadata = true },
+			wantErr: "failed to get protected resource metadata",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			tt.setup(idp)
+
+			server, scopes, err := Discover(context.Background(), http.DefaultClient, idp.URL+"/")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Discover() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Discover() error = %v", err)
+			}
+			if server.Issuer != idp.Issuer {
+				t.Errorf("Issuer = %q, want %q", server.Issuer, idp.Issuer)
+			}
+			if server.TokenEndpoint != idp.Issuer+"/token" {
+				t.Errorf("TokenEndpoint = %q, want %q", server.TokenEndpoint, idp.Issuer+"/token")
+			}
+			// The scopes of the API server are requested along with offline_access for a refresh token
+			if want := []string{"openid", "profile", "offline_access"}; !slices.Equal(scopes, want) {
+				t.Errorf("scopes = %v, want %v", scopes, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"slices"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	callbackPath = "/callback"
+	// loginTimeout bounds how long the browser flow waits for the user to complete the login
+	loginTimeout = 5 * time.Minute
+)
+
+// Client runs the OAuth 2.0 login flows of choreoctl against an authorization server.
+type Client struct {
+	HTTPClient *http.Client
+	ClientID   string
+	// ClientSecret authenticates confidential clients, it is only used by the client credentials flow
+	ClientSecret string
+	Server       *ServerMetadata
+	Scopes       []string
+	// Out receives the instructions for the user
+	Out io.Writer
+	// OpenBrowser opens a URL in the browser of the user. The URL is only printed when it is nil
+	// or fails, so that the user can open it manually.
+	OpenBrowser func(url string) error
+	// CallbackPort is the loopback port of the browser flow. A random port is used when it is 0.
+	CallbackPort int
+}
+
+// DeviceLogin runs the device authorization grant (RFC 8628). The user completes the login on any
+// device with a browser while choreoctl polls the authorization server for the tokens.
+func (c *Client) DeviceLogin(ctx context.Context) (*oauth2.Token, error) {
+	if c.Server.DeviceAuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("authorization server %q does not support the device authorization flow", c.Server.Issuer)
+	}
+
+	ctx = c.withHTTPClient(ctx)
+	conf := c.config("")
+	deviceAuth, err := conf.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start device authorization: %w", err)
+	}
+
+	verificationURI := deviceAuth.VerificationURI
+	if deviceAuth.VerificationURIComplete != "" {
+		verificationURI = deviceAuth.VerificationURIComplete
+	}
+	fmt.Fprintf(c.Out, "To log in, open %s in a browser and enter the code %s\n",
+		deviceAuth.VerificationURI, deviceAuth.UserCode)
+	c.openBrowser(verificationURI)
+	fmt.Fprintln(c.Out, "Waiting for the login to complete...")
+
+	token, err := conf.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization failed: %w", err)
+	}
+	return token, nil
+}
+
+// BrowserLogin runs the authorization code grant with PKCE (RFC 7636), receiving the authorization
+// code on a loopback redirect URI as recommended for native apps (RFC 8252).
+func (c *Client) BrowserLogin(ctx context.Context) (*oauth2.Token, error) {
+	if c.Server.AuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("authorization server %q does not support the authorization code flow", c.Server.Issuer)
+	}
+	if methods := c.Server.CodeChallengeMethodsSupported; len(methods) > 0 && !slices.Contains(methods, "S256") {
+		return nil, fmt.Errorf("authorization server %q does not support the S256 PKCE code challenge", c.Server.Issuer)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", c.CallbackPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the login callback: %w", err)
+	}
+	redirectURL := fmt.Sprintf("http://%s%s", listener.Addr().String(), callbackPath)
+
+	state, err := randomString()
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+	conf := c.config(redirectURL)
+
+	// The channels are buffered and written without blocking, so that a repeated callback
+	// cannot block its handler once the first result is received
+	codes := make(chan string, 1)
+	errs := make(chan error, 1)
+	fail := func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("state") != state:
+			// Any local process or page can call the loopback address, so a callback that does not belong to
+			// this login is rejected without failing the login, which keeps waiting for the real callback
+			http.Error(w, "Login failed: invalid state", http.StatusBadRequest)
+		case query.Get("error") != "":
+			http.Error(w, "Login failed: "+query.Get("error"), http.StatusBadRequest)
+			fail(fmt.Errorf("authorization failed: %s %s", query.Get("error"), query.Get("error_description")))
+		case query.Get("code") == "":
+			http.Error(w, "Login failed: missing authorization code", http.StatusBadRequest)
+			fail(errors.New("login callback has no authorization code"))
+		default:
+			_, _ = io.WriteString(w, "Login successful. You can close this window and return to choreoctl.")
+			select {
+			case codes <- query.Get("code"):
+			default:
+			}
+		}
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fail(fmt.Errorf("login callback server failed: %w", err))
+		}
+	}()
+	defer func() { _ = server.Close() }()
+
+	authURL := conf.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+	fmt.Fprintf(c.Out, "Open the following URL in your browser to log in if it does not open automatically:\n%s\n", authURL)
+	c.openBrowser(authURL)
+
+	waitCtx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+
+	var code string
+	select {
+	case code = <-codes:
+	case err := <-errs:
+		return nil, err
+	case <-waitCtx.Done():
+		return nil, fmt.Errorf("timed out waiting for the login to complete: %w", waitCtx.Err())
+	}
+
+	token, err := conf.Exchange(c.withHTTPClient(ctx), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange the authorization code: %w", err)
+	}
+	return token, nil
+}
+
+// ClientCredentialsLogin runs the client credentials grant (RFC 6749 section 4.4), which logs in
+// as the client itself without a user, e.g. in CI pipelines. No refresh token is issued, so the
+// login has to be repeated once the access token expires.
+func (c *Client) ClientCredentialsLogin(ctx context.Context) (*oauth2.Token, error) {
+	if c.ClientSecret == "" {
+		return nil, errors.New("the client credentials flow requires a client secret")
+	}
+
+	conf := &clientcredentials.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		TokenURL:     c.Server.TokenEndpoint,
+		Scopes:       c.Scopes,
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	token, err := conf.Token(c.withHTTPClient(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("client credentials authorization failed: %w", err)
+	}
+	return token, nil
+}
+
+func (c *Client) config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID: c.ClientID,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       c.Server.AuthorizationEndpoint,
+			DeviceAuthURL: c.Server.DeviceAuthorizationEndpoint,
+			TokenURL:      c.Server.TokenEndpoint,
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      c.Scopes,
+	}
+}
+
+func (c *Client) withHTTPClient(ctx context.Context) context.Context {
+	if c.HTTPClient == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, c.HTTPClient)
+}
+
+func (c *Client) openBrowser(url string) {
+	if c.OpenBrowser == nil {
+		return
+	}
+	if err := c.OpenBrowser(url); err != nil {
+		fmt.Fprintf(c.Out, "Failed to open a browser, open %s manually\n", url)
+	}
+}
+
+// OpenBrowser opens a URL in the default browser of the platform.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
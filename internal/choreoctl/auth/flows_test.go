@@ -0,0 +1,314 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth/authtest"
+)
+
+// newTestClient returns a client of the mock authorization server.
+func newTestClient(t *testing.T, idp *authtest.IdP) (*Client, *bytes.Buffer) {
+	t.Helper()
+	server, scopes, err := Discover(context.Background(), http.DefaultClient, idp.URL)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	out := &bytes.Buffer{}
+	return &Client{
+		HTTPClient: http.DefaultClient,
+		ClientID:   "choreoctl",
+		Server:     server,
+		Scopes:     scopes,
+		Out:        out,
+	}, out
+}
+
+func TestDeviceLogin(t *testing.T) {
+	// The mock authorization server asks to poll every second, slow_down adds 5 seconds to it
+	tests := []struct {
+		name      string
+		responses []authtest.TokenResponse
+		wantPolls int
+		wantErr   string
+	}{
+		{
+			name: "polls while the authorization is pending",
+			responses: []authtest.TokenResponse{
+				authtest.TokenError("authorization_pending"),
+				authtest.Token("access", "refresh", 3600),
+			},
+			wantPolls: 2,
+		},
+		{
+			name: "slows down when asked to",
+			responses: []authtest.TokenResponse{
+				authtest.TokenError("slow_down"),
+				authtest.Token("access", "refresh", 3600),
+			},
+			wantPolls: 2,
+		},
+		{
+			name:      "stops when the user denies the authorization",
+			responses: []authtest.TokenResponse{authtest.TokenError("access_denied")},
+			wantPolls: 1,
+			wantErr:   "access_denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			idp := authtest.NewIdP(t)
+			idp.RespondToToken(tt.responses...)
+			client, out := newTestClient(t, idp)
+			var opened string
+			client.OpenBrowser = func(url string) error {
+				opened = url
+				return nil
+			}
+
+			token, err := client.DeviceLogin(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DeviceLogin() error = %v, want %q", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("DeviceLogin() error = %v", err)
+				}
+				if token.AccessToken != "access" || token.RefreshToken != "refresh" {
+					t.Errorf("DeviceLogin() = %+v, want the issued tokens", token)
+				}
+			}
+
+			if want := idp.Issuer + "/device/verify?user_code=" + authtest.UserCode; opened != want {
+				t.Errorf("opened %q, want %q", opened, want)
+			}
+			if !strings.Contains(out.String(), authtest.UserCode) {
+				t.Errorf("output %q does not show the user code", out.String())
+			}
+			requests := idp.TokenRequests()
+			if len(requests) != tt.wantPolls {
+				t.Fatalf("token requests = %d, want %d", len(requests), tt.wantPolls)
+			}
+			for _, form := range requests {
+				if form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:device_code" ||
+					form.Get("device_code") != authtest.DeviceCode || form.Get("client_id") != "choreoctl" {
+					t.Errorf("token request = %v, want a device code grant of choreoctl", form)
+				}
+			}
+		})
+	}
+}
+
+func TestDeviceLoginUnsupported(t *testing.T) {
+	client := &Client{Server: &ServerMetadata{Issuer: "https://idp.example.com"}}
+	if _, err := client.DeviceLogin(context.Background()); err == nil ||
+		!strings.Contains(err.Error(), "does not support the device authorization flow") {
+		t.Errorf("DeviceLogin() error = %v, want an unsupported flow error", err)
+	}
+}
+
+func TestBrowserLogin(t *testing.T) {
+	tests := []struct {
+		name string
+		// forged holds the queries of callbacks that do not belong to the login, sent before the redirect
+		forged []url.Values
+		// callback returns the query of the redirect to the loopback callback for an authorization request
+		callback   func(authorization url.Values) url.Values
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name: "exchanges the authorization code with the PKCE verifier",
+			callback: func(authorization url.Values) url.Values {
+				return url.Values{"code": {"auth-code"}, "state": {authorization.Get("state")}}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "ignores callbacks with another state or without a state",
+			forged: []url.Values{
+				{"code": {"forged-code"}, "state": {"forged"}},
+				{"code": {"forged-code"}},
+				{"error": {"access_denied"}, "state": {"forged"}},
+			},
+			callback: func(authorization url.Values) url.Values {
+				return url.Values{"code": {"auth-code"}, "state": {authorization.Get("state")}}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "reports an authorization error",
+			callback: func(authorization url.Values) url.Values {
+				return url.Values{"error": {"access_denied"}, "state": {authorization.Get("state")}}
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "authorization failed: access_denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			idp.RespondToToken(authtest.Token("access", "refresh", 3600))
+			client, _ := newTestClient(t, idp)
+
+			// The browser follows the authorization URL and is redirected back to the loopback callback
+			var authorization url.Values
+			var status int
+			client.OpenBrowser = func(target string) error {
+				authURL, err := url.Parse(target)
+				if err != nil {
+					return err
+				}
+				authorization = authURL.Query()
+				call := func(query url.Values) (int, error) {
+					redirect := authorization.Get("redirect_uri") + "?" + query.Encode()
+					resp, err := http.Get(redirect) //nolint:gosec // the redirect URI is the loopback callback
+					if err != nil {
+						return 0, err
+					}
+					defer resp.Body.Close()
+					return resp.StatusCode, nil
+				}
+				for _, query := range tt.forged {
+					forgedStatus, err := call(query)
+					if err != nil {
+						return err
+					}
+					if forgedStatus != http.StatusBadRequest {
+						t.Errorf("forged callback %v status = %d, want %d", query, forgedStatus, http.StatusBadRequest)
+					}
+				}
+				status, err = call(tt.callback(authorization))
+				return err
+			}
+
+			token, err := client.BrowserLogin(context.Background())
+			if status != tt.wantStatus {
+				t.Errorf("callback status = %d, want %d", status, tt.wantStatus)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("BrowserLogin() error = %v, want %q", err, tt.wantErr)
+				}
+				if len(idp.TokenRequests()) != 0 {
+					t.Errorf("the authorization code was exchanged after a failed callback")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BrowserLogin() error = %v", err)
+			}
+			if token.AccessToken != "access" {
+				t.Errorf("BrowserLogin() = %+v, want the issued tokens", token)
+			}
+
+			if !strings.HasPrefix(authorization.Get("redirect_uri"), "http://127.0.0.1:") {
+				t.Errorf("redirect_uri = %q, want a loopback address", authorization.Get("redirect_uri"))
+			}
+			if authorization.Get("code_challenge_method") != "S256" {
+				t.Errorf("code_challenge_method = %q, want S256", authorization.Get("code_challenge_method"))
+			}
+			requests := idp.TokenRequests()
+			if len(requests) != 1 {
+				t.Fatalf("token requests = %d, want 1", len(requests))
+			}
+			form := requests[0]
+			if form.Get("grant_type") != "authorization_code" || form.Get("code") != "auth-code" ||
+				form.Get("redirect_uri") != authorization.Get("redirect_uri") {
+				t.Errorf("token request = %v, want the exchange of the authorization code", form)
+			}
+			digest := sha256.Sum256([]byte(form.Get("code_verifier")))
+			if challenge := base64.RawURLEncoding.EncodeToString(digest[:]); challenge != authorization.Get("code_challenge") {
+				t.Errorf("code_verifier does not match the code_challenge %q", authorization.Get("code_challenge"))
+			}
+		})
+	}
+}
+
+func TestBrowserLoginRequiresS256(t *testing.T) {
+	client := &Client{Server: &ServerMetadata{
+		Issuer:                        "https://idp.example.com",
+		AuthorizationEndpoint:         "https://idp.example.com/authorize",
+		CodeChallengeMethodsSupported: []string{"plain"},
+	}}
+	if _, err := client.BrowserLogin(context.Background()); err == nil || !strings.Contains(err.Error(), "S256") {
+		t.Errorf("BrowserLogin() error = %v, want an unsupported code challenge error", err)
+	}
+}
+
+func TestClientCredentialsLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		secret   string
+		response authtest.TokenResponse
+		wantErr  string
+	}{
+		{
+			name:     "issues a token to the client",
+			secret:   "s3cret",
+			response: authtest.Token("access", "", 300),
+		},
+		{
+			name:     "reports rejected client credentials",
+			secret:   "wrong",
+			response: authtest.TokenError("invalid_client"),
+			wantErr:  "invalid_client",
+		},
+		{
+			name:    "requires a client secret",
+			wantErr: "requires a client secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			idp.RespondToToken(tt.response)
+			client, _ := newTestClient(t, idp)
+			client.ClientID = "ci"
+			client.ClientSecret = tt.secret
+
+			token, err := client.ClientCredentialsLogin(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ClientCredentialsLogin() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ClientCredentialsLogin() error = %v", err)
+			}
+			if token.AccessToken != "access" || token.RefreshToken != "" {
+				t.Errorf("ClientCredentialsLogin() = %+v, want an access token only", token)
+			}
+
+			requests := idp.TokenRequests()
+			if len(requests) != 1 {
+				t.Fatalf("token requests = %d, want 1", len(requests))
+			}
+			want := url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {"ci"},
+				"client_secret": {"s3cret"},
+				"scope":         {"openid profile offline_access"},
+			}
+			if got := requests[0]; fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("token request = %v, want %v", got, want)
+			}
+		})
+	}
+}
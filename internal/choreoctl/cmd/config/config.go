@@ -96,6 +96,9 @@ func (c *ConfigContextImpl) GetCurrentContext() error {
 		{"Environment", formatValueOrPlaceholder(currentCtx.Environment)},
 		{"Data Plane", formatValueOrPlaceholder(currentCtx.DataPlane)},
 	}
+	if creds := currentCtx.Credentials; creds != nil {
+		rows = append(rows, []string{"Logged In To", formatValueOrPlaceholder(creds.Issuer)})
+	}
 
 	if err := printTable(headers, rows); err != nil {
 		return err
@@ -0,0 +1,121 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+type DiffImpl struct{}
+
+func NewDiffImpl() *DiffImpl {
+	return &DiffImpl{}
+}
+
+// Diff asks the API server to render two component releases for an environment
+// and prints the differences between the rendered resources.
+func (i *DiffImpl) Diff(params api.DiffParams) error {
+	if err := validation.ValidateParams(validation.CmdDiff, validation.ResourceDiff, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	diff, err := apiClient.DiffComponentReleases(ctx, params.Organization, params.Project, params.Component,
+		params.Release, params.AgainstRelease, params.Environment)
+	if err != nil {
+		return err
+	}
+
+	return printDiff(os.Stdout, diff, params.OutputFormat)
+}
+
+func printDiff(w io.Writer, diff *client.ComponentReleaseDiff, format string) error {
+	switch format {
+	case outputJSON:
+		out, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal diff: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	case outputYAML:
+		out, err := yaml.Marshal(diff)
+		if err != nil {
+			return fmt.Errorf("failed to marshal diff: %w", err)
+		}
+		_, err = w.Write(out)
+		return err
+	default:
+		return printDiffText(w, diff)
+	}
+}
+
+// printDiffText prints the diff in a human readable form:
+// "+" marks added resources, "-" removed resources and "~" changed resources.
+func printDiffText(w io.Writer, diff *client.ComponentReleaseDiff) error {
+	fmt.Fprintf(w, "Release %s against %s in environment %s\n", diff.ReleaseName, diff.AgainstRelease, diff.Environment)
+	fmt.Fprintf(w, "%d added, %d removed, %d changed, %d unchanged\n",
+		diff.Summary.Added, diff.Summary.Removed, diff.Summary.Changed, diff.Summary.Unchanged)
+
+	for _, resource := range diff.Resources {
+		marker := "~"
+		switch resource.Change {
+		case "added":
+			marker = "+"
+		case "removed":
+			marker = "-"
+		}
+
+		name := resource.Name
+		if resource.Namespace != "" {
+			name = resource.Namespace + "/" + resource.Name
+		}
+		fmt.Fprintf(w, "\n%s %s %s (%s)\n", marker, resource.Kind, name, resource.APIVersion)
+
+		for _, change := range resource.FieldChanges {
+			switch {
+			case change.Old == nil:
+				fmt.Fprintf(w, "    + %s: %s\n", change.Path, formatValue(change.New))
+			case change.New == nil:
+				fmt.Fprintf(w, "    - %s: %s\n", change.Path, formatValue(change.Old))
+			default:
+				fmt.Fprintf(w, "    ~ %s: %s -> %s\n", change.Path, formatValue(change.Old), formatValue(change.New))
+			}
+		}
+	}
+	return nil
+}
+
+// formatValue renders a field value as compact JSON so that strings, numbers and
+// nested objects can be told apart.
+func formatValue(value interface{}) string {
+	out, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(out)
+}
@@ -4,11 +4,25 @@
 package login
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"time"
 
+	"golang.org/x/oauth2"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
 
+// defaultClientID is the OAuth2 client ID of choreoctl, which is a public client.
+const defaultClientID = "choreoctl"
+
+// openBrowser opens the login pages of the browser and device flows, it is replaced in tests.
+var openBrowser = auth.OpenBrowser
+
 type AuthImpl struct{}
 
 var _ api.LoginAPI = &AuthImpl{}
@@ -17,14 +31,88 @@ func NewAuthImpl() *AuthImpl {
 	return &AuthImpl{}
 }
 
+// Login discovers the authorization server of the configured OpenChoreo API server, logs in with
+// the device authorization flow or the browser flow, and stores the tokens in the current context.
+// When a client secret is set through CHOREO_CLIENT_SECRET, the client logs in as itself with the
+// client credentials flow instead.
 func (i *AuthImpl) Login(params api.LoginParams) error {
-	return fmt.Errorf("login functionality is not supported")
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.ControlPlane == nil || cfg.ControlPlane.Endpoint == "" {
+		return fmt.Errorf("no control plane configured\n" +
+			"hint: use 'choreoctl config set-control-plane --endpoint <url>' to configure the API server")
+	}
+	current, err := auth.CurrentContext(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	server, scopes, err := auth.Discover(ctx, httpClient, cfg.ControlPlane.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	clientID := params.ClientID
+	if clientID == "" {
+		clientID = getEnvOrDefault("CHOREO_CLIENT_ID", defaultClientID)
+	}
+	client := &auth.Client{
+		HTTPClient:   httpClient,
+		ClientID:     clientID,
+		ClientSecret: os.Getenv("CHOREO_CLIENT_SECRET"),
+		Server:       server,
+		Scopes:       scopes,
+		Out:          os.Stdout,
+		OpenBrowser:  openBrowser,
+	}
+
+	var token *oauth2.Token
+	switch {
+	case client.ClientSecret != "":
+		token, err = client.ClientCredentialsLogin(ctx)
+	case params.DeviceCode:
+		token, err = client.DeviceLogin(ctx)
+	default:
+		token, err = client.BrowserLogin(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+
+	current.Credentials = auth.NewCredentials(clientID, server, token)
+	if err := config.SaveStoredConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save credentials: %w", err)
+	}
+
+	fmt.Printf("✓ Logged in to %s with context %q\n", cfg.ControlPlane.Endpoint, current.Name)
+	return nil
 }
 
+// IsLoggedIn reports whether the current context has credentials that are valid or can be refreshed.
 func (i *AuthImpl) IsLoggedIn() bool {
-	return false
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return false
+	}
+	current, err := auth.CurrentContext(cfg)
+	if err != nil || current.Credentials == nil {
+		return false
+	}
+	creds := current.Credentials
+	return creds.RefreshToken != "" || creds.Expiry.IsZero() || time.Now().Before(creds.Expiry)
 }
 
 func (i *AuthImpl) GetLoginPrompt() string {
-	return "login functionality is not supported"
+	return "You are not logged in. Use 'choreoctl login' to log in to OpenChoreo"
+}
+
+func getEnvOrDefault(envVar, defaultValue string) string {
+	if value := os.Getenv(envVar); value != "" {
+		return value
+	}
+	return defaultValue
 }
@@ -0,0 +1,116 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package login
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth/authtest"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
+	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// saveConfig stores a config in a temporary home directory with the given control plane and a
+// current context that is not logged in.
+func saveConfig(t *testing.T, controlPlane *configContext.ControlPlane) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	cfg := &configContext.StoredConfig{
+		CurrentContext: "dev",
+		ControlPlane:   controlPlane,
+		Contexts:       []configContext.Context{{Name: "dev"}},
+	}
+	if err := config.SaveStoredConfig(cfg); err != nil {
+		t.Fatalf("SaveStoredConfig() error = %v", err)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        api.LoginParams
+		clientSecret  string
+		wantClientID  string
+		wantGrantType string
+		wantOpened    bool
+	}{
+		{
+			name:          "client credentials",
+			params:        api.LoginParams{ClientID: "ci"},
+			clientSecret:  "s3cret",
+			wantClientID:  "ci",
+			wantGrantType: "client_credentials",
+		},
+		{
+			name:          "device code",
+			params:        api.LoginParams{DeviceCode: true},
+			wantClientID:  defaultClientID,
+			wantGrantType: "urn:ietf:params:oauth:grant-type:device_code",
+			wantOpened:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			idp.RespondToToken(authtest.Token("access", "refresh", 3600))
+			saveConfig(t, &configContext.ControlPlane{Type: "remote", Endpoint: idp.URL})
+			t.Setenv("CHOREO_CLIENT_ID", "")
+			t.Setenv("CHOREO_CLIENT_SECRET", tt.clientSecret)
+			opened := false
+			previous := openBrowser
+			openBrowser = func(string) error {
+				opened = true
+				return nil
+			}
+			t.Cleanup(func() { openBrowser = previous })
+
+			impl := NewAuthImpl()
+			if impl.IsLoggedIn() {
+				t.Fatal("IsLoggedIn() = true before logging in")
+			}
+			if err := impl.Login(tt.params); err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+			if !impl.IsLoggedIn() {
+				t.Error("IsLoggedIn() = false after logging in")
+			}
+			if opened != tt.wantOpened {
+				t.Errorf("opened a browser = %v, want %v", opened, tt.wantOpened)
+			}
+
+			requests := idp.TokenRequests()
+			if len(requests) != 1 || requests[0].Get("grant_type") != tt.wantGrantType {
+				t.Errorf("token requests = %v, want a single %s grant", requests, tt.wantGrantType)
+			}
+
+			cfg, err := config.LoadStoredConfig()
+			if err != nil {
+				t.Fatalf("LoadStoredConfig() error = %v", err)
+			}
+			creds := cfg.Contexts[0].Credentials
+			if creds == nil {
+				t.Fatal("no credentials stored in the current context")
+			}
+			if creds.ClientID != tt.wantClientID || creds.Issuer != idp.Issuer ||
+				creds.TokenEndpoint != idp.Issuer+"/token" || creds.RevocationEndpoint != idp.Issuer+"/revoke" {
+				t.Errorf("stored credentials = %+v, want those of client %q at the mock authorization server",
+					creds, tt.wantClientID)
+			}
+			if creds.AccessToken != "access" || creds.RefreshToken != "refresh" {
+				t.Errorf("stored tokens = %q, %q, want the issued tokens", creds.AccessToken, creds.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestLoginWithoutControlPlane(t *testing.T) {
+	saveConfig(t, nil)
+	if err := NewAuthImpl().Login(api.LoginParams{}); err == nil ||
+		!strings.Contains(err.Error(), "no control plane configured") {
+		t.Errorf("Login() error = %v, want a missing control plane error", err)
+	}
+}
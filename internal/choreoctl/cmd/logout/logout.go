@@ -4,7 +4,13 @@
 package logout
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
 )
 
 type LogoutImpl struct{}
@@ -13,6 +19,32 @@ func NewLogoutImpl() *LogoutImpl {
 	return &LogoutImpl{}
 }
 
+// Logout revokes the tokens of the current context and removes them from the config. The tokens
+// are removed even when the authorization server cannot revoke them.
 func (i *LogoutImpl) Logout() error {
-	return fmt.Errorf("logout functionality is not supported")
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	current, err := auth.CurrentContext(cfg)
+	if err != nil {
+		return err
+	}
+	if current.Credentials == nil {
+		fmt.Printf("Context %q is not logged in\n", current.Name)
+		return nil
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	if err := auth.Revoke(context.Background(), httpClient, current.Credentials); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+
+	current.Credentials = nil
+	if err := config.SaveStoredConfig(cfg); err != nil {
+		return fmt.Errorf("failed to remove credentials: %w", err)
+	}
+
+	fmt.Printf("✓ Logged out of context %q\n", current.Name)
+	return nil
 }
@@ -0,0 +1,71 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logout
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth/authtest"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
+	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
+)
+
+func TestLogout(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		wantRevocations int
+	}{
+		{
+			name:            "revokes and removes the tokens",
+			wantRevocations: 2,
+		},
+		{
+			name:            "removes the tokens when they cannot be revoked",
+			status:          http.StatusInternalServerError,
+			wantRevocations: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := authtest.NewIdP(t)
+			idp.RevocationStatus = tt.status
+			t.Setenv("HOME", t.TempDir())
+			cfg := &configContext.StoredConfig{
+				CurrentContext: "dev",
+				Contexts: []configContext.Context{{
+					Name: "dev",
+					Credentials: &configContext.Credentials{
+						ClientID:           "choreoctl",
+						Issuer:             idp.Issuer,
+						TokenEndpoint:      idp.Issuer + "/token",
+						RevocationEndpoint: idp.Issuer + "/revoke",
+						AccessToken:        "access",
+						RefreshToken:       "refresh",
+					},
+				}},
+			}
+			if err := config.SaveStoredConfig(cfg); err != nil {
+				t.Fatalf("SaveStoredConfig() error = %v", err)
+			}
+
+			if err := NewLogoutImpl().Logout(); err != nil {
+				t.Fatalf("Logout() error = %v", err)
+			}
+
+			if got := len(idp.Revocations()); got != tt.wantRevocations {
+				t.Errorf("revocations = %d, want %d", got, tt.wantRevocations)
+			}
+			cfg, err := config.LoadStoredConfig()
+			if err != nil {
+				t.Fatalf("LoadStoredConfig() error = %v", err)
+			}
+			if creds := cfg.Contexts[0].Credentials; creds != nil {
+				t.Errorf("credentials = %+v, want them removed", creds)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const (
+	// streamIdleTimeout is how long a log stream may stay silent before it is reconnected. The observer
+	// sends heartbeats well within it while there are no new logs.
+	streamIdleTimeout = time.Minute
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+	// maxEventSize bounds the size of a single log event read from the stream
+	maxEventSize = 1024 * 1024
+)
+
+// followDeploymentLogs streams the runtime logs of a component in an environment from the observer of
+// its data plane until interrupted, reconnecting from the last received log when the stream drops.
+func followDeploymentLogs(params api.LogParams) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	component, err := apiClient.GetComponent(ctx, params.Organization, params.Project, params.Component)
+	if err != nil {
+		return err
+	}
+	environment, err := apiClient.GetEnvironment(ctx, params.Organization, params.Environment)
+	if err != nil {
+		return err
+	}
+	observer, err := apiClient.GetComponentObserverURL(ctx, params.Organization, params.Project, params.Component, params.Environment)
+	if err != nil {
+		return err
+	}
+	if observer.ObserverURL == "" {
+		return fmt.Errorf("logs of component '%s' are not available in environment '%s': %s",
+			params.Component, params.Environment, observer.Message)
+	}
+
+	query := url.Values{}
+	query.Set("environmentId", environment.UID)
+	query.Set("tailLines", strconv.FormatInt(params.TailLines, 10))
+	follower := &logFollower{
+		url: fmt.Sprintf("%s/api/logs/component/%s/stream?%s",
+			strings.TrimSuffix(observer.ObserverURL, "/"), url.PathEscape(component.UID), query.Encode()),
+		httpClient:     &http.Client{},
+		out:            os.Stdout,
+		errOut:         os.Stderr,
+		reconnectDelay: minReconnectDelay,
+	}
+	if cm := observer.ConnectionMethod; cm != nil && cm.Type == "basic" {
+		follower.username = cm.Username
+		follower.password = cm.Password
+	}
+
+	return follower.run(ctx)
+}
+
+// logFollower consumes the Server-Sent Events log stream of the observer
+type logFollower struct {
+	url        string
+	username   string
+	password   string
+	httpClient *http.Client
+	out        io.Writer
+	errOut     io.Writer
+
+	// lastEventID is the cursor of the last received log, which resumes the stream after it
+	lastEventID    string
+	reconnectDelay time.Duration
+
+	// after replaces time.After while waiting to reconnect in tests
+	after func(d time.Duration) <-chan time.Time
+}
+
+// streamEvent is a single Server-Sent Event
+type streamEvent struct {
+	name string
+	id   string
+	data []string
+}
+
+// streamedLog holds the fields of a streamed log entry printed by choreoctl
+type streamedLog struct {
+	Log string `json:"log"`
+}
+
+// streamError is the payload of an error event of the stream
+type streamError struct {
+	Message string `json:"message"`
+}
+
+// permanentError marks a failure that reconnecting does not resolve
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (f *logFollower) run(ctx context.Context) error {
+	after := f.after
+	if after == nil {
+		after = time.After
+	}
+
+	delay := f.reconnectDelay
+	for {
+		connected, err := f.stream(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return permanent.err
+		}
+
+		// Back off while the observer is unreachable, and start over once a stream was established
+		if connected {
+			delay = f.reconnectDelay
+		}
+		if err == nil {
+			err = errors.New("stream closed by the observer")
+		}
+		fmt.Fprintf(f.errOut, "Log stream interrupted: %v. Reconnecting in %s...\n", err, delay)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-after(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// stream reads the log stream until it ends, reporting whether the connection was established
+func (f *logFollower) stream(ctx context.Context) (bool, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Reconnect streams that stopped sending heartbeats, as the connection is likely lost
+	idle := time.AfterFunc(streamIdleTimeout, cancel)
+	defer idle.Stop()
+
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return false, &permanentError{fmt.Errorf("failed to create log stream request: %w", err)}
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if f.lastEventID != "" {
+		req.Header.Set("Last-Event-ID", f.lastEventID)
+	}
+	if f.username != "" {
+		req.SetBasicAuth(f.username, f.password)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to the observer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err := fmt.Errorf("observer returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return false, &permanentError{err}
+		}
+		return false, err
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+	event := &streamEvent{}
+	for scanner.Scan() {
+		idle.Reset(streamIdleTimeout)
+
+		line := scanner.Text()
+		if line == "" {
+			f.dispatch(event)
+			event = &streamEvent{}
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			// Comments such as heartbeats only keep the connection alive
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event.name = value
+		case "id":
+			event.id = value
+		case "data":
+			event.data = append(event.data, value)
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms > 0 {
+				f.reconnectDelay = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	return true, scanner.Err()
+}
+
+func (f *logFollower) dispatch(event *streamEvent) {
+	if len(event.data) == 0 {
+		return
+	}
+	data := []byte(strings.Join(event.data, "\n"))
+
+	switch event.name {
+	case "log":
+		var entry streamedLog
+		if err := json.Unmarshal(data, &entry); err != nil {
+			fmt.Fprintf(f.errOut, "Skipping malformed log event: %v\n", err)
+			return
+		}
+		fmt.Fprintln(f.out, strings.TrimRight(entry.Log, "\r\n"))
+	case "error":
+		var streamErr streamError
+		if err := json.Unmarshal(data, &streamErr); err != nil || streamErr.Message == "" {
+			streamErr.Message = string(data)
+		}
+		fmt.Fprintf(f.errOut, "Observer error: %s\n", streamErr.Message)
+	}
+
+	if event.id != "" {
+		f.lastEventID = event.id
+	}
+}
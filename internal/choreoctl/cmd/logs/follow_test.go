@@ -0,0 +1,147 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestFollower returns a follower of the server that records the delays it waits before reconnecting
+// instead of waiting for them
+func newTestFollower(server *httptest.Server) (*logFollower, *[]time.Duration) {
+	var delays []time.Duration
+	follower := &logFollower{
+		url:            server.URL + "/api/logs/component/comp-123/stream",
+		httpClient:     server.Client(),
+		out:            &bytes.Buffer{},
+		errOut:         &bytes.Buffer{},
+		reconnectDelay: minReconnectDelay,
+		after: func(d time.Duration) <-chan time.Time {
+			delays = append(delays, d)
+			ch := make(chan time.Time, 1)
+			ch <- time.Now()
+			return ch
+		},
+	}
+	return follower, &delays
+}
+
+func TestLogFollowerResumesAfterLastEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var lastEventIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		lastEventIDs = append(lastEventIDs, r.Header.Get("Last-Event-ID"))
+		connection := len(lastEventIDs)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch connection {
+		case 1:
+			fmt.Fprint(w, "retry: 5\n\n")
+			fmt.Fprint(w, "event: log\nid: cursor-1\ndata: {\"log\":\"INFO: first\\n\"}\n\n")
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case 2:
+			fmt.Fprint(w, "event: error\ndata: {\"message\":\"Failed to retrieve logs\"}\n\n")
+			fmt.Fprint(w, "event: log\nid: cursor-2\ndata: {\"log\":\"ERROR: second\"}\n\n")
+		default:
+			cancel()
+		}
+	}))
+	defer server.Close()
+
+	follower, delays := newTestFollower(server)
+	if err := follower.run(ctx); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	// Each reconnect resumes after the last received log
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"", "cursor-1", "cursor-2"}; !slices.Equal(lastEventIDs, want) {
+		t.Errorf("Last-Event-ID headers = %q, want %q", lastEventIDs, want)
+	}
+	if out := follower.out.(*bytes.Buffer).String(); out != "INFO: first\nERROR: second\n" {
+		t.Errorf("printed logs = %q", out)
+	}
+	errOut := follower.errOut.(*bytes.Buffer).String()
+	if !strings.Contains(errOut, "Observer error: Failed to retrieve logs") {
+		t.Errorf("expected the error event to be reported, got %q", errOut)
+	}
+	// The retry interval sent by the observer replaces the reconnect delay
+	if want := []time.Duration{5 * time.Millisecond, 5 * time.Millisecond}; !slices.Equal(*delays, want) {
+		t.Errorf("reconnect delays = %v, want %v", *delays, want)
+	}
+}
+
+func TestLogFollowerBacksOff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch connections.Add(1) {
+		case 8:
+			// A stream that is established resets the delay once it drops
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case 10:
+			cancel()
+			fallthrough
+		default:
+			http.Error(w, "observer unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	follower, delays := newTestFollower(server)
+	if err := follower.run(ctx); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	want := []time.Duration{
+		// The delay doubles up to the maximum while the observer is unavailable
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second,
+		maxReconnectDelay, maxReconnectDelay,
+		// and starts over after the established stream drops
+		time.Second, 2 * time.Second,
+	}
+	if !slices.Equal(*delays, want) {
+		t.Errorf("reconnect delays = %v, want %v", *delays, want)
+	}
+	if errOut := follower.errOut.(*bytes.Buffer).String(); !strings.Contains(errOut, "observer returned status 503") {
+		t.Errorf("expected the failed connections to be reported, got %q", errOut)
+	}
+}
+
+func TestLogFollowerStopsOnClientErrors(t *testing.T) {
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections.Add(1)
+		http.Error(w, "invalid cursor", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	follower, delays := newTestFollower(server)
+	err := follower.run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "observer returned status 400: invalid cursor") {
+		t.Fatalf("run() error = %v, want the rejected request", err)
+	}
+	if connections.Load() != 1 || len(*delays) != 0 {
+		t.Errorf("expected no reconnect after a client error, got %d connections", connections.Load())
+	}
+}
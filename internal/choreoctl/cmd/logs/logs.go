@@ -146,6 +146,14 @@ func getBuildLogs(params api.LogParams) error {
 }
 
 func getDeploymentLogs(params api.LogParams) error {
+	// Following logs streams them from the observer, which serves the logs of every deployment of the component
+	if params.Follow {
+		if params.Organization == "" || params.Project == "" || params.Component == "" || params.Environment == "" {
+			return fmt.Errorf("organization, project, component and environment values are required to follow deployment logs")
+		}
+		return followDeploymentLogs(params)
+	}
+
 	if params.Organization == "" || params.Project == "" ||
 		params.Component == "" || params.Environment == "" || params.Deployment == "" {
 		return fmt.Errorf("organization, project, component, environment and deployment values are required for deployment logs")
@@ -217,20 +225,6 @@ func getDeploymentLogs(params api.LogParams) error {
 
 	tailLinesPtr := &params.TailLines
 
-	// If following logs, only show the latest pod
-	if params.Follow {
-		pod := pods.Items[0]
-		fmt.Printf("\n=== Pod: %s ===\n", pod.Name)
-		logs, err := GetPodLogs(pod.Name, pod.Namespace, "", true, tailLinesPtr)
-		if err != nil {
-			return fmt.Errorf("failed to get logs for pod %s: %w", pod.Name, err)
-		}
-		fmt.Println("=======================================")
-		fmt.Println(logs)
-		return nil
-	}
-
-	// Show logs from all pods if not following
 	for _, pod := range pods.Items {
 		fmt.Printf("\n=== Pod: %s ===\n", pod.Name)
 		logs, err := GetPodLogs(pod.Name, pod.Namespace, "", false, tailLinesPtr)
@@ -0,0 +1,425 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const outputJSON = "json"
+
+type RenderImpl struct{}
+
+func NewRenderImpl() *RenderImpl {
+	return &RenderImpl{}
+}
+
+// Render loads OpenChoreo resources from local files, runs the component rendering
+// pipeline in-process and prints the resulting manifests.
+func (i *RenderImpl) Render(params api.RenderParams) error {
+	if err := validation.ValidateParams(validation.CmdRender, validation.ResourceRender, params); err != nil {
+		return err
+	}
+
+	set := newResourceSet()
+	for _, path := range strings.Split(params.FilePath, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		files, err := discoverResourceFiles(path)
+		if err != nil {
+			return fmt.Errorf("failed to discover resources: %w", err)
+		}
+		for _, file := range files {
+			if err := set.loadFile(file); err != nil {
+				return fmt.Errorf("failed to load %s: %w", file, err)
+			}
+		}
+	}
+
+	input, err := set.buildRenderInput(params.Component, params.Environment)
+	if err != nil {
+		return err
+	}
+
+	pipeline := componentpipeline.NewPipeline(componentpipeline.WithSourceTracking(params.ShowSources))
+	output, err := pipeline.Render(input)
+	if err != nil {
+		return fmt.Errorf("failed to render component %q: %w", input.Component.Name, err)
+	}
+
+	for _, warning := range output.Metadata.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	return printOutput(os.Stdout, output, params.OutputFormat)
+}
+
+// resourceSet holds the OpenChoreo resources loaded from the input files.
+type resourceSet struct {
+	componentTypes   map[string]*openchoreov1alpha1.ComponentType
+	traits           map[string]*openchoreov1alpha1.Trait
+	components       []*openchoreov1alpha1.Component
+	workloads        []*openchoreov1alpha1.Workload
+	environments     []*openchoreov1alpha1.Environment
+	dataPlanes       []*openchoreov1alpha1.DataPlane
+	projects         []*openchoreov1alpha1.Project
+	releaseBindings  []*openchoreov1alpha1.ReleaseBinding
+	secretReferences map[string]*openchoreov1alpha1.SecretReference
+}
+
+func newResourceSet() *resourceSet {
+	return &resourceSet{
+		componentTypes:   make(map[string]*openchoreov1alpha1.ComponentType),
+		traits:           make(map[string]*openchoreov1alpha1.Trait),
+		secretReferences: make(map[string]*openchoreov1alpha1.SecretReference),
+	}
+}
+
+// loadFile decodes every YAML or JSON document in a file and records the
+// OpenChoreo resources that take part in rendering. Other kinds are ignored.
+func (s *resourceSet) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bufio.NewReader(f), 4096)
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to parse document: %w", err)
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		if err := s.add(raw); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *resourceSet) add(raw []byte) error {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return fmt.Errorf("failed to read resource kind: %w", err)
+	}
+
+	switch typeMeta.Kind {
+	case "ComponentType":
+		obj := &openchoreov1alpha1.ComponentType{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.componentTypes[obj.Name] = obj
+	case "Trait":
+		obj := &openchoreov1alpha1.Trait{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.traits[obj.Name] = obj
+	case "Component":
+		obj := &openchoreov1alpha1.Component{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.components = append(s.components, obj)
+	case "Workload":
+		obj := &openchoreov1alpha1.Workload{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.workloads = append(s.workloads, obj)
+	case "Environment":
+		obj := &openchoreov1alpha1.Environment{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.environments = append(s.environments, obj)
+	case "DataPlane":
+		obj := &openchoreov1alpha1.DataPlane{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.dataPlanes = append(s.dataPlanes, obj)
+	case "Project":
+		obj := &openchoreov1alpha1.Project{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.projects = append(s.projects, obj)
+	case "ReleaseBinding":
+		obj := &openchoreov1alpha1.ReleaseBinding{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.releaseBindings = append(s.releaseBindings, obj)
+	case "SecretReference":
+		obj := &openchoreov1alpha1.SecretReference{}
+		if err := decodeInto(raw, obj); err != nil {
+			return err
+		}
+		s.secretReferences[obj.Name] = obj
+	}
+	return nil
+}
+
+func decodeInto(raw []byte, obj metav1.Object) error {
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return fmt.Errorf("failed to decode %T: %w", obj, err)
+	}
+	return nil
+}
+
+// buildRenderInput selects the component and environment to render and
+// resolves everything they reference from the loaded resources.
+func (s *resourceSet) buildRenderInput(componentName, environmentName string) (*componentpipeline.RenderInput, error) {
+	component, err := selectByName(s.components, componentName, "Component")
+	if err != nil {
+		return nil, err
+	}
+
+	if component.Spec.ComponentType == "" {
+		return nil, fmt.Errorf("component %q does not reference a ComponentType", component.Name)
+	}
+	parts := strings.SplitN(component.Spec.ComponentType, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid componentType format: expected {workloadType}/{name}, got %s",
+			component.Spec.ComponentType)
+	}
+	componentType, ok := s.componentTypes[parts[1]]
+	if !ok {
+		return nil, fmt.Errorf("componentType %q referenced by component %q not found in the given files",
+			parts[1], component.Name)
+	}
+
+	traits := make([]openchoreov1alpha1.Trait, 0, len(component.Spec.Traits))
+	seen := make(map[string]bool)
+	for _, instance := range component.Spec.Traits {
+		if seen[instance.Name] {
+			continue
+		}
+		trait, ok := s.traits[instance.Name]
+		if !ok {
+			return nil, fmt.Errorf("trait %q referenced by component %q not found in the given files",
+				instance.Name, component.Name)
+		}
+		traits = append(traits, *trait)
+		seen[instance.Name] = true
+	}
+
+	workload, err := s.selectWorkload(component.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	environment, err := selectByName(s.environments, environmentName, "Environment")
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlane, err := selectByName(s.dataPlanes, environment.Spec.DataPlaneRef, "DataPlane")
+	if err != nil {
+		return nil, err
+	}
+
+	project := s.selectProject(component)
+
+	var releaseBinding *openchoreov1alpha1.ReleaseBinding
+	for _, rb := range s.releaseBindings {
+		if rb.Spec.Owner.ComponentName == component.Name && rb.Spec.Environment == environment.Name {
+			releaseBinding = rb
+			break
+		}
+	}
+
+	// Resources loaded from files usually have no namespace; default to the
+	// "default" namespace, which the metadata context uses as the organization.
+	if component.Namespace == "" {
+		component.Namespace = "default"
+	}
+
+	return &componentpipeline.RenderInput{
+		ComponentType:    componentType,
+		Component:        component,
+		Traits:           traits,
+		Workload:         workload,
+		Environment:      environment,
+		ReleaseBinding:   releaseBinding,
+		DataPlane:        dataPlane,
+		SecretReferences: s.secretReferences,
+		Metadata:         releasebinding.BuildMetadataContext(component, project, dataPlane, environment),
+	}, nil
+}
+
+// selectWorkload returns the workload owned by the component, or the only
+// workload if none declares an owner.
+func (s *resourceSet) selectWorkload(componentName string) (*openchoreov1alpha1.Workload, error) {
+	for _, w := range s.workloads {
+		if w.Spec.Owner.ComponentName == componentName {
+			return w, nil
+		}
+	}
+	if len(s.workloads) == 1 && s.workloads[0].Spec.Owner.ComponentName == "" {
+		return s.workloads[0], nil
+	}
+	return nil, fmt.Errorf("no Workload for component %q found in the given files", componentName)
+}
+
+// selectProject returns the project owning the component. When the project is
+// not part of the input files, a placeholder with the owner's name is used.
+func (s *resourceSet) selectProject(component *openchoreov1alpha1.Component) *openchoreov1alpha1.Project {
+	for _, p := range s.projects {
+		if p.Name == component.Spec.Owner.ProjectName {
+			return p
+		}
+	}
+	return &openchoreov1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      component.Spec.Owner.ProjectName,
+			Namespace: component.Namespace,
+		},
+	}
+}
+
+// selectByName picks the object with the given name. When no name is given,
+// the files must contain a single object of the kind, which is returned.
+func selectByName[T metav1.Object](objs []T, name, kind string) (T, error) {
+	var zero T
+	if len(objs) == 0 {
+		return zero, fmt.Errorf("no %s found in the given files", kind)
+	}
+	if name == "" {
+		if len(objs) == 1 {
+			return objs[0], nil
+		}
+		return zero, fmt.Errorf("found %d %s resources in the given files; select one by name", len(objs), kind)
+	}
+	for _, obj := range objs {
+		if obj.GetName() == name {
+			return obj, nil
+		}
+	}
+	return zero, fmt.Errorf("could not find %s %q in the given files", kind, name)
+}
+
+// discoverResourceFiles returns the YAML and JSON files at a path, walking directories recursively.
+func discoverResourceFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("path %s does not exist", path)
+		}
+		return nil, fmt.Errorf("error accessing path %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(filePath)) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking directory %s: %w", path, err)
+	}
+	return files, nil
+}
+
+// renderedResource is the JSON representation of a resource when sources are shown.
+type renderedResource struct {
+	Source   string         `json:"source"`
+	Resource map[string]any `json:"resource"`
+}
+
+// printOutput writes the rendered resources in the requested format.
+// YAML output is a multi-document stream; when sources are tracked each
+// document is preceded by a "# Source:" comment.
+func printOutput(w io.Writer, output *componentpipeline.RenderOutput, format string) error {
+	if format == outputJSON {
+		var doc any = output.Resources
+		if output.Sources != nil {
+			items := make([]renderedResource, 0, len(output.Resources))
+			for i, resource := range output.Resources {
+				items = append(items, renderedResource{
+					Source:   describeSource(output.Sources[i]),
+					Resource: resource,
+				})
+			}
+			doc = items
+		}
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal resources: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	for i, resource := range output.Resources {
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return fmt.Errorf("failed to marshal resource: %w", err)
+		}
+		if _, err := fmt.Fprintln(w, "---"); err != nil {
+			return err
+		}
+		if output.Sources != nil {
+			if _, err := fmt.Fprintf(w, "# Source: %s\n", describeSource(output.Sources[i])); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describeSource formats a resource source for display.
+func describeSource(source componentpipeline.ResourceSource) string {
+	var desc string
+	if source.Trait != "" {
+		desc = fmt.Sprintf("trait %s/%s", source.Trait, source.TraitInstance)
+	} else {
+		desc = fmt.Sprintf("componentType resource %q", source.TemplateID)
+	}
+	if len(source.PatchedBy) > 0 {
+		desc += fmt.Sprintf(" (patched by %s)", strings.Join(source.PatchedBy, ", "))
+	}
+	return desc
+}
@@ -0,0 +1,406 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+const testComponentType = `
+apiVersion: openchoreo.dev/v1alpha1
+kind: ComponentType
+metadata:
+  name: web
+spec:
+  workloadType: deployment
+  schema:
+    parameters:
+      replicas: "integer | default=1"
+  resources:
+    - id: deployment
+      template:
+        apiVersion: apps/v1
+        kind: Deployment
+        metadata:
+          name: ${metadata.name}
+          namespace: ${metadata.namespace}
+        spec:
+          replicas: ${parameters.replicas}
+`
+
+const testComponents = `
+apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: frontend
+spec:
+  owner:
+    projectName: shop
+  componentType: deployment/web
+  parameters:
+    replicas: 2
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: backend
+spec:
+  owner:
+    projectName: shop
+  componentType: deployment/web
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Workload
+metadata:
+  name: frontend
+spec:
+  owner:
+    projectName: shop
+    componentName: frontend
+  containers:
+    main:
+      image: frontend:latest
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Workload
+metadata:
+  name: backend
+spec:
+  owner:
+    projectName: shop
+    componentName: backend
+  containers:
+    main:
+      image: backend:latest
+`
+
+const testPlatform = `{
+  "apiVersion": "openchoreo.dev/v1alpha1",
+  "kind": "Environment",
+  "metadata": {"name": "dev"},
+  "spec": {"dataPlaneRef": "local"}
+}
+{
+  "apiVersion": "openchoreo.dev/v1alpha1",
+  "kind": "DataPlane",
+  "metadata": {"name": "local"},
+  "spec": {}
+}`
+
+// writeFiles writes the given files to a temporary directory and returns its path.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Failed to create directory for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+// loadResourceSet loads every resource file found in dir.
+func loadResourceSet(t *testing.T, dir string) *resourceSet {
+	t.Helper()
+	files, err := discoverResourceFiles(dir)
+	if err != nil {
+		t.Fatalf("discoverResourceFiles() error = %v", err)
+	}
+	set := newResourceSet()
+	for _, file := range files {
+		if err := set.loadFile(file); err != nil {
+			t.Fatalf("loadFile(%s) error = %v", file, err)
+		}
+	}
+	return set
+}
+
+func TestDiscoverResourceFiles(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"component.yaml":          "",
+		"platform/env.yml":        "",
+		"platform/dataplane.JSON": "",
+		"README.md":               "",
+		"platform/notes.txt":      "",
+	})
+
+	got, err := discoverResourceFiles(dir)
+	if err != nil {
+		t.Fatalf("discoverResourceFiles() error = %v", err)
+	}
+	for i := range got {
+		got[i], _ = filepath.Rel(dir, got[i])
+	}
+	sort.Strings(got)
+	want := []string{"component.yaml", "platform/dataplane.JSON", "platform/env.yml"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("discoverResourceFiles() = %v, want %v", got, want)
+	}
+
+	file := filepath.Join(dir, "README.md")
+	got, err = discoverResourceFiles(file)
+	if err != nil {
+		t.Fatalf("discoverResourceFiles() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != file {
+		t.Errorf("discoverResourceFiles() = %v, want the file itself", got)
+	}
+
+	if _, err := discoverResourceFiles(filepath.Join(dir, "missing")); err == nil ||
+		!strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("discoverResourceFiles() error = %v, want a missing path error", err)
+	}
+}
+
+func TestResourceSetLoadFile(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"component-type.yaml": testComponentType,
+		"components.yaml":     testComponents + "---\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: ignored\n",
+		"platform.json":       testPlatform,
+	})
+	set := loadResourceSet(t, dir)
+
+	if _, ok := set.componentTypes["web"]; !ok || len(set.componentTypes) != 1 {
+		t.Errorf("componentTypes = %v, want only web", set.componentTypes)
+	}
+	if len(set.components) != 2 {
+		t.Errorf("len(components) = %d, want 2", len(set.components))
+	}
+	if len(set.workloads) != 2 {
+		t.Errorf("len(workloads) = %d, want 2", len(set.workloads))
+	}
+	if len(set.environments) != 1 || set.environments[0].Spec.DataPlaneRef != "local" {
+		t.Errorf("environments = %v, want the dev environment from the JSON file", set.environments)
+	}
+	if len(set.dataPlanes) != 1 {
+		t.Errorf("len(dataPlanes) = %d, want 1", len(set.dataPlanes))
+	}
+
+	invalid := writeFiles(t, map[string]string{"invalid.yaml": "kind: Component\nspec: [\n"})
+	if err := newResourceSet().loadFile(filepath.Join(invalid, "invalid.yaml")); err == nil {
+		t.Error("loadFile() expected an error for an invalid document")
+	}
+}
+
+func TestResourceSetBuildRenderInput(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		component     string
+		environment   string
+		wantComponent string
+		wantImage     string
+		wantErr       string
+	}{
+		{
+			name: "selects the component and its workload by name",
+			files: map[string]string{
+				"component-type.yaml": testComponentType,
+				"components.yaml":     testComponents,
+				"platform.json":       testPlatform,
+			},
+			component:     "backend",
+			wantComponent: "backend",
+			wantImage:     "backend:latest",
+		},
+		{
+			name: "requires a name when several components are loaded",
+			files: map[string]string{
+				"component-type.yaml": testComponentType,
+				"components.yaml":     testComponents,
+				"platform.json":       testPlatform,
+			},
+			wantErr: "found 2 Component resources in the given files; select one by name",
+		},
+		{
+			name: "rejects a name that does not match the only component",
+			files: map[string]string{
+				"component-type.yaml": testComponentType,
+				"components.yaml":     strings.SplitN(testComponents, "---", 2)[0],
+				"platform.json":       testPlatform,
+			},
+			component: "backend",
+			wantErr:   `could not find Component "backend" in the given files`,
+		},
+		{
+			name: "rejects an environment name that does not match the only environment",
+			files: map[string]string{
+				"component-type.yaml": testComponentType,
+				"components.yaml":     testComponents,
+				"platform.json":       testPlatform,
+			},
+			component:   "frontend",
+			environment: "prod",
+			wantErr:     `could not find Environment "prod" in the given files`,
+		},
+		{
+			name: "rejects a data plane that does not match the environment's reference",
+			files: map[string]string{
+				"component-type.yaml": testComponentType,
+				"components.yaml":     testComponents,
+				"platform.json":       strings.Replace(testPlatform, `"name": "local"`, `"name": "remote"`, 1),
+			},
+			component: "frontend",
+			wantErr:   `could not find DataPlane "local" in the given files`,
+		},
+		{
+			name: "reports a missing component type",
+			files: map[string]string{
+				"components.yaml": testComponents,
+				"platform.json":   testPlatform,
+			},
+			component: "frontend",
+			wantErr:   `componentType "web" referenced by component "frontend" not found in the given files`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := loadResourceSet(t, writeFiles(t, tt.files))
+
+			input, err := set.buildRenderInput(tt.component, tt.environment)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("buildRenderInput() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildRenderInput() error = %v", err)
+			}
+			if input.Component.Name != tt.wantComponent {
+				t.Errorf("Component = %q, want %q", input.Component.Name, tt.wantComponent)
+			}
+			if got := input.Workload.Spec.Containers["main"].Image; got != tt.wantImage {
+				t.Errorf("Workload image = %q, want %q", got, tt.wantImage)
+			}
+			if input.Component.Namespace != "default" {
+				t.Errorf("Component namespace = %q, want %q", input.Component.Namespace, "default")
+			}
+			if input.DataPlane.Name != "local" {
+				t.Errorf("DataPlane = %q, want %q", input.DataPlane.Name, "local")
+			}
+		})
+	}
+}
+
+func TestPrintOutput(t *testing.T) {
+	set := loadResourceSet(t, writeFiles(t, map[string]string{
+		"component-type.yaml": testComponentType,
+		"components.yaml":     testComponents,
+		"platform.json":       testPlatform,
+	}))
+	input, err := set.buildRenderInput("frontend", "dev")
+	if err != nil {
+		t.Fatalf("buildRenderInput() error = %v", err)
+	}
+	plain, err := componentpipeline.NewPipeline().Render(input)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	tracked, err := componentpipeline.NewPipeline(componentpipeline.WithSourceTracking(true)).Render(input)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := printOutput(&buf, plain, "yaml"); err != nil {
+			t.Fatalf("printOutput() error = %v", err)
+		}
+		out := buf.String()
+		if !strings.HasPrefix(out, "---\n") || strings.Contains(out, "# Source:") {
+			t.Errorf("printOutput() = %q, want a document without sources", out)
+		}
+		var resource map[string]any
+		if err := yaml.Unmarshal([]byte(strings.TrimPrefix(out, "---\n")), &resource); err != nil {
+			t.Fatalf("Failed to parse output: %v", err)
+		}
+		if resource["kind"] != "Deployment" {
+			t.Errorf("kind = %v, want Deployment", resource["kind"])
+		}
+		if replicas := resource["spec"].(map[string]any)["replicas"]; replicas != float64(2) {
+			t.Errorf("replicas = %v, want 2", replicas)
+		}
+	})
+
+	t.Run("yaml with sources", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := printOutput(&buf, tracked, "yaml"); err != nil {
+			t.Fatalf("printOutput() error = %v", err)
+		}
+		if want := "---\n# Source: componentType resource \"deployment\"\n"; !strings.HasPrefix(buf.String(), want) {
+			t.Errorf("printOutput() = %q, want prefix %q", buf.String(), want)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := printOutput(&buf, plain, outputJSON); err != nil {
+			t.Fatalf("printOutput() error = %v", err)
+		}
+		var resources []map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &resources); err != nil {
+			t.Fatalf("Failed to parse output: %v", err)
+		}
+		if len(resources) != 1 || resources[0]["kind"] != "Deployment" {
+			t.Errorf("printOutput() = %v, want the Deployment", resources)
+		}
+	})
+
+	t.Run("json with sources", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := printOutput(&buf, tracked, outputJSON); err != nil {
+			t.Fatalf("printOutput() error = %v", err)
+		}
+		var items []renderedResource
+		if err := json.Unmarshal(buf.Bytes(), &items); err != nil {
+			t.Fatalf("Failed to parse output: %v", err)
+		}
+		if len(items) != 1 || items[0].Source != `componentType resource "deployment"` ||
+			items[0].Resource["kind"] != "Deployment" {
+			t.Errorf("printOutput() = %+v, want the Deployment with its source", items)
+		}
+	})
+}
+
+func TestDescribeSource(t *testing.T) {
+	tests := []struct {
+		source componentpipeline.ResourceSource
+		want   string
+	}{
+		{
+			source: componentpipeline.ResourceSource{TemplateID: "deployment"},
+			want:   `componentType resource "deployment"`,
+		},
+		{
+			source: componentpipeline.ResourceSource{Trait: "storage", TraitInstance: "data"},
+			want:   "trait storage/data",
+		},
+		{
+			source: componentpipeline.ResourceSource{TemplateID: "deployment", PatchedBy: []string{"storage/data", "ingress/web"}},
+			want:   `componentType resource "deployment" (patched by storage/data, ingress/web)`,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := describeSource(tt.source); got != tt.want {
+			t.Errorf("describeSource(%+v) = %q, want %q", tt.source, got, tt.want)
+		}
+	}
+}
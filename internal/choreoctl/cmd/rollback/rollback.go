@@ -0,0 +1,45 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollback
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+type RollbackImpl struct{}
+
+func NewRollbackImpl() *RollbackImpl {
+	return &RollbackImpl{}
+}
+
+// Rollback asks the API server to rebind an environment to a previously deployed component release.
+func (i *RollbackImpl) Rollback(params api.RollbackParams) error {
+	if err := validation.ValidateParams(validation.CmdRollback, validation.ResourceRollback, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	binding, err := apiClient.RollbackReleaseBinding(ctx, params.Organization, params.Project, params.Component,
+		params.Environment, params.Release)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Component '%s' in environment '%s' rolled back to release '%s'\n",
+		params.Component, binding.Environment, binding.ReleaseName)
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/create/project"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/create/workload"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/delete"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/diff"
 	getbuild "github.com/openchoreo/openchoreo/internal/choreoctl/cmd/get/build"
 	getcomponent "github.com/openchoreo/openchoreo/internal/choreoctl/cmd/get/component"
 	getconfigurationgroup "github.com/openchoreo/openchoreo/internal/choreoctl/cmd/get/configurationgroup"
@@ -33,6 +34,8 @@ import (
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/login"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/logout"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/logs"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/render"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/rollback"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
@@ -190,6 +193,21 @@ func (c *CommandImplementation) Apply(params api.ApplyParams) error {
 	return applyImpl.Apply(params)
 }
 
+func (c *CommandImplementation) Render(params api.RenderParams) error {
+	renderImpl := render.NewRenderImpl()
+	return renderImpl.Render(params)
+}
+
+func (c *CommandImplementation) Diff(params api.DiffParams) error {
+	diffImpl := diff.NewDiffImpl()
+	return diffImpl.Diff(params)
+}
+
+func (c *CommandImplementation) Rollback(params api.RollbackParams) error {
+	rollbackImpl := rollback.NewRollbackImpl()
+	return rollbackImpl.Rollback(params)
+}
+
 // Logs Operations
 
 func (c *CommandImplementation) GetLogs(params api.LogParams) error {
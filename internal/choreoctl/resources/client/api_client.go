@@ -7,11 +7,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/openchoreo/openchoreo/internal/choreoctl/auth"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
 	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 )
@@ -101,6 +104,7 @@ type ListProjectsResponse struct {
 
 // ComponentResponse represents a component from the API
 type ComponentResponse struct {
+	UID         string `json:"uid"`
 	Name        string `json:"name"`
 	OrgName     string `json:"orgName"`
 	ProjectName string `json:"projectName"`
@@ -124,6 +128,117 @@ type ListComponentsResponse struct {
 	Code  string `json:"code,omitempty"`
 }
 
+// ComponentAPIResponse represents the response from getting a component
+type ComponentAPIResponse struct {
+	Success bool              `json:"success"`
+	Data    ComponentResponse `json:"data"`
+	Error   string            `json:"error,omitempty"`
+	Code    string            `json:"code,omitempty"`
+}
+
+// EnvironmentResponse represents an environment from the API
+type EnvironmentResponse struct {
+	UID          string `json:"uid"`
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	DisplayName  string `json:"displayName,omitempty"`
+	DataPlaneRef string `json:"dataPlaneRef,omitempty"`
+	IsProduction bool   `json:"isProduction"`
+	Status       string `json:"status,omitempty"`
+}
+
+// EnvironmentAPIResponse represents the response from getting an environment
+type EnvironmentAPIResponse struct {
+	Success bool                `json:"success"`
+	Data    EnvironmentResponse `json:"data"`
+	Error   string              `json:"error,omitempty"`
+	Code    string              `json:"code,omitempty"`
+}
+
+// ObserverURLResponse represents the observer serving the logs of a component in an environment
+type ObserverURLResponse struct {
+	ObserverURL      string `json:"observerUrl,omitempty"`
+	ConnectionMethod *struct {
+		Type     string `json:"type,omitempty"`
+		Username string `json:"username,omitempty"`
+		Password string `json:"password,omitempty"`
+	} `json:"connectionMethod,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ObserverURLAPIResponse represents the response from getting the observer URL of a component
+type ObserverURLAPIResponse struct {
+	Success bool                `json:"success"`
+	Data    ObserverURLResponse `json:"data"`
+	Error   string              `json:"error,omitempty"`
+	Code    string              `json:"code,omitempty"`
+}
+
+// ComponentReleaseDiff represents the differences between two component releases rendered for an environment
+type ComponentReleaseDiff struct {
+	ReleaseName    string `json:"releaseName"`
+	AgainstRelease string `json:"againstRelease"`
+	Environment    string `json:"environment"`
+	Summary        struct {
+		Added     int `json:"added"`
+		Removed   int `json:"removed"`
+		Changed   int `json:"changed"`
+		Unchanged int `json:"unchanged"`
+	} `json:"summary"`
+	Resources []ResourceDiff `json:"resources"`
+}
+
+// ResourceDiff represents the difference of a single rendered resource
+type ResourceDiff struct {
+	APIVersion   string        `json:"apiVersion"`
+	Kind         string        `json:"kind"`
+	Name         string        `json:"name"`
+	Namespace    string        `json:"namespace,omitempty"`
+	Change       string        `json:"change"`
+	FieldChanges []FieldChange `json:"fieldChanges,omitempty"`
+}
+
+// FieldChange represents a changed field within a rendered resource
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// DiffComponentReleasesResponse represents the response from diffing component releases
+type DiffComponentReleasesResponse struct {
+	Success bool                 `json:"success"`
+	Data    ComponentReleaseDiff `json:"data"`
+	Error   string               `json:"error,omitempty"`
+	Code    string               `json:"code,omitempty"`
+}
+
+// ReleaseBinding represents a release binding returned by the API
+type ReleaseBinding struct {
+	Name           string                `json:"name"`
+	ComponentName  string                `json:"componentName"`
+	ProjectName    string                `json:"projectName"`
+	OrgName        string                `json:"orgName"`
+	Environment    string                `json:"environment"`
+	ReleaseName    string                `json:"releaseName"`
+	Status         string                `json:"status,omitempty"`
+	ReleaseHistory []ReleaseHistoryEntry `json:"releaseHistory,omitempty"`
+}
+
+// ReleaseHistoryEntry represents a release that was bound to an environment
+type ReleaseHistoryEntry struct {
+	ReleaseName string    `json:"releaseName"`
+	BoundAt     time.Time `json:"boundAt"`
+}
+
+// ReleaseBindingAPIResponse represents the response from release binding operations
+type ReleaseBindingAPIResponse struct {
+	Success bool           `json:"success"`
+	Data    ReleaseBinding `json:"data"`
+	Error   string         `json:"error,omitempty"`
+	Code    string         `json:"code,omitempty"`
+}
+
 // NewAPIClient creates a new API client with control plane auto-detection
 func NewAPIClient() (*APIClient, error) {
 	cfg, err := getStoredControlPlaneConfig()
@@ -131,10 +246,23 @@ func NewAPIClient() (*APIClient, error) {
 		return nil, fmt.Errorf("failed to detect control plane: %w", err)
 	}
 
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+
+	// Tokens obtained by "choreoctl login" for the current context take precedence over the
+	// static token of the control plane
+	token := cfg.Token
+	accessToken, err := auth.AccessToken(context.Background(), httpClient)
+	switch {
+	case err == nil:
+		token = accessToken
+	case !errors.Is(err, auth.ErrNotLoggedIn):
+		return nil, err
+	}
+
 	return &APIClient{
 		baseURL:    cfg.Endpoint,
-		token:      cfg.Token,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		token:      token,
+		httpClient: httpClient,
 	}, nil
 }
 
@@ -279,6 +407,148 @@ func (c *APIClient) ListComponents(ctx context.Context, orgName, projectName str
 	return listResp.Data.Items, nil
 }
 
+// GetComponent retrieves a component from the API
+func (c *APIClient) GetComponent(ctx context.Context, orgName, projectName, componentName string) (*ComponentResponse, error) {
+	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s",
+		url.PathEscape(orgName), url.PathEscape(projectName), url.PathEscape(componentName))
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make get component request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var componentResp ComponentAPIResponse
+	if err := json.Unmarshal(body, &componentResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !componentResp.Success {
+		return nil, fmt.Errorf("get component failed: %s", componentResp.Error)
+	}
+
+	return &componentResp.Data, nil
+}
+
+// GetEnvironment retrieves an environment of an organization from the API
+func (c *APIClient) GetEnvironment(ctx context.Context, orgName, environmentName string) (*EnvironmentResponse, error) {
+	path := fmt.Sprintf("/api/v1/orgs/%s/environments/%s", url.PathEscape(orgName), url.PathEscape(environmentName))
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make get environment request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var environmentResp EnvironmentAPIResponse
+	if err := json.Unmarshal(body, &environmentResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !environmentResp.Success {
+		return nil, fmt.Errorf("get environment failed: %s", environmentResp.Error)
+	}
+
+	return &environmentResp.Data, nil
+}
+
+// GetComponentObserverURL retrieves the observer serving the runtime logs of a component in an environment
+func (c *APIClient) GetComponentObserverURL(ctx context.Context, orgName, projectName, componentName, environmentName string) (*ObserverURLResponse, error) {
+	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/environments/%s/observer-url",
+		url.PathEscape(orgName), url.PathEscape(projectName), url.PathEscape(componentName), url.PathEscape(environmentName))
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make get observer URL request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var observerResp ObserverURLAPIResponse
+	if err := json.Unmarshal(body, &observerResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !observerResp.Success {
+		return nil, fmt.Errorf("get observer URL failed: %s", observerResp.Error)
+	}
+
+	return &observerResp.Data, nil
+}
+
+// DiffComponentReleases compares two component releases rendered for an environment
+func (c *APIClient) DiffComponentReleases(ctx context.Context, orgName, projectName, componentName, releaseName, againstRelease, environment string) (*ComponentReleaseDiff, error) {
+	query := url.Values{}
+	query.Set("against", againstRelease)
+	query.Set("environment", environment)
+	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/component-releases/%s/diff?%s",
+		url.PathEscape(orgName), url.PathEscape(projectName), url.PathEscape(componentName), url.PathEscape(releaseName), query.Encode())
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make diff component releases request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var diffResp DiffComponentReleasesResponse
+	if err := json.Unmarshal(body, &diffResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !diffResp.Success {
+		return nil, fmt.Errorf("diff component releases failed: %s", diffResp.Error)
+	}
+
+	return &diffResp.Data, nil
+}
+
+// RollbackReleaseBinding rolls back the release bound to an environment.
+// An empty releaseName rolls back to the previously deployed release.
+func (c *APIClient) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, environment, releaseName string) (*ReleaseBinding, error) {
+	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/environments/%s/rollback",
+		url.PathEscape(orgName), url.PathEscape(projectName), url.PathEscape(componentName), url.PathEscape(environment))
+	body := map[string]string{}
+	if releaseName != "" {
+		body["releaseName"] = releaseName
+	}
+	resp, err := c.post(ctx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make rollback request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var bindingResp ReleaseBindingAPIResponse
+	if err := json.Unmarshal(respBody, &bindingResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !bindingResp.Success {
+		return nil, fmt.Errorf("rollback failed: %s", bindingResp.Error)
+	}
+
+	return &bindingResp.Data, nil
+}
+
 // HTTP helper methods
 func (c *APIClient) get(ctx context.Context, path string) (*http.Response, error) {
 	return c.doRequest(ctx, "GET", path, nil)
@@ -6,6 +6,7 @@ package kinds
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -47,11 +48,26 @@ func (d *DataPlaneResource) WithNamespace(namespace string) {
 }
 
 // GetStatus returns the status of a DataPlane with detailed information.
+// A failing probe condition takes precedence, so that an unhealthy cluster is reported as not ready.
 func (d *DataPlaneResource) GetStatus(dataPlane *openchoreov1alpha1.DataPlane) string {
+	probeConditions := []string{
+		ConditionTypeClusterReachable,
+		ConditionTypeCapabilitiesAvailable,
+		ConditionTypeGatewayResolvable,
+	}
+	for _, condType := range probeConditions {
+		condition := meta.FindStatusCondition(dataPlane.Status.Conditions, condType)
+		if condition != nil && condition.Status != ConditionStatusTrue {
+			return resources.GetResourceStatus(dataPlane.Status.Conditions, []string{condType},
+				StatusPending, StatusReady, StatusNotReady)
+		}
+	}
+
 	priorityConditions := []string{
 		ConditionTypeReady,
 		ConditionTypeAvailable,
 		ConditionTypeConfigured,
+		ConditionTypeClusterReachable,
 	}
 
 	return resources.GetResourceStatus(
@@ -63,6 +79,14 @@ func (d *DataPlaneResource) GetStatus(dataPlane *openchoreov1alpha1.DataPlane) s
 	)
 }
 
+// GetServerVersion returns the Kubernetes version of the dataplane cluster as of its last probe.
+func (d *DataPlaneResource) GetServerVersion(dataPlane *openchoreov1alpha1.DataPlane) string {
+	if dataPlane.Status.Cluster == nil || dataPlane.Status.Cluster.ServerVersion == "" {
+		return PlaceholderVersion
+	}
+	return dataPlane.Status.Cluster.ServerVersion
+}
+
 // GetAge returns the age of a DataPlane.
 func (d *DataPlaneResource) GetAge(dataPlane *openchoreov1alpha1.DataPlane) string {
 	return resources.FormatAge(dataPlane.GetCreationTimestamp().Time)
@@ -90,6 +114,7 @@ func (d *DataPlaneResource) PrintTableItems(dataPlanes []resources.ResourceWrapp
 		rows = append(rows, []string{
 			wrapper.LogicalName,
 			dataPlane.Name,
+			d.GetServerVersion(dataPlane),
 			d.GetStatus(dataPlane),
 			d.GetAge(dataPlane),
 			dataPlane.GetLabels()[constants.LabelOrganization],
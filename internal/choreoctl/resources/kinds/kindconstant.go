@@ -75,6 +75,7 @@ const (
 	HeaderDNSPrefix       = "DNS PREFIX"
 	HeaderCluster         = "CLUSTER"
 	HeaderAddress         = "ADDRESS"
+	HeaderVersion         = "VERSION"
 )
 
 // Resource-specific table headers defined as variables (not constants)
@@ -104,7 +105,7 @@ var (
 	HeadersEnvironment = []string{HeaderName, HeaderDataPlane, HeaderProduction, HeaderDNSPrefix, HeaderAge, HeaderOrganization}
 
 	// DataPlane table headers
-	HeadersDataPlane = []string{HeaderName, HeaderCluster, HeaderStatus, HeaderAge, HeaderOrganization}
+	HeadersDataPlane = []string{HeaderName, HeaderCluster, HeaderVersion, HeaderStatus, HeaderAge, HeaderOrganization}
 
 	// Endpoint table headers
 	HeadersEndpoint = []string{HeaderName, HeaderType, HeaderAddress, HeaderStatus, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization, HeaderEnvironment}
@@ -160,6 +161,13 @@ const (
 	ConditionTypeConfigured = "Configured"
 )
 
+// DataPlane specific condition types, set by probing the dataplane cluster
+const (
+	ConditionTypeClusterReachable      = "ClusterReachable"
+	ConditionTypeCapabilitiesAvailable = "CapabilitiesAvailable"
+	ConditionTypeGatewayResolvable     = "GatewayResolvable"
+)
+
 //
 // CONDITION STATUS VALUES
 //
@@ -264,6 +272,7 @@ const (
 const (
 	PlaceholderDuration = "-"
 	PlaceholderAddress  = "-"
+	PlaceholderVersion  = "-"
 )
 
 //
@@ -39,19 +39,50 @@ type WorkloadDescriptorEndpoint struct {
 }
 
 type WorkloadDescriptorConnection struct {
-	Name   string                             `yaml:"name"`
-	Type   string                             `yaml:"type"`
-	Params map[string]string                  `yaml:"params,omitempty"`
-	Inject WorkloadDescriptorConnectionInject `yaml:"inject"`
+	Name     string                             `yaml:"name"`
+	Type     string                             `yaml:"type"`
+	Params   map[string]string                  `yaml:"params,omitempty"`
+	Database *WorkloadDescriptorDatabase        `yaml:"database,omitempty"`
+	Queue    *WorkloadDescriptorQueue           `yaml:"queue,omitempty"`
+	External *WorkloadDescriptorExternal        `yaml:"external,omitempty"`
+	Inject   WorkloadDescriptorConnectionInject `yaml:"inject"`
+}
+
+type WorkloadDescriptorDatabase struct {
+	Engine      string `yaml:"engine,omitempty"`
+	Host        string `yaml:"host"`
+	Port        int32  `yaml:"port"`
+	Name        string `yaml:"name"`
+	Credentials string `yaml:"credentials,omitempty"`
+}
+
+type WorkloadDescriptorQueue struct {
+	BrokerURL   string `yaml:"brokerURL"`
+	Topic       string `yaml:"topic"`
+	Credentials string `yaml:"credentials,omitempty"`
+}
+
+type WorkloadDescriptorExternal struct {
+	URL         string `yaml:"url"`
+	Credentials string `yaml:"credentials,omitempty"`
 }
 
 type WorkloadDescriptorConnectionInject struct {
-	Env []WorkloadDescriptorConnectionEnvVar `yaml:"env"`
+	Env   []WorkloadDescriptorConnectionEnvVar `yaml:"env,omitempty"`
+	Files []WorkloadDescriptorConnectionFile   `yaml:"files,omitempty"`
 }
 
 type WorkloadDescriptorConnectionEnvVar struct {
-	Name  string `yaml:"name"`
-	Value string `yaml:"value"`
+	Name      string `yaml:"name"`
+	Value     string `yaml:"value,omitempty"`
+	SecretKey string `yaml:"secretKey,omitempty"`
+}
+
+type WorkloadDescriptorConnectionFile struct {
+	Name      string `yaml:"name"`
+	MountPath string `yaml:"mountPath"`
+	Value     string `yaml:"value,omitempty"`
+	SecretKey string `yaml:"secretKey,omitempty"`
 }
 
 // WorkloadDescriptorConfiguration represents the configurations section in workload.yaml
@@ -266,23 +297,67 @@ func addConnectionsFromDescriptor(workload *openchoreov1alpha1.Workload, descrip
 		envVars := make([]openchoreov1alpha1.WorkloadConnectionEnvVar, len(descriptorConnection.Inject.Env))
 		for i, envVar := range descriptorConnection.Inject.Env {
 			envVars[i] = openchoreov1alpha1.WorkloadConnectionEnvVar{
-				Name:  envVar.Name,
-				Value: envVar.Value,
+				Name:      envVar.Name,
+				Value:     envVar.Value,
+				SecretKey: envVar.SecretKey,
 			}
 		}
 
+		// Convert files
+		var files []openchoreov1alpha1.WorkloadConnectionFile
+		for _, file := range descriptorConnection.Inject.Files {
+			files = append(files, openchoreov1alpha1.WorkloadConnectionFile{
+				Key:       file.Name,
+				MountPath: file.MountPath,
+				Value:     file.Value,
+				SecretKey: file.SecretKey,
+			})
+		}
+
 		connection := openchoreov1alpha1.WorkloadConnection{
 			Type:   descriptorConnection.Type,
 			Params: descriptorConnection.Params,
 			Inject: openchoreov1alpha1.WorkloadConnectionInject{
-				Env: envVars,
+				Env:   envVars,
+				Files: files,
 			},
 		}
 
+		if db := descriptorConnection.Database; db != nil {
+			connection.Database = &openchoreov1alpha1.DatabaseConnection{
+				Engine:      db.Engine,
+				Host:        db.Host,
+				Port:        db.Port,
+				Name:        db.Name,
+				Credentials: connectionCredentials(db.Credentials),
+			}
+		}
+		if queue := descriptorConnection.Queue; queue != nil {
+			connection.Queue = &openchoreov1alpha1.QueueConnection{
+				BrokerURL:   queue.BrokerURL,
+				Topic:       queue.Topic,
+				Credentials: connectionCredentials(queue.Credentials),
+			}
+		}
+		if external := descriptorConnection.External; external != nil {
+			connection.External = &openchoreov1alpha1.ExternalConnection{
+				URL:         external.URL,
+				Credentials: connectionCredentials(external.Credentials),
+			}
+		}
+
 		workload.Spec.Connections[descriptorConnection.Name] = connection
 	}
 }
 
+// connectionCredentials converts the SecretReference name of descriptor connection credentials
+func connectionCredentials(secretRef string) *openchoreov1alpha1.ConnectionCredentials {
+	if secretRef == "" {
+		return nil
+	}
+	return &openchoreov1alpha1.ConnectionCredentials{SecretRef: secretRef}
+}
+
 // addConfigurationsFromDescriptor adds configurations (env vars and files) from the descriptor to the workload
 func addConfigurationsFromDescriptor(workload *openchoreov1alpha1.Workload, descriptor *WorkloadDescriptor, descriptorPath string) error {
 	// Get the main container
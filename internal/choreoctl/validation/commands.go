@@ -12,11 +12,14 @@ import (
 type CommandType string
 
 const (
-	CmdCreate CommandType = "create"
-	CmdGet    CommandType = "get"
-	CmdLogs   CommandType = "logs"
-	CmdApply  CommandType = "apply"
-	CmdDelete CommandType = "delete"
+	CmdCreate   CommandType = "create"
+	CmdGet      CommandType = "get"
+	CmdLogs     CommandType = "logs"
+	CmdApply    CommandType = "apply"
+	CmdDelete   CommandType = "delete"
+	CmdRender   CommandType = "render"
+	CmdDiff     CommandType = "diff"
+	CmdRollback CommandType = "rollback"
 )
 
 // ResourceType represents the resource being managed
@@ -39,6 +42,9 @@ const (
 	ResourceDeploymentPipeline ResourceType = "deploymentpipeline"
 	ResourceConfigurationGroup ResourceType = "configurationgroup"
 	ResourceWorkload           ResourceType = "workload"
+	ResourceRender             ResourceType = "render"
+	ResourceDiff               ResourceType = "diff"
+	ResourceRollback           ResourceType = "rollback"
 )
 
 // checkRequiredFields verifies if all required fields are populated
@@ -45,6 +45,12 @@ func ValidateParams(cmdType CommandType, resource ResourceType, params interface
 		return validateConfigurationGroupParams(cmdType, params)
 	case ResourceWorkload:
 		return validateWorkloadParams(cmdType, params)
+	case ResourceRender:
+		return validateRenderParams(cmdType, params)
+	case ResourceDiff:
+		return validateDiffParams(cmdType, params)
+	case ResourceRollback:
+		return validateRollbackParams(cmdType, params)
 	default:
 		return fmt.Errorf("unknown resource type: %s", resource)
 	}
@@ -282,7 +288,10 @@ func validateLogParams(cmdType CommandType, params interface{}) error {
 						"project":      p.Project,
 						"component":    p.Component,
 						"environment":  p.Environment,
-						"deployment":   p.Deployment,
+					}
+					// Followed logs are streamed for the component rather than a single deployment
+					if !p.Follow {
+						deployFields["deployment"] = p.Deployment
 					}
 					if !checkRequiredFields(deployFields) {
 						return generateHelpError(cmdType, ResourceLogs, deployFields)
@@ -384,6 +393,65 @@ func validateDeleteParams(cmdType CommandType, params interface{}) error {
 	return nil
 }
 
+// validateRenderParams validates parameters for render operations
+func validateRenderParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdRender {
+		if p, ok := params.(api.RenderParams); ok {
+			fields := map[string]string{
+				"file": p.FilePath,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, "", fields)
+			}
+			if p.OutputFormat != "" && p.OutputFormat != "yaml" && p.OutputFormat != "json" {
+				return fmt.Errorf("unsupported output format %q: must be one of yaml, json", p.OutputFormat)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDiffParams validates parameters for diff operations
+func validateDiffParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdDiff {
+		if p, ok := params.(api.DiffParams); ok {
+			fields := map[string]string{
+				"organization": p.Organization,
+				"project":      p.Project,
+				"component":    p.Component,
+				"environment":  p.Environment,
+				"release":      p.Release,
+				"against":      p.AgainstRelease,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, "", fields)
+			}
+			if p.OutputFormat != "" && p.OutputFormat != "text" && p.OutputFormat != "yaml" && p.OutputFormat != "json" {
+				return fmt.Errorf("unsupported output format %q: must be one of text, yaml, json", p.OutputFormat)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRollbackParams validates parameters for rollback operations
+func validateRollbackParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdRollback {
+		if p, ok := params.(api.RollbackParams); ok {
+			fields := map[string]string{
+				"organization": p.Organization,
+				"project":      p.Project,
+				"component":    p.Component,
+				"environment":  p.Environment,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, "", fields)
+			}
+		}
+	}
+	return nil
+}
+
 // Add validation function:
 func validateDeploymentPipelineParams(cmdType CommandType, params interface{}) error {
 	switch cmdType {
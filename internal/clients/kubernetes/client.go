@@ -4,13 +4,17 @@
 package kubernetes
 
 import (
-	"encoding/base64"
+	"context"
 	"fmt"
+	"net/http"
 	"sync"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -21,15 +25,34 @@ import (
 )
 
 // KubeMultiClientManager maintains a cache of Kubernetes clients keyed by a unique identifier.
+// Each cached client is tied to a hash of the cluster configuration it was created with, and is replaced
+// once the configuration or the credentials resolved for it change.
 type KubeMultiClientManager struct {
 	mu      sync.Mutex
-	clients map[string]client.Client
+	clients map[string]*cachedClient
+
+	// secretReader reads the secrets referenced by the clusters instead of the reader given to GetK8sClient
+	secretReader client.Reader
+	// versionReader reads the metadata of the referenced secrets, so that cached clients are reused without
+	// resolving their credentials again while the secrets are unchanged
+	versionReader client.Reader
+}
+
+// cachedClient is a client along with the hash of the credentials it was created with
+type cachedClient struct {
+	credentialsHash string
+	client          client.Client
+
+	// clusterHash identifies the cluster configuration the credentials were resolved for
+	clusterHash string
+	// secretVersions holds the resource versions of the secrets the credentials were resolved from, by name
+	secretVersions map[string]string
 }
 
 // NewManager initializes a new KubeMultiClientManager.
 func NewManager() *KubeMultiClientManager {
 	return &KubeMultiClientManager{
-		clients: make(map[string]client.Client),
+		clients: make(map[string]*cachedClient),
 	}
 }
 
@@ -43,20 +66,21 @@ func init() {
 	_ = argo.AddToScheme(scheme.Scheme)
 }
 
-// GetClient returns an existing Kubernetes client or creates one using the provided cluster configuration.
-func (m *KubeMultiClientManager) GetClient(key string, kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (client.Client, error) {
+// GetClient returns the cached Kubernetes client of the key when it was created with the same credentials
+// hash, or creates one from the REST config. A client created with other credentials is evicted.
+func (m *KubeMultiClientManager) GetClient(key, credentialsHash string, restCfg *rest.Config) (client.Client, error) {
+	return m.getClient(key, cachedClient{credentialsHash: credentialsHash}, restCfg)
+}
+
+// getClient is GetClient for a client along with the cluster configuration and secret versions it is created from.
+func (m *KubeMultiClientManager) getClient(key string, entry cachedClient, restCfg *rest.Config) (client.Client, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Return cached client if it exists
-	if cl, exists := m.clients[key]; exists {
-		return cl, nil
-	}
-
-	// Create REST config from the new structure
-	restCfg, err := buildRESTConfig(kubernetesCluster)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build REST config: %w", err)
+	// Return cached client if it was created with the current credentials
+	if cached, exists := m.clients[key]; exists && cached.credentialsHash == entry.credentialsHash {
+		cached.clusterHash, cached.secretVersions = entry.clusterHash, entry.secretVersions
+		return cached.client, nil
 	}
 
 	// Create the new client
@@ -65,113 +89,195 @@ func (m *KubeMultiClientManager) GetClient(key string, kubernetesCluster opencho
 		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
 	}
 
-	// Cache and return the client
-	m.clients[key] = cl
+	// Cache and return the client, replacing any client with stale credentials
+	entry.client = cl
+	m.clients[key] = &entry
 	return cl, nil
 }
 
-// buildRESTConfig constructs a REST config from the KubernetesClusterSpec
-func buildRESTConfig(kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (*rest.Config, error) {
+// RemoveClient evicts the cached Kubernetes client of the key, if any.
+func (m *KubeMultiClientManager) RemoveClient(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.clients, key)
+}
+
+// buildRESTConfig constructs a REST config from the KubernetesClusterSpec and its resolved credentials
+func buildRESTConfig(kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec, creds *clusterCredentials) (*rest.Config, error) {
 	restCfg := &rest.Config{
 		Host: kubernetesCluster.Server,
 	}
 
 	// Configure TLS
-	if err := configureTLS(restCfg, &kubernetesCluster.TLS); err != nil {
-		return nil, fmt.Errorf("failed to configure TLS: %w", err)
-	}
+	restCfg.TLSClientConfig.CAData = creds.caData
 
-	// Configure authentication with priority: mTLS > bearerToken > OIDC
-	if kubernetesCluster.Auth.MTLS != nil {
-		if err := configureMTLSAuth(restCfg, kubernetesCluster.Auth.MTLS); err != nil {
+	// Configure authentication with priority: mTLS > bearerToken > exec > OIDC
+	auth := kubernetesCluster.Auth
+	switch {
+	case auth.MTLS != nil:
+		if err := configureMTLSAuth(restCfg, creds); err != nil {
 			return nil, fmt.Errorf("failed to configure mTLS authentication: %w", err)
 		}
-	} else if kubernetesCluster.Auth.BearerToken != nil {
-		if err := configureBearerAuth(restCfg, kubernetesCluster.Auth.BearerToken); err != nil {
+	case auth.BearerToken != nil:
+		if err := configureBearerAuth(restCfg, creds); err != nil {
 			return nil, fmt.Errorf("failed to configure bearer token authentication: %w", err)
 		}
-	} else {
+	case auth.Exec != nil:
+		if err := configureExecAuth(restCfg, auth.Exec); err != nil {
+			return nil, fmt.Errorf("failed to configure exec authentication: %w", err)
+		}
+	case auth.OIDC != nil:
+		if err := configureOIDCAuth(restCfg, auth.OIDC, creds); err != nil {
+			return nil, fmt.Errorf("failed to configure OIDC authentication: %w", err)
+		}
+	default:
 		return nil, fmt.Errorf("no supported authentication method configured")
 	}
 
 	return restCfg, nil
 }
 
-// configureTLS sets up TLS configuration
-func configureTLS(restCfg *rest.Config, tls *openchoreov1alpha1.KubernetesTLS) error {
-	// Only handle inline CA data for now
-	if tls != nil && tls.CA.Value != "" {
-		// Decode base64 encoded CA certificate
-		caCert, err := base64.StdEncoding.DecodeString(tls.CA.Value)
-		if err != nil {
-			return fmt.Errorf("failed to decode base64 CA certificate: %w", err)
-		}
-		restCfg.TLSClientConfig.CAData = caCert
+// configureMTLSAuth sets up mutual TLS authentication
+func configureMTLSAuth(restCfg *rest.Config, creds *clusterCredentials) error {
+	if len(creds.clientCertData) == 0 {
+		return fmt.Errorf("client certificate is required for mTLS authentication")
 	}
+	if len(creds.clientKeyData) == 0 {
+		return fmt.Errorf("client key is required for mTLS authentication")
+	}
+
+	restCfg.TLSClientConfig.CertData = creds.clientCertData
+	restCfg.TLSClientConfig.KeyData = creds.clientKeyData
 
 	return nil
 }
 
-// configureMTLSAuth sets up mutual TLS authentication
-func configureMTLSAuth(restCfg *rest.Config, mtlsAuth *openchoreov1alpha1.MTLSAuth) error {
-	if mtlsAuth == nil {
-		return fmt.Errorf("mTLS authentication config is nil")
+// configureBearerAuth sets up bearer token authentication
+func configureBearerAuth(restCfg *rest.Config, creds *clusterCredentials) error {
+	if len(creds.bearerToken) == 0 {
+		return fmt.Errorf("bearer token is required for bearer token authentication")
 	}
 
-	// Get client certificate (only inline value for now)
-	if mtlsAuth.ClientCert.Value == "" {
-		return fmt.Errorf("client certificate value is required for mTLS authentication")
-	}
-	clientCertData, err := base64.StdEncoding.DecodeString(mtlsAuth.ClientCert.Value)
-	if err != nil {
-		return fmt.Errorf("failed to decode base64 client certificate: %w", err)
+	restCfg.BearerToken = string(creds.bearerToken)
+	return nil
+}
+
+// configureExecAuth sets up a client-go credential plugin, which client-go runs whenever it needs new credentials
+func configureExecAuth(restCfg *rest.Config, execAuth *openchoreov1alpha1.ExecAuth) error {
+	if !isExecCommandAllowed(execAuth.Command) {
+		return fmt.Errorf("credential plugin %q is not allowed, allow it with --cluster-auth-exec-commands", execAuth.Command)
 	}
 
-	// Get client key (only inline value for now)
-	if mtlsAuth.ClientKey.Value == "" {
-		return fmt.Errorf("client key value is required for mTLS authentication")
+	apiVersion := execAuth.APIVersion
+	if apiVersion == "" {
+		apiVersion = "client.authentication.k8s.io/v1"
 	}
-	clientKeyData, err := base64.StdEncoding.DecodeString(mtlsAuth.ClientKey.Value)
-	if err != nil {
-		return fmt.Errorf("failed to decode base64 client key: %w", err)
+	env := make([]clientcmdapi.ExecEnvVar, 0, len(execAuth.Env))
+	for _, e := range execAuth.Env {
+		env = append(env, clientcmdapi.ExecEnvVar{Name: e.Name, Value: e.Value})
 	}
 
-	restCfg.TLSClientConfig.CertData = clientCertData
-	restCfg.TLSClientConfig.KeyData = clientKeyData
-
+	restCfg.ExecProvider = &clientcmdapi.ExecConfig{
+		Command:            execAuth.Command,
+		Args:               execAuth.Args,
+		Env:                env,
+		APIVersion:         apiVersion,
+		InteractiveMode:    clientcmdapi.NeverExecInteractiveMode,
+		ProvideClusterInfo: true,
+	}
 	return nil
 }
 
-// configureBearerAuth sets up bearer token authentication
-func configureBearerAuth(restCfg *rest.Config, bearerAuth *openchoreov1alpha1.ValueFrom) error {
-	if bearerAuth == nil {
-		return fmt.Errorf("bearer token authentication config is nil")
+// configureOIDCAuth sets up bearer tokens issued by an OpenID Connect provider with the client credentials
+// grant. Tokens are cached and requested again shortly before they expire.
+func configureOIDCAuth(restCfg *rest.Config, oidcAuth *openchoreov1alpha1.OIDCAuth, creds *clusterCredentials) error {
+	if oidcAuth.TokenURL == "" || oidcAuth.ClientID == "" {
+		return fmt.Errorf("token URL and client ID are required for OIDC authentication")
+	}
+	if len(creds.oidcClientSecret) == 0 {
+		return fmt.Errorf("client secret is required for OIDC authentication")
 	}
 
-	// Only handle inline token value for now
-	if bearerAuth.Value == "" {
-		return fmt.Errorf("bearer token value is required for bearer token authentication")
+	conf := &clientcredentials.Config{
+		ClientID:     oidcAuth.ClientID,
+		ClientSecret: string(creds.oidcClientSecret),
+		TokenURL:     oidcAuth.TokenURL,
+		Scopes:       oidcAuth.Scopes,
+	}
+	if oidcAuth.Audience != "" {
+		conf.EndpointParams = map[string][]string{"audience": {oidcAuth.Audience}}
 	}
+	tokenSource := conf.TokenSource(context.Background())
 
-	restCfg.BearerToken = bearerAuth.Value
+	restCfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return &oauth2.Transport{Source: tokenSource, Base: rt}
+	}
 	return nil
 }
 
-// makeClientKey generates a unique key for the client cache.
-func makeClientKey(orgName, name string) string {
-	return fmt.Sprintf("%s/%s", orgName, name)
+// makeClientKey generates a unique key for the client cache. The kind of the plane is part of the key,
+// so that a DataPlane and a BuildPlane with the same name don't share a client.
+func makeClientKey(plane client.Object) string {
+	kind := "dataplane"
+	if _, ok := plane.(*openchoreov1alpha1.BuildPlane); ok {
+		kind = "buildplane"
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, plane.GetNamespace(), plane.GetName())
 }
 
-// GetK8sClient retrieves a Kubernetes client for the specified org and cluster.
+// GetK8sClient retrieves a Kubernetes client for the cluster of a DataPlane or BuildPlane. Credentials
+// referenced from secrets are read from the namespace of the plane with the given reader, unless the manager
+// reads secrets on its own (see ReadSecretsWith).
 func GetK8sClient(
+	ctx context.Context,
 	clientMgr *KubeMultiClientManager,
-	orgName, name string,
+	reader client.Reader,
+	plane client.Object,
 	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec,
 ) (client.Client, error) {
-	key := makeClientKey(orgName, name)
-	cl, err := clientMgr.GetClient(key, kubernetesCluster)
+	key := makeClientKey(plane)
+	clusterHash, err := clusterHash(plane.GetGeneration(), kubernetesCluster)
+	if err != nil {
+		return nil, err
+	}
+	if cl, ok := clientMgr.getUnchangedClient(ctx, key, clusterHash, plane.GetNamespace()); ok {
+		return cl, nil
+	}
+
+	if secretReader := clientMgr.getSecretReader(); secretReader != nil {
+		reader = secretReader
+	}
+	restCfg, creds, err := getRESTConfig(ctx, reader, plane, kubernetesCluster)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := clientMgr.getClient(key, cachedClient{
+		credentialsHash: credentialsHash(clusterHash, creds),
+		clusterHash:     clusterHash,
+		secretVersions:  creds.secretVersions,
+	}, restCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Kubernetes client: %w", err)
 	}
 	return cl, nil
 }
+
+// getRESTConfig resolves the credentials of the cluster of a DataPlane or BuildPlane and builds a REST config
+// for it, returning the resolved credentials along with it.
+func getRESTConfig(
+	ctx context.Context,
+	reader client.Reader,
+	plane client.Object,
+	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec,
+) (*rest.Config, *clusterCredentials, error) {
+	creds, err := resolveCredentials(ctx, reader, plane.GetNamespace(), kubernetesCluster)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve cluster credentials: %w", err)
+	}
+	restCfg, err := buildRESTConfig(kubernetesCluster, creds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build REST config: %w", err)
+	}
+	return restCfg, creds, nil
+}
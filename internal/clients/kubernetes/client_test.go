@@ -0,0 +1,350 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newDataPlane(cluster openchoreov1alpha1.KubernetesClusterSpec) *openchoreov1alpha1.DataPlane {
+	return &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: "org", Generation: 1},
+		Spec:       openchoreov1alpha1.DataPlaneSpec{KubernetesCluster: cluster},
+	}
+}
+
+func newSecret(name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "org"},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func TestResolveCredentials(t *testing.T) {
+	reader := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		newSecret("dp-tls", map[string]string{"ca.crt": "ca-pem", "tls.crt": "cert-pem", "tls.key": "key-pem"}),
+		newSecret("dp-token", map[string]string{"token": "sa-token", "custom": "custom-token"}),
+	).Build()
+
+	tests := []struct {
+		name      string
+		cluster   openchoreov1alpha1.KubernetesClusterSpec
+		want      clusterCredentials
+		expectErr bool
+	}{
+		{
+			name: "inline values",
+			cluster: openchoreov1alpha1.KubernetesClusterSpec{
+				TLS: openchoreov1alpha1.KubernetesTLS{CA: openchoreov1alpha1.ValueFrom{Value: base64.StdEncoding.EncodeToString([]byte("ca-pem"))}},
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					BearerToken: &openchoreov1alpha1.ValueFrom{Value: "inline-token"},
+				},
+			},
+			want: clusterCredentials{caData: []byte("ca-pem"), bearerToken: []byte("inline-token")},
+		},
+		{
+			name: "mTLS from secret with conventional keys",
+			cluster: openchoreov1alpha1.KubernetesClusterSpec{
+				TLS: openchoreov1alpha1.KubernetesTLS{CA: openchoreov1alpha1.ValueFrom{SecretRef: "dp-tls"}},
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					MTLS: &openchoreov1alpha1.MTLSAuth{
+						ClientCert: openchoreov1alpha1.ValueFrom{SecretRef: "dp-tls"},
+						ClientKey:  openchoreov1alpha1.ValueFrom{SecretRef: "dp-tls"},
+					},
+				},
+			},
+			want: clusterCredentials{caData: []byte("ca-pem"), clientCertData: []byte("cert-pem"), clientKeyData: []byte("key-pem")},
+		},
+		{
+			name: "token from secret with explicit key",
+			cluster: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-token", Key: "custom"},
+				},
+			},
+			want: clusterCredentials{bearerToken: []byte("custom-token")},
+		},
+		{
+			name: "missing secret",
+			cluster: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "missing"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing key",
+			cluster: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-tls"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid inline base64",
+			cluster: openchoreov1alpha1.KubernetesClusterSpec{
+				TLS: openchoreov1alpha1.KubernetesTLS{CA: openchoreov1alpha1.ValueFrom{Value: "not base64!"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := resolveCredentials(context.Background(), reader, "org", tt.cluster)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(creds.caData) != string(tt.want.caData) ||
+				string(creds.clientCertData) != string(tt.want.clientCertData) ||
+				string(creds.clientKeyData) != string(tt.want.clientKeyData) ||
+				string(creds.bearerToken) != string(tt.want.bearerToken) {
+				t.Errorf("Expected credentials %+v, got %+v", tt.want, *creds)
+			}
+		})
+	}
+}
+
+func TestBuildRESTConfig(t *testing.T) {
+	t.Run("exec command must be allowed", func(t *testing.T) {
+		cluster := openchoreov1alpha1.KubernetesClusterSpec{
+			Server: "https://dp.example.com",
+			Auth: openchoreov1alpha1.KubernetesAuth{
+				Exec: &openchoreov1alpha1.ExecAuth{Command: "aws", Args: []string{"eks", "get-token"}},
+			},
+		}
+
+		SetAllowedExecCommands(nil)
+		if _, err := buildRESTConfig(cluster, &clusterCredentials{}); err == nil {
+			t.Fatal("Expected exec authentication to be rejected when the command is not allowed")
+		}
+
+		SetAllowedExecCommands([]string{"aws"})
+		defer SetAllowedExecCommands(nil)
+		restCfg, err := buildRESTConfig(cluster, &clusterCredentials{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if restCfg.ExecProvider == nil || restCfg.ExecProvider.Command != "aws" ||
+			restCfg.ExecProvider.APIVersion != "client.authentication.k8s.io/v1" {
+			t.Errorf("Unexpected exec provider %+v", restCfg.ExecProvider)
+		}
+	})
+
+	t.Run("OIDC wraps the transport", func(t *testing.T) {
+		cluster := openchoreov1alpha1.KubernetesClusterSpec{
+			Server: "https://dp.example.com",
+			Auth: openchoreov1alpha1.KubernetesAuth{
+				OIDC: &openchoreov1alpha1.OIDCAuth{TokenURL: "https://idp.example.com/token", ClientID: "openchoreo"},
+			},
+		}
+
+		if _, err := buildRESTConfig(cluster, &clusterCredentials{}); err == nil {
+			t.Fatal("Expected error without a client secret")
+		}
+		restCfg, err := buildRESTConfig(cluster, &clusterCredentials{oidcClientSecret: []byte("secret")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if restCfg.WrapTransport == nil {
+			t.Error("Expected the transport to be wrapped with an OIDC token source")
+		}
+	})
+
+	t.Run("no authentication method", func(t *testing.T) {
+		if _, err := buildRESTConfig(openchoreov1alpha1.KubernetesClusterSpec{}, &clusterCredentials{}); err == nil {
+			t.Error("Expected error without an authentication method")
+		}
+	})
+}
+
+func TestGetK8sClient(t *testing.T) {
+	tokenSecret := newSecret("dp-token", map[string]string{"token": "token-1"})
+	reader := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tokenSecret).Build()
+	dataPlane := newDataPlane(openchoreov1alpha1.KubernetesClusterSpec{
+		Server: "https://dp.example.com",
+		Auth: openchoreov1alpha1.KubernetesAuth{
+			BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-token"},
+		},
+	})
+	mgr := NewManager()
+	ctx := context.Background()
+
+	getClient := func() client.Client {
+		t.Helper()
+		cl, err := GetK8sClient(ctx, mgr, reader, dataPlane, dataPlane.Spec.KubernetesCluster)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return cl
+	}
+
+	first := getClient()
+	if getClient() != first {
+		t.Error("Expected the cached client to be reused while the credentials are unchanged")
+	}
+
+	// Rotating the referenced secret replaces the client
+	tokenSecret.Data["token"] = []byte("token-2")
+	if err := reader.Update(ctx, tokenSecret); err != nil {
+		t.Fatalf("Failed to update secret: %v", err)
+	}
+	rotated := getClient()
+	if rotated == first {
+		t.Error("Expected a new client after the secret was rotated")
+	}
+
+	// Updating the DataPlane replaces the client
+	dataPlane.Generation++
+	if getClient() == rotated {
+		t.Error("Expected a new client after the DataPlane was updated")
+	}
+
+	if len(mgr.clients) != 1 {
+		t.Errorf("Expected stale clients to be evicted, got %d cached clients", len(mgr.clients))
+	}
+}
+
+func TestReadSecretsWith(t *testing.T) {
+	tokenSecret := newSecret("dp-token", map[string]string{"token": "token-1"})
+	versionReader := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tokenSecret).Build()
+	secretReads := 0
+	secretReader := interceptor.NewClient(versionReader, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if _, ok := obj.(*corev1.Secret); ok {
+				secretReads++
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	})
+	dataPlane := newDataPlane(openchoreov1alpha1.KubernetesClusterSpec{
+		Server: "https://dp.example.com",
+		Auth: openchoreov1alpha1.KubernetesAuth{
+			BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-token"},
+		},
+	})
+	mgr := NewManager()
+	mgr.ReadSecretsWith(secretReader, versionReader)
+	ctx := context.Background()
+
+	getClient := func() client.Client {
+		t.Helper()
+		// Secrets are read with the reader of the manager rather than the given one
+		cl, err := GetK8sClient(ctx, mgr, nil, dataPlane, dataPlane.Spec.KubernetesCluster)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return cl
+	}
+
+	first := getClient()
+	if getClient() != first {
+		t.Error("Expected the cached client to be reused while the secret is unchanged")
+	}
+	if secretReads != 1 {
+		t.Errorf("Expected the secret to be read once while it is unchanged, got %d reads", secretReads)
+	}
+
+	// Rotating the referenced secret changes its resource version and replaces the client
+	tokenSecret.Data["token"] = []byte("token-2")
+	if err := versionReader.Update(ctx, tokenSecret); err != nil {
+		t.Fatalf("Failed to update secret: %v", err)
+	}
+	rotated := getClient()
+	if rotated == first {
+		t.Error("Expected a new client after the secret was rotated")
+	}
+	if secretReads != 2 {
+		t.Errorf("Expected the rotated secret to be read, got %d reads", secretReads)
+	}
+
+	// Updating the DataPlane resolves the credentials again
+	dataPlane.Generation++
+	getClient()
+	if secretReads != 3 {
+		t.Errorf("Expected the secret to be read after the DataPlane was updated, got %d reads", secretReads)
+	}
+
+	// Deleting the referenced secret stops the client from being reused
+	if err := versionReader.Delete(ctx, tokenSecret); err != nil {
+		t.Fatalf("Failed to delete secret: %v", err)
+	}
+	if _, err := GetK8sClient(ctx, mgr, nil, dataPlane, dataPlane.Spec.KubernetesCluster); err == nil {
+		t.Error("Expected an error after the secret was deleted")
+	}
+}
+
+func TestRemoveClientsOnDelete(t *testing.T) {
+	cluster := openchoreov1alpha1.KubernetesClusterSpec{
+		Server: "https://plane.example.com",
+		Auth: openchoreov1alpha1.KubernetesAuth{
+			BearerToken: &openchoreov1alpha1.ValueFrom{Value: "token"},
+		},
+	}
+	dataPlane := newDataPlane(cluster)
+	buildPlane := &openchoreov1alpha1.BuildPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: "org", Generation: 1},
+		Spec:       openchoreov1alpha1.BuildPlaneSpec{KubernetesCluster: cluster},
+	}
+	mgr := NewManager()
+	ctx := context.Background()
+
+	informers := &informertest.FakeInformers{}
+	if err := mgr.RemoveClientsOnDelete(ctx, informers); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, plane := range []client.Object{dataPlane, buildPlane} {
+		if _, err := GetK8sClient(ctx, mgr, nil, plane, cluster); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// A DataPlane and a BuildPlane with the same name have clients of their own
+	if len(mgr.clients) != 2 {
+		t.Fatalf("Expected 2 cached clients, got %d", len(mgr.clients))
+	}
+
+	dataPlaneInformer, err := informers.FakeInformerFor(ctx, dataPlane)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	dataPlaneInformer.Delete(dataPlane)
+	if _, ok := mgr.clients[makeClientKey(dataPlane)]; ok {
+		t.Error("Expected the client of the deleted DataPlane to be evicted")
+	}
+	if _, ok := mgr.clients[makeClientKey(buildPlane)]; !ok {
+		t.Error("Expected the client of the BuildPlane to be kept")
+	}
+
+	// Deletions observed as tombstones evict the client as well
+	mgr.removeDeletedClient(toolscache.DeletedFinalStateUnknown{Key: "org/dp", Obj: buildPlane})
+	if len(mgr.clients) != 0 {
+		t.Errorf("Expected all clients to be evicted, got %d cached clients", len(mgr.clients))
+	}
+}
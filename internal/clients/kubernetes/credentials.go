@@ -0,0 +1,249 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"slices"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Conventional secret keys of the cluster credentials. They match the keys of the kubernetes.io/tls and
+// kubernetes.io/service-account-token secret types, so that those secrets can be referenced without a key.
+const (
+	secretKeyCA           = "ca.crt"
+	secretKeyClientCert   = "tls.crt"
+	secretKeyClientKey    = "tls.key"
+	secretKeyToken        = "token"
+	secretKeyClientSecret = "client-secret"
+)
+
+var (
+	execCommandsMu      sync.RWMutex
+	allowedExecCommands []string
+)
+
+// SetAllowedExecCommands sets the credential plugin commands that clusters may run with exec authentication.
+// As the commands run in the process connecting to the cluster, exec authentication is rejected for every
+// command that is not explicitly allowed.
+func SetAllowedExecCommands(commands []string) {
+	execCommandsMu.Lock()
+	defer execCommandsMu.Unlock()
+	allowedExecCommands = slices.Clone(commands)
+}
+
+func isExecCommandAllowed(command string) bool {
+	execCommandsMu.RLock()
+	defer execCommandsMu.RUnlock()
+	return slices.Contains(allowedExecCommands, command)
+}
+
+// clusterCredentials holds the credentials of a cluster resolved from inline values and secrets
+type clusterCredentials struct {
+	caData           []byte
+	clientCertData   []byte
+	clientKeyData    []byte
+	bearerToken      []byte
+	oidcClientSecret []byte
+
+	// secretVersions holds the resource versions of the secrets the credentials were resolved from, by name
+	secretVersions map[string]string
+}
+
+// resolveCredentials resolves the credentials of a cluster. Secrets are read from the namespace of the
+// DataPlane or BuildPlane defining the cluster.
+func resolveCredentials(
+	ctx context.Context,
+	reader client.Reader,
+	namespace string,
+	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec,
+) (*clusterCredentials, error) {
+	r := &valueResolver{ctx: ctx, reader: reader, namespace: namespace}
+	creds := &clusterCredentials{}
+	var err error
+
+	// Inline certificates and keys are base64 encoded, while secrets hold them as they are
+	if creds.caData, err = r.resolve(kubernetesCluster.TLS.CA, secretKeyCA, true); err != nil {
+		return nil, fmt.Errorf("failed to resolve CA certificate: %w", err)
+	}
+
+	auth := kubernetesCluster.Auth
+	if auth.MTLS != nil {
+		if creds.clientCertData, err = r.resolve(auth.MTLS.ClientCert, secretKeyClientCert, true); err != nil {
+			return nil, fmt.Errorf("failed to resolve client certificate: %w", err)
+		}
+		if creds.clientKeyData, err = r.resolve(auth.MTLS.ClientKey, secretKeyClientKey, true); err != nil {
+			return nil, fmt.Errorf("failed to resolve client key: %w", err)
+		}
+	}
+	if auth.BearerToken != nil {
+		if creds.bearerToken, err = r.resolve(*auth.BearerToken, secretKeyToken, false); err != nil {
+			return nil, fmt.Errorf("failed to resolve bearer token: %w", err)
+		}
+	}
+	if auth.OIDC != nil {
+		if creds.oidcClientSecret, err = r.resolve(auth.OIDC.ClientSecret, secretKeyClientSecret, false); err != nil {
+			return nil, fmt.Errorf("failed to resolve OIDC client secret: %w", err)
+		}
+	}
+
+	creds.secretVersions = make(map[string]string, len(r.secrets))
+	for name, secret := range r.secrets {
+		creds.secretVersions[name] = secret.ResourceVersion
+	}
+	return creds, nil
+}
+
+// valueResolver resolves values from secrets, reading each secret once
+type valueResolver struct {
+	ctx       context.Context
+	reader    client.Reader
+	namespace string
+	secrets   map[string]*corev1.Secret
+}
+
+func (r *valueResolver) resolve(value openchoreov1alpha1.ValueFrom, defaultKey string, base64Inline bool) ([]byte, error) {
+	if value.SecretRef == "" {
+		if value.Value == "" || !base64Inline {
+			return []byte(value.Value), nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 value: %w", err)
+		}
+		return decoded, nil
+	}
+
+	secret, err := r.secret(value.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	key := value.Key
+	if key == "" {
+		key = defaultKey
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no key %q", r.namespace, value.SecretRef, key)
+	}
+	return data, nil
+}
+
+func (r *valueResolver) secret(name string) (*corev1.Secret, error) {
+	if secret, ok := r.secrets[name]; ok {
+		return secret, nil
+	}
+	if r.reader == nil {
+		return nil, fmt.Errorf("cannot read secret %s/%s without a client", r.namespace, name)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.reader.Get(r.ctx, client.ObjectKey{Namespace: r.namespace, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", r.namespace, name, err)
+	}
+	if r.secrets == nil {
+		r.secrets = make(map[string]*corev1.Secret)
+	}
+	r.secrets[name] = secret
+	return secret, nil
+}
+
+// clusterHash identifies the configuration of a cluster, so that cached clients are replaced once the
+// DataPlane or BuildPlane is updated.
+func clusterHash(generation int64, kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (string, error) {
+	spec, err := json.Marshal(kubernetesCluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cluster spec: %w", err)
+	}
+
+	h := sha256.New()
+	writeHashPart(h, []byte(strconv.FormatInt(generation, 10)))
+	writeHashPart(h, spec)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// credentialsHash identifies a cluster configuration along with its resolved credentials, so that cached
+// clients are replaced once a referenced secret is rotated as well.
+func credentialsHash(clusterHash string, creds *clusterCredentials) string {
+	h := sha256.New()
+	for _, part := range [][]byte{
+		[]byte(clusterHash),
+		creds.caData,
+		creds.clientCertData,
+		creds.clientKeyData,
+		creds.bearerToken,
+		creds.oidcClientSecret,
+	} {
+		writeHashPart(h, part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeHashPart writes a length prefixed part, so that moving bytes between parts changes the hash
+func writeHashPart(h hash.Hash, part []byte) {
+	_, _ = fmt.Fprintf(h, "%d:", len(part))
+	_, _ = h.Write(part)
+}
+
+// ReadSecretsWith makes the manager read the secrets referenced by the clusters with secretReader, e.g. the API
+// reader of the controller manager, rather than with the reader given to GetK8sClient. A cached client is then
+// reused without reading its secrets again while their resource versions, read with versionReader, are unchanged.
+// versionReader is meant to be a cache holding the metadata of secrets, such as the cache of the controller
+// manager, so that the data of secrets is never cached.
+func (m *KubeMultiClientManager) ReadSecretsWith(secretReader, versionReader client.Reader) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.secretReader = secretReader
+	m.versionReader = versionReader
+}
+
+// getSecretReader returns the reader set with ReadSecretsWith, or nil if the manager does not read secrets on its own.
+func (m *KubeMultiClientManager) getSecretReader() client.Reader {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.secretReader
+}
+
+// getUnchangedClient returns the cached client of the key when it was created for the same cluster
+// configuration and none of the secrets it was created from changed since. Without the versions of secrets,
+// a change cannot be ruled out and no client is returned.
+func (m *KubeMultiClientManager) getUnchangedClient(ctx context.Context, key, clusterHash, namespace string) (client.Client, bool) {
+	m.mu.Lock()
+	versionReader := m.versionReader
+	var cached cachedClient
+	if c, exists := m.clients[key]; exists {
+		cached = *c
+	}
+	m.mu.Unlock()
+	if versionReader == nil || cached.client == nil || cached.clusterHash != clusterHash {
+		return nil, false
+	}
+
+	for name, version := range cached.secretVersions {
+		secret := &metav1.PartialObjectMetadata{}
+		secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		if err := versionReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+			return nil, false
+		}
+		if secret.ResourceVersion != version {
+			return nil, false
+		}
+	}
+	return cached.client, true
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// RemoveClientsOnDelete evicts the cached client of a DataPlane or BuildPlane once it is deleted, so that
+// the client stops holding its credentials and a plane created again with the same name gets a new client.
+// Deletions are observed with the informers of the given cache, e.g. the cache of the controller manager.
+func (m *KubeMultiClientManager) RemoveClientsOnDelete(ctx context.Context, informers cache.Informers) error {
+	for _, plane := range []client.Object{&openchoreov1alpha1.DataPlane{}, &openchoreov1alpha1.BuildPlane{}} {
+		informer, err := informers.GetInformer(ctx, plane)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %T: %w", plane, err)
+		}
+		if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			DeleteFunc: m.removeDeletedClient,
+		}); err != nil {
+			return fmt.Errorf("failed to add event handler for %T: %w", plane, err)
+		}
+	}
+	return nil
+}
+
+// removeDeletedClient evicts the cached client of a deleted DataPlane or BuildPlane.
+func (m *KubeMultiClientManager) removeDeletedClient(obj any) {
+	// The final state of the plane is unknown if the deletion was missed while disconnected from the API server
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if plane, ok := obj.(client.Object); ok {
+		m.RemoveClient(makeClientKey(plane))
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// probeTimeout bounds each request made to a cluster while probing it
+const probeTimeout = 10 * time.Second
+
+// ClusterCapability is a capability of a cluster that OpenChoreo relies on, identified by the custom
+// resources it installs.
+type ClusterCapability struct {
+	Name      string
+	Resources []schema.GroupVersionResource
+}
+
+var (
+	CapabilityGatewayAPI = ClusterCapability{
+		Name: "GatewayAPI",
+		Resources: []schema.GroupVersionResource{
+			{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"},
+			{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"},
+		},
+	}
+	CapabilityEnvoyGateway = ClusterCapability{
+		Name: "EnvoyGateway",
+		Resources: []schema.GroupVersionResource{
+			{Group: "gateway.envoyproxy.io", Version: "v1alpha1", Resource: "securitypolicies"},
+			{Group: "gateway.envoyproxy.io", Version: "v1alpha1", Resource: "backendtrafficpolicies"},
+			{Group: "gateway.envoyproxy.io", Version: "v1alpha1", Resource: "httproutefilters"},
+		},
+	}
+	CapabilityCilium = ClusterCapability{
+		Name: "Cilium",
+		Resources: []schema.GroupVersionResource{
+			{Group: "cilium.io", Version: "v2", Resource: "ciliumnetworkpolicies"},
+		},
+	}
+	CapabilityExternalSecrets = ClusterCapability{
+		Name: "ExternalSecrets",
+		Resources: []schema.GroupVersionResource{
+			{Group: "external-secrets.io", Version: "v1", Resource: "externalsecrets"},
+			{Group: "external-secrets.io", Version: "v1", Resource: "clustersecretstores"},
+		},
+	}
+	CapabilityArgoWorkflows = ClusterCapability{
+		Name: "ArgoWorkflows",
+		Resources: []schema.GroupVersionResource{
+			{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"},
+		},
+	}
+
+	// DataPlaneCapabilities are the capabilities required to deploy components to a data plane
+	DataPlaneCapabilities = []ClusterCapability{
+		CapabilityGatewayAPI,
+		CapabilityEnvoyGateway,
+		CapabilityCilium,
+		CapabilityExternalSecrets,
+	}
+
+	// BuildPlaneCapabilities are the capabilities required to run builds in a build plane
+	BuildPlaneCapabilities = []ClusterCapability{
+		CapabilityArgoWorkflows,
+	}
+)
+
+// ClusterProbeResult is the outcome of probing a reachable cluster
+type ClusterProbeResult struct {
+	// ServerVersion is the Kubernetes version reported by the API server
+	ServerVersion string
+	// Capabilities holds the status of each probed capability, in the order they were given
+	Capabilities []openchoreov1alpha1.ClusterCapabilityStatus
+}
+
+// ProbeCluster connects to the cluster of a DataPlane or BuildPlane with its current credentials and checks
+// which of the given capabilities are installed in it. An error is returned when the cluster cannot be reached.
+// Probing always uses a new connection, so that it reflects the credentials as they are now.
+func ProbeCluster(
+	ctx context.Context,
+	reader client.Reader,
+	plane client.Object,
+	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec,
+	capabilities []ClusterCapability,
+) (*ClusterProbeResult, error) {
+	restCfg, _, err := getRESTConfig(ctx, reader, plane, kubernetesCluster)
+	if err != nil {
+		return nil, err
+	}
+	restCfg.Timeout = probeTimeout
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+	return probeDiscovery(discoveryClient, capabilities)
+}
+
+// probeDiscovery probes a cluster through its discovery API
+func probeDiscovery(discoveryClient discovery.DiscoveryInterface, capabilities []ClusterCapability) (*ClusterProbeResult, error) {
+	serverVersion, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the API server: %w", err)
+	}
+
+	// Discover each group version once, as capabilities may share them
+	served := make(map[schema.GroupVersion]map[string]bool)
+	isServed := func(gvr schema.GroupVersionResource) (bool, error) {
+		gv := gvr.GroupVersion()
+		resources, ok := served[gv]
+		if !ok {
+			resources = make(map[string]bool)
+			list, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
+			if err != nil && !apierrors.IsNotFound(err) {
+				return false, fmt.Errorf("failed to discover resources of %s: %w", gv, err)
+			}
+			if list != nil {
+				for _, resource := range list.APIResources {
+					resources[resource.Name] = true
+				}
+			}
+			served[gv] = resources
+		}
+		return resources[gvr.Resource], nil
+	}
+
+	result := &ClusterProbeResult{
+		ServerVersion: serverVersion.GitVersion,
+		Capabilities:  make([]openchoreov1alpha1.ClusterCapabilityStatus, 0, len(capabilities)),
+	}
+	for _, capability := range capabilities {
+		status := openchoreov1alpha1.ClusterCapabilityStatus{Name: capability.Name}
+		for _, gvr := range capability.Resources {
+			ok, err := isServed(gvr)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				status.MissingResources = append(status.MissingResources, gvr.GroupResource().String())
+			}
+		}
+		status.Available = len(status.MissingResources) == 0
+		result.Capabilities = append(result.Capabilities, status)
+	}
+	return result, nil
+}
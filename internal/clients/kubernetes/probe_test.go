@@ -0,0 +1,73 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newFakeDiscovery(resources ...*metav1.APIResourceList) *fakediscovery.FakeDiscovery {
+	return &fakediscovery.FakeDiscovery{
+		Fake:               &clienttesting.Fake{Resources: resources},
+		FakedServerVersion: &version.Info{GitVersion: "v1.32.3"},
+	}
+}
+
+func TestProbeDiscovery(t *testing.T) {
+	discoveryClient := newFakeDiscovery(
+		&metav1.APIResourceList{
+			GroupVersion: "gateway.networking.k8s.io/v1",
+			APIResources: []metav1.APIResource{{Name: "gateways"}, {Name: "httproutes"}},
+		},
+		&metav1.APIResourceList{
+			GroupVersion: "gateway.envoyproxy.io/v1alpha1",
+			APIResources: []metav1.APIResource{{Name: "securitypolicies"}},
+		},
+	)
+
+	result, err := probeDiscovery(discoveryClient, []ClusterCapability{
+		CapabilityGatewayAPI,
+		CapabilityEnvoyGateway,
+		CapabilityArgoWorkflows,
+	})
+	if err != nil {
+		t.Fatalf("probeDiscovery() error = %v", err)
+	}
+	if result.ServerVersion != "v1.32.3" {
+		t.Errorf("ServerVersion = %q, want v1.32.3", result.ServerVersion)
+	}
+
+	want := []openchoreov1alpha1.ClusterCapabilityStatus{
+		{Name: "GatewayAPI", Available: true},
+		{
+			Name:             "EnvoyGateway",
+			MissingResources: []string{"backendtrafficpolicies.gateway.envoyproxy.io", "httproutefilters.gateway.envoyproxy.io"},
+		},
+		{Name: "ArgoWorkflows", MissingResources: []string{"workflows.argoproj.io"}},
+	}
+	if !reflect.DeepEqual(result.Capabilities, want) {
+		t.Errorf("Capabilities = %+v, want %+v", result.Capabilities, want)
+	}
+}
+
+func TestProbeDiscoveryUnreachable(t *testing.T) {
+	discoveryClient := newFakeDiscovery()
+	discoveryClient.PrependReactor("get", "version", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+
+	if _, err := probeDiscovery(discoveryClient, DataPlaneCapabilities); err == nil {
+		t.Fatal("probeDiscovery() expected an error for an unreachable cluster")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Client is a client for the Observer API of a data plane
+type Client struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the Observer API configured on a data plane
+func NewClient(config openchoreov1alpha1.ObserverAPI) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(config.URL, "/"),
+		username:   config.Authentication.BasicAuth.Username,
+		password:   config.Authentication.BasicAuth.Password,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// ComponentMetricsRequest selects the metrics of a component in an environment for a time range.
+// The IDs are the UIDs carried by the pod labels of the component.
+type ComponentMetricsRequest struct {
+	ComponentID   string
+	EnvironmentID string
+	ProjectID     string
+	StartTime     time.Time
+	EndTime       time.Time
+}
+
+// TimeValuePoint is a single point of a metric time series
+type TimeValuePoint struct {
+	Time  string  `json:"time"`
+	Value float64 `json:"value"`
+}
+
+// HTTPMetrics holds the HTTP request rates of a component as time series
+type HTTPMetrics struct {
+	RequestCount             []TimeValuePoint `json:"requestCount"`
+	SuccessfulRequestCount   []TimeValuePoint `json:"successfulRequestCount"`
+	UnsuccessfulRequestCount []TimeValuePoint `json:"unsuccessfulRequestCount"`
+}
+
+// metricsRequest is the request body of the Observer metrics API
+type metricsRequest struct {
+	ComponentID   string `json:"componentId,omitempty"`
+	EnvironmentID string `json:"environmentId"`
+	ProjectID     string `json:"projectId"`
+	StartTime     string `json:"startTime,omitempty"`
+	EndTime       string `json:"endTime,omitempty"`
+}
+
+// GetComponentHTTPMetrics retrieves the HTTP metrics of a component
+func (c *Client) GetComponentHTTPMetrics(ctx context.Context, req ComponentMetricsRequest) (*HTTPMetrics, error) {
+	body, err := json.Marshal(metricsRequest{
+		ComponentID:   req.ComponentID,
+		EnvironmentID: req.EnvironmentID,
+		ProjectID:     req.ProjectID,
+		StartTime:     req.StartTime.UTC().Format(time.RFC3339),
+		EndTime:       req.EndTime.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metrics request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/metrics/component/http", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.username != "" {
+		httpReq.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query observer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("observer returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	metrics := &HTTPMetrics{}
+	if err := json.NewDecoder(resp.Body).Decode(metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode observer response: %w", err)
+	}
+	return metrics, nil
+}
+
+// ErrorRate returns the percentage of unsuccessful requests over the time series.
+// The second return value is false when no requests were observed.
+func (m *HTTPMetrics) ErrorRate() (float64, bool) {
+	total := sumValues(m.RequestCount)
+	if total <= 0 {
+		return 0, false
+	}
+	return sumValues(m.UnsuccessfulRequestCount) / total * 100, true
+}
+
+func sumValues(points []TimeValuePoint) float64 {
+	var sum float64
+	for _, point := range points {
+		sum += point.Value
+	}
+	return sum
+}
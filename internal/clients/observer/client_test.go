@@ -0,0 +1,86 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestGetComponentHTTPMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/metrics/component/http" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+			t.Errorf("expected basic auth credentials, got %q/%q", username, password)
+		}
+
+		var req metricsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.ComponentID != "component-uid" || req.EnvironmentID != "env-uid" || req.ProjectID != "project-uid" {
+			t.Errorf("unexpected request body: %+v", req)
+		}
+
+		_ = json.NewEncoder(w).Encode(HTTPMetrics{
+			RequestCount:             []TimeValuePoint{{Value: 10}, {Value: 30}},
+			UnsuccessfulRequestCount: []TimeValuePoint{{Value: 2}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(openchoreov1alpha1.ObserverAPI{
+		URL: server.URL + "/",
+		Authentication: openchoreov1alpha1.ObserverAuthentication{
+			BasicAuth: openchoreov1alpha1.BasicAuthCredentials{Username: "user", Password: "pass"},
+		},
+	})
+
+	end := time.Now()
+	metrics, err := client.GetComponentHTTPMetrics(context.Background(), ComponentMetricsRequest{
+		ComponentID:   "component-uid",
+		EnvironmentID: "env-uid",
+		ProjectID:     "project-uid",
+		StartTime:     end.Add(-5 * time.Minute),
+		EndTime:       end,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rate, ok := metrics.ErrorRate()
+	if !ok {
+		t.Fatal("expected requests to be observed")
+	}
+	if rate != 5 {
+		t.Errorf("ErrorRate() = %v, want 5", rate)
+	}
+}
+
+func TestGetComponentHTTPMetrics_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "metrics backend unavailable", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(openchoreov1alpha1.ObserverAPI{URL: server.URL})
+	if _, err := client.GetComponentHTTPMetrics(context.Background(), ComponentMetricsRequest{}); err == nil {
+		t.Fatal("expected an error for a failed request")
+	}
+}
+
+func TestErrorRate_NoRequests(t *testing.T) {
+	metrics := &HTTPMetrics{RequestCount: []TimeValuePoint{{Value: 0}}}
+	if _, ok := metrics.ErrorRate(); ok {
+		t.Error("expected no error rate without requests")
+	}
+}
@@ -8,4 +8,13 @@ package controller
 const (
 	AnnotationKeyDisplayName = "openchoreo.dev/display-name"
 	AnnotationKeyDescription = "openchoreo.dev/description"
+
+	// AnnotationKeySkipGitOpsCleanup lets a ReleaseBinding be deleted without removing its exported
+	// resources from the GitOps repository, e.g. when the removal keeps failing. Set it to "true".
+	AnnotationKeySkipGitOpsCleanup = "openchoreo.dev/skip-gitops-cleanup"
+
+	// AnnotationKeyMaterializeSecret makes the controller create an ExternalSecret named after the
+	// SecretReference in every data plane namespace where it is used. Set it to "true". By default the
+	// ComponentType templates render the ExternalSecrets and the controller only reports the status.
+	AnnotationKeyMaterializeSecret = "openchoreo.dev/materialize-secret"
 )
@@ -33,6 +33,8 @@ type Reconciler struct {
 	// IsGitOpsMode indicates whether the controller is running in GitOps mode
 	IsGitOpsMode bool
 	Scheme       *runtime.Scheme
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
 	engine       *Builder
 }
 
@@ -122,7 +124,10 @@ const (
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.engine == nil {
-		r.engine = NewBuilder(r.Client, kubernetesClient.NewManager())
+		if r.K8sClientMgr == nil {
+			r.K8sClientMgr = kubernetesClient.NewManager()
+		}
+		r.engine = NewBuilder(r.Client, r.K8sClientMgr)
 
 		// Register build engines here to avoid circular imports
 		r.engine.registerBuildEngines()
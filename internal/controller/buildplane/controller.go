@@ -5,44 +5,113 @@ package buildplane
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 // BuildPlaneReconciler reconciles a BuildPlane object
 type BuildPlaneReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// ProbeInterval is how often the buildplane cluster is probed, controller.DefaultClusterProbeInterval if unset
+	ProbeInterval time.Duration
+	// SecretReader reads the secrets holding the credentials of the buildplane cluster, the client if unset
+	SecretReader client.Reader
+
+	// probeCluster replaces the cluster probe in tests
+	probeCluster controller.ClusterProbeFunc
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=buildplanes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=buildplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=buildplanes/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the BuildPlane object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile probes the cluster of the BuildPlane periodically and records whether it is reachable
+// and has the capabilities required to run builds.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
 func (r *BuildPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	logger := logf.FromContext(ctx)
+
+	buildPlane := &openchoreov1alpha1.BuildPlane{}
+	if err := r.Get(ctx, req.NamespacedName, buildPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("BuildPlane resource not found. Ignoring since it must be deleted.")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get BuildPlane")
+		return ctrl.Result{}, err
+	}
+
+	if !buildPlane.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// The buildplane is probed periodically, and again as soon as its spec changes
+	probeInterval := r.probeInterval()
+	if delay := controller.ClusterProbeDelay(buildPlane.Status.Cluster, buildPlane.Status.ObservedGeneration,
+		buildPlane.Generation, probeInterval); delay > 0 {
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+
+	buildPlane.Status.ObservedGeneration = buildPlane.Generation
 
-	// TODO(user): your logic here
+	probeCluster := r.probeCluster
+	if probeCluster == nil {
+		probeCluster = kubernetesClient.ProbeCluster
+	}
 
-	return ctrl.Result{}, nil
+	wasReachable := meta.IsStatusConditionTrue(buildPlane.Status.Conditions, string(controller.ConditionClusterReachable))
+	secretReader := r.SecretReader
+	if secretReader == nil {
+		secretReader = r.Client
+	}
+	result, err := probeCluster(ctx, secretReader, buildPlane, buildPlane.Spec.KubernetesCluster, kubernetesClient.BuildPlaneCapabilities)
+	if err != nil {
+		logger.Info("Failed to probe buildplane cluster", "error", err)
+		if wasReachable && r.Recorder != nil {
+			r.Recorder.Event(buildPlane, corev1.EventTypeWarning, string(controller.ReasonClusterUnreachable), err.Error())
+		}
+	}
+	buildPlane.Status.Cluster = controller.SetClusterProbeConditions(buildPlane, buildPlane.Status.Cluster, result, err)
+
+	// The probe time always changes, so the status is updated on every probe
+	if err := r.Status().Update(ctx, buildPlane); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update buildplane status: %w", err)
+	}
+
+	return ctrl.Result{RequeueAfter: probeInterval}, nil
+}
+
+func (r *BuildPlaneReconciler) probeInterval() time.Duration {
+	if r.ProbeInterval > 0 {
+		return r.ProbeInterval
+	}
+	return controller.DefaultClusterProbeInterval
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *BuildPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("buildplane-controller")
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.BuildPlane{}).
 		Named("buildplane").
@@ -5,15 +5,21 @@ package buildplane
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 var _ = Describe("BuildPlane Controller", func() {
@@ -55,16 +61,31 @@ var _ = Describe("BuildPlane Controller", func() {
 		It("should successfully reconcile the resource", func() {
 			By("Reconciling the created resource")
 			controllerReconciler := &BuildPlaneReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(100),
+				probeCluster: func(context.Context, client.Reader, client.Object,
+					openchoreov1alpha1.KubernetesClusterSpec, []kubernetesClient.ClusterCapability,
+				) (*kubernetesClient.ClusterProbeResult, error) {
+					return nil, fmt.Errorf("connection refused")
+				},
 			}
 
-			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+			Expect(result.RequeueAfter).To(Equal(controller.DefaultClusterProbeInterval))
+
+			By("Checking the probe result is recorded in the status")
+			resource := &openchoreov1alpha1.BuildPlane{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Cluster).NotTo(BeNil())
+			Expect(resource.Status.Cluster.LastProbeTime).NotTo(BeNil())
+			reachable := meta.FindStatusCondition(resource.Status.Conditions, string(controller.ConditionClusterReachable))
+			Expect(reachable).NotTo(BeNil())
+			Expect(reachable.Status).To(Equal(metav1.ConditionFalse))
+			Expect(reachable.Reason).To(Equal(string(controller.ReasonClusterUnreachable)))
 		})
 	})
 })
@@ -0,0 +1,110 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+// This file contains the types and functions to probe the clusters of DataPlanes and BuildPlanes.
+
+// DefaultClusterProbeInterval is how often the cluster of a DataPlane or BuildPlane is probed by default
+const DefaultClusterProbeInterval = 5 * time.Minute
+
+const (
+	// ConditionClusterReachable represents whether the API server of the cluster can be reached with the
+	// configured credentials
+	ConditionClusterReachable ConditionType = "ClusterReachable"
+
+	// ConditionCapabilitiesAvailable represents whether the capabilities OpenChoreo relies on are installed
+	// in the cluster
+	ConditionCapabilitiesAvailable ConditionType = "CapabilitiesAvailable"
+)
+
+const (
+	// ReasonClusterReachable is the reason used when the API server of the cluster responded to the probe
+	ReasonClusterReachable ConditionReason = "ClusterReachable"
+
+	// ReasonClusterUnreachable is the reason used when the cluster could not be probed
+	ReasonClusterUnreachable ConditionReason = "ClusterUnreachable"
+
+	// ReasonCapabilitiesAvailable is the reason used when all the required capabilities are installed
+	ReasonCapabilitiesAvailable ConditionReason = "CapabilitiesAvailable"
+
+	// ReasonCapabilitiesMissing is the reason used when some of the required capabilities are not installed
+	ReasonCapabilitiesMissing ConditionReason = "CapabilitiesMissing"
+)
+
+// ClusterProbeFunc probes the cluster of a DataPlane or BuildPlane. It matches kubernetesClient.ProbeCluster,
+// which reconcilers use unless they are given another implementation.
+type ClusterProbeFunc func(
+	ctx context.Context,
+	reader client.Reader,
+	plane client.Object,
+	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec,
+	capabilities []kubernetesClient.ClusterCapability,
+) (*kubernetesClient.ClusterProbeResult, error)
+
+// ClusterProbeDelay returns how long to wait before the cluster should be probed again, which is zero when the
+// cluster was never probed, the spec changed since the last probe or the last probe is older than the interval.
+func ClusterProbeDelay(cluster *openchoreov1alpha1.ClusterStatus, observedGeneration, generation int64,
+	interval time.Duration) time.Duration {
+	if cluster == nil || cluster.LastProbeTime == nil || observedGeneration != generation {
+		return 0
+	}
+	return max(interval-time.Since(cluster.LastProbeTime.Time), 0)
+}
+
+// SetClusterProbeConditions records the outcome of a cluster probe as the ClusterReachable and
+// CapabilitiesAvailable conditions of the object, and returns the updated cluster status. The server version
+// and capabilities of the last successful probe are kept when the cluster is unreachable.
+func SetClusterProbeConditions(
+	obj ConditionedObject,
+	current *openchoreov1alpha1.ClusterStatus,
+	result *kubernetesClient.ClusterProbeResult,
+	probeErr error,
+) *openchoreov1alpha1.ClusterStatus {
+	cluster := &openchoreov1alpha1.ClusterStatus{}
+	if current != nil {
+		cluster = current.DeepCopy()
+	}
+	now := metav1.Now()
+	cluster.LastProbeTime = &now
+
+	if probeErr != nil {
+		MarkFalseCondition(obj, ConditionClusterReachable, ReasonClusterUnreachable, probeErr.Error())
+		MarkUnknownCondition(obj, ConditionCapabilitiesAvailable, ReasonClusterUnreachable,
+			"Capabilities cannot be checked while the cluster is unreachable")
+		return cluster
+	}
+
+	cluster.ServerVersion = result.ServerVersion
+	cluster.Capabilities = result.Capabilities
+	MarkTrueCondition(obj, ConditionClusterReachable, ReasonClusterReachable,
+		fmt.Sprintf("Kubernetes API server %s is reachable", result.ServerVersion))
+
+	var missing []string
+	for _, capability := range result.Capabilities {
+		if !capability.Available {
+			missing = append(missing, fmt.Sprintf("%s (%s)", capability.Name, strings.Join(capability.MissingResources, ", ")))
+		}
+	}
+	if len(missing) > 0 {
+		MarkFalseCondition(obj, ConditionCapabilitiesAvailable, ReasonCapabilitiesMissing,
+			"Missing capabilities: "+strings.Join(missing, "; "))
+	} else {
+		MarkTrueCondition(obj, ConditionCapabilitiesAvailable, ReasonCapabilitiesAvailable,
+			"All required capabilities are installed")
+	}
+	return cluster
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+func TestClusterProbeDelay(t *testing.T) {
+	interval := 5 * time.Minute
+	probedAt := func(ago time.Duration) *openchoreov1alpha1.ClusterStatus {
+		return &openchoreov1alpha1.ClusterStatus{LastProbeTime: &metav1.Time{Time: time.Now().Add(-ago)}}
+	}
+
+	tests := []struct {
+		name       string
+		cluster    *openchoreov1alpha1.ClusterStatus
+		generation int64
+		wantProbe  bool
+	}{
+		{name: "never probed", cluster: nil, generation: 1, wantProbe: true},
+		{name: "recently probed", cluster: probedAt(time.Minute), generation: 1, wantProbe: false},
+		{name: "probe is stale", cluster: probedAt(10 * time.Minute), generation: 1, wantProbe: true},
+		{name: "spec changed", cluster: probedAt(time.Minute), generation: 2, wantProbe: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay := ClusterProbeDelay(tt.cluster, 1, tt.generation, interval)
+			if (delay == 0) != tt.wantProbe {
+				t.Errorf("ClusterProbeDelay() = %v, want probe %v", delay, tt.wantProbe)
+			}
+			if delay > interval {
+				t.Errorf("ClusterProbeDelay() = %v, want at most %v", delay, interval)
+			}
+		})
+	}
+}
+
+func TestSetClusterProbeConditions(t *testing.T) {
+	dataPlane := &openchoreov1alpha1.DataPlane{}
+	result := &kubernetesClient.ClusterProbeResult{
+		ServerVersion: "v1.32.3",
+		Capabilities: []openchoreov1alpha1.ClusterCapabilityStatus{
+			{Name: "GatewayAPI", Available: true},
+			{Name: "Cilium", MissingResources: []string{"ciliumnetworkpolicies.cilium.io"}},
+		},
+	}
+
+	cluster := SetClusterProbeConditions(dataPlane, nil, result, nil)
+	if cluster.ServerVersion != "v1.32.3" || len(cluster.Capabilities) != 2 || cluster.LastProbeTime == nil {
+		t.Fatalf("unexpected cluster status %+v", cluster)
+	}
+	if !meta.IsStatusConditionTrue(dataPlane.Status.Conditions, string(ConditionClusterReachable)) {
+		t.Errorf("expected %s to be true", ConditionClusterReachable)
+	}
+	capabilities := meta.FindStatusCondition(dataPlane.Status.Conditions, string(ConditionCapabilitiesAvailable))
+	if capabilities == nil || capabilities.Status != metav1.ConditionFalse ||
+		capabilities.Reason != string(ReasonCapabilitiesMissing) {
+		t.Errorf("unexpected %s condition %+v", ConditionCapabilitiesAvailable, capabilities)
+	}
+
+	// The last known server version and capabilities are kept while the cluster is unreachable
+	cluster = SetClusterProbeConditions(dataPlane, cluster, nil, errors.New("connection refused"))
+	if cluster.ServerVersion != "v1.32.3" || len(cluster.Capabilities) != 2 {
+		t.Errorf("expected the last known cluster status to be kept, got %+v", cluster)
+	}
+	reachable := meta.FindStatusCondition(dataPlane.Status.Conditions, string(ConditionClusterReachable))
+	if reachable == nil || reachable.Status != metav1.ConditionFalse || reachable.Message != "connection refused" {
+		t.Errorf("unexpected %s condition %+v", ConditionClusterReachable, reachable)
+	}
+	capabilities = meta.FindStatusCondition(dataPlane.Status.Conditions, string(ConditionCapabilitiesAvailable))
+	if capabilities == nil || capabilities.Status != metav1.ConditionUnknown {
+		t.Errorf("unexpected %s condition %+v", ConditionCapabilitiesAvailable, capabilities)
+	}
+}
@@ -370,6 +370,24 @@ func (r *Reconciler) collectSecretReferences(ctx context.Context, snapshot *open
 		}
 	}
 
+	// Collect the credentials of workload connections
+	for _, conn := range snapshot.Spec.Workload.Spec.Connections {
+		refName := pipelinecontext.ConnectionCredentialsRef(conn)
+		if refName == "" {
+			continue
+		}
+		if _, exists := secretRefs[refName]; !exists {
+			secretRef := &openchoreov1alpha1.SecretReference{}
+			if err := r.Get(ctx, client.ObjectKey{
+				Name:      refName,
+				Namespace: snapshot.Namespace,
+			}, secretRef); err != nil {
+				return nil, fmt.Errorf("failed to get SecretReference %s: %w", refName, err)
+			}
+			secretRefs[refName] = secretRef
+		}
+	}
+
 	// Collect from ComponentDeployment configuration overrides
 	if componentDeployment != nil && componentDeployment.Spec.ConfigurationOverrides != nil {
 		// Collect from env overrides
@@ -445,7 +463,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 
 	// Render resources using the shared pipeline instance
 	// The pipeline caches CEL environments, so subsequent reconciliations benefit from warm cache
-	renderOutput, err := r.Pipeline.Render(renderInput)
+	renderOutput, err := r.Pipeline.RenderWithContext(ctx, renderInput)
 	if err != nil {
 		msg := fmt.Sprintf("Failed to render resources: %v", err)
 		controller.MarkFalseCondition(componentDeployment, ConditionReleaseSynced,
@@ -5,34 +5,105 @@ package componentrelease
 
 import (
 	"context"
+	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 // Reconciler reconciles a ComponentRelease object
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// RetentionLimit is the number of most recent ComponentReleases kept per component.
+	// Older releases are deleted unless they are referenced by a ReleaseBinding, recorded in a
+	// ReleaseBinding's release history or are the component's latest release. A value of 0 disables pruning.
+	RetentionLimit int
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=components,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=promotionrequests,verbs=get;list;watch
 
-// Reconcile is part of the main kubernetes reconciliation loop
+// Reconcile validates the snapshot held by a ComponentRelease and prunes old releases of the same component.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	// No-op controller - ComponentReleases are managed externally
+	logger := log.FromContext(ctx)
+
+	release := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, req.NamespacedName, release); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get ComponentRelease")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !release.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// The spec of a ComponentRelease is immutable, so it only needs to be validated once per generation
+	if !isValidated(release) {
+		old := release.DeepCopy()
+
+		if errs := validateComponentRelease(release); len(errs) > 0 {
+			logger.Info("ComponentRelease snapshot is invalid", "errors", errs.ToAggregate().Error())
+			controller.MarkFalseCondition(release, ConditionReady, ReasonInvalid, errs.ToAggregate().Error())
+		} else {
+			controller.MarkTrueCondition(release, ConditionReady, ReasonValid,
+				"Component parameters and trait instances conform to the embedded schemas")
+		}
+
+		if err := controller.UpdateStatusConditions(ctx, r.Client, old, release); err != nil {
+			logger.Error(err, "Failed to update ComponentRelease status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if r.RetentionLimit > 0 {
+		if err := r.pruneComponentReleases(ctx, release); err != nil {
+			logger.Error(err, "Failed to prune ComponentReleases",
+				"component", release.Spec.Owner.ComponentName)
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// isValidated reports whether the Ready condition has been evaluated for the current generation.
+func isValidated(release *openchoreov1alpha1.ComponentRelease) bool {
+	cond := meta.FindStatusCondition(release.Status.Conditions, string(ConditionReady))
+	return cond != nil && cond.ObservedGeneration == release.Generation
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctx := context.Background()
+
+	if err := r.setupComponentReleaseOwnerIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup component release owner index: %w", err)
+	}
+
+	if err := r.setupReleaseBindingOwnerIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup release binding owner index: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ComponentRelease{}).
+		Watches(&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseForReleaseBinding)).
 		Named("componentrelease").
 		Complete(r)
 }
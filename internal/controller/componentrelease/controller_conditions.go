@@ -0,0 +1,26 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates that the ComponentRelease snapshot has been validated
+	// and can be bound to environments
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonValid indicates the component parameters and trait instances conform to the embedded schemas
+	ReasonValid controller.ConditionReason = "Valid"
+
+	// ReasonInvalid indicates the snapshot does not conform to the embedded ComponentType or Trait schemas
+	ReasonInvalid controller.ConditionReason = "Invalid"
+)
@@ -0,0 +1,141 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// pruneComponentReleases deletes the ComponentReleases of the release's component that fall outside
+// the retention limit. Releases referenced by a ReleaseBinding or recorded in its release history,
+// releases awaiting promotion approval and the component's latest release are never deleted.
+func (r *Reconciler) pruneComponentReleases(ctx context.Context, release *openchoreov1alpha1.ComponentRelease) error {
+	logger := log.FromContext(ctx)
+	ownerKey := makeOwnerKey(release.Spec.Owner.ProjectName, release.Spec.Owner.ComponentName)
+
+	var releases openchoreov1alpha1.ComponentReleaseList
+	if err := r.List(ctx, &releases,
+		client.InNamespace(release.Namespace),
+		client.MatchingFields{componentReleaseOwnerIndex: ownerKey}); err != nil {
+		return fmt.Errorf("failed to list component releases: %w", err)
+	}
+
+	if len(releases.Items) <= r.RetentionLimit {
+		return nil
+	}
+
+	protected, err := r.findProtectedReleases(ctx, release)
+	if err != nil {
+		return err
+	}
+
+	for _, stale := range releasesToPrune(releases.Items, r.RetentionLimit, protected) {
+		if err := r.Delete(ctx, stale); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete component release %q: %w", stale.Name, err)
+		}
+		logger.Info("Pruned ComponentRelease beyond retention limit",
+			"name", stale.Name,
+			"component", release.Spec.Owner.ComponentName,
+			"retentionLimit", r.RetentionLimit)
+	}
+
+	return nil
+}
+
+// findProtectedReleases returns the names of the component's releases that must not be pruned:
+// releases referenced by or recorded in the history of any ReleaseBinding of the component, releases
+// of pending PromotionRequests, and the component's latest release.
+func (r *Reconciler) findProtectedReleases(ctx context.Context, release *openchoreov1alpha1.ComponentRelease) (map[string]bool, error) {
+	ownerKey := makeOwnerKey(release.Spec.Owner.ProjectName, release.Spec.Owner.ComponentName)
+	protected := make(map[string]bool)
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings,
+		client.InNamespace(release.Namespace),
+		client.MatchingFields{releaseBindingOwnerIndex: ownerKey}); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for _, binding := range bindings.Items {
+		protected[binding.Spec.ReleaseName] = true
+		// Releases in the binding history are kept so that the binding can be rolled back to them
+		for _, entry := range binding.Status.ReleaseHistory {
+			protected[entry.ReleaseName] = true
+		}
+		// The stable release keeps serving traffic while a canary is rolled out
+		if binding.Status.Rollout != nil {
+			protected[binding.Status.Rollout.StableRelease] = true
+		}
+	}
+
+	// Releases waiting for promotion approval must still exist when the promotion is approved
+	var promotionRequests openchoreov1alpha1.PromotionRequestList
+	if err := r.List(ctx, &promotionRequests, client.InNamespace(release.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+	for _, request := range promotionRequests.Items {
+		if request.Spec.Owner.ProjectName != release.Spec.Owner.ProjectName ||
+			request.Spec.Owner.ComponentName != release.Spec.Owner.ComponentName {
+			continue
+		}
+		if request.IsPending() {
+			protected[request.Spec.ReleaseName] = true
+		}
+	}
+
+	comp := &openchoreov1alpha1.Component{}
+	err := r.Get(ctx, types.NamespacedName{Name: release.Spec.Owner.ComponentName, Namespace: release.Namespace}, comp)
+	switch {
+	case err == nil:
+		if comp.Status.LatestRelease != nil {
+			protected[comp.Status.LatestRelease.Name] = true
+		}
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get component %q: %w", release.Spec.Owner.ComponentName, err)
+	}
+
+	return protected, nil
+}
+
+// releasesToPrune selects the releases to delete so that only the retentionLimit most recent
+// releases remain, skipping protected releases and releases that are already being deleted.
+// Releases are ordered by creation time, newest first, with the name as a tie-breaker.
+func releasesToPrune(
+	releases []openchoreov1alpha1.ComponentRelease,
+	retentionLimit int,
+	protected map[string]bool,
+) []*openchoreov1alpha1.ComponentRelease {
+	if len(releases) <= retentionLimit {
+		return nil
+	}
+
+	sorted := make([]*openchoreov1alpha1.ComponentRelease, len(releases))
+	for i := range releases {
+		sorted[i] = &releases[i]
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := sorted[i].CreationTimestamp, sorted[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return sorted[i].Name > sorted[j].Name
+	})
+
+	var stale []*openchoreov1alpha1.ComponentRelease
+	for _, release := range sorted[retentionLimit:] {
+		if protected[release.Name] || !release.DeletionTimestamp.IsZero() {
+			continue
+		}
+		stale = append(stale, release)
+	}
+	return stale
+}
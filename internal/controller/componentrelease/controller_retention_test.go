@@ -0,0 +1,73 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestReleasesToPrune(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	release := func(name string, age time.Duration) openchoreov1alpha1.ComponentRelease {
+		return openchoreov1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(base.Add(-age)),
+			},
+		}
+	}
+	deleting := release("deleting", 10*time.Hour)
+	deleting.DeletionTimestamp = &metav1.Time{Time: base}
+
+	releases := []openchoreov1alpha1.ComponentRelease{
+		release("r3", 3*time.Hour),
+		release("r1", 1*time.Hour),
+		release("r5", 5*time.Hour),
+		release("r2", 2*time.Hour),
+		release("r4", 4*time.Hour),
+		deleting,
+	}
+
+	tests := []struct {
+		name           string
+		retentionLimit int
+		protected      map[string]bool
+		want           []string
+	}{
+		{
+			name:           "Within retention limit",
+			retentionLimit: 10,
+			want:           nil,
+		},
+		{
+			name:           "Prunes oldest releases",
+			retentionLimit: 2,
+			want:           []string{"r3", "r4", "r5"},
+		},
+		{
+			name:           "Keeps protected releases",
+			retentionLimit: 2,
+			protected:      map[string]bool{"r4": true},
+			want:           []string{"r3", "r5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range releasesToPrune(releases, tt.retentionLimit, tt.protected) {
+				got = append(got, r.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("releasesToPrune() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -125,8 +126,13 @@ var _ = Describe("ComponentRelease Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+
+			By("Checking that the Ready condition is set")
+			reconciled := &openchoreov1alpha1.ComponentRelease{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, reconciled)).To(Succeed())
+			readyCondition := meta.FindStatusCondition(reconciled.Status.Conditions, string(ConditionReady))
+			Expect(readyCondition).NotTo(BeNil())
+			Expect(readyCondition.ObservedGeneration).To(Equal(reconciled.Generation))
 		})
 	})
 })
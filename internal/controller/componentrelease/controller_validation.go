@@ -0,0 +1,157 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/schema"
+)
+
+// validateComponentRelease validates the parameter values snapshotted in a ComponentRelease
+// against the ComponentType and Trait specs embedded in the same release.
+//
+// The component parameters are validated against the parameters schema of the ComponentType.
+// The envOverrides are supplied by each ReleaseBinding and validated when they are merged in, so the
+// fields declared by envOverrides are optional here and $validations rules, which may refer to them,
+// are left to the ReleaseBinding as well.
+// Each trait instance must reference a trait embedded in spec.traits, must have a unique instance name,
+// and its parameters are validated against that trait's schema.
+func validateComponentRelease(release *openchoreov1alpha1.ComponentRelease) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	profilePath := specPath.Child("componentProfile")
+
+	ctSchema := release.Spec.ComponentType.Schema
+	allErrs = append(allErrs, validateParameters(
+		profilePath.Child("parameters"),
+		release.Spec.ComponentProfile.Parameters,
+		specPath.Child("componentType", "schema"),
+		ctSchema.Types, ctSchema.Parameters, ctSchema.EnvOverrides,
+	)...)
+
+	instanceNames := make(map[string]bool, len(release.Spec.ComponentProfile.Traits))
+	for i, instance := range release.Spec.ComponentProfile.Traits {
+		instancePath := profilePath.Child("traits").Index(i)
+
+		if instanceNames[instance.InstanceName] {
+			allErrs = append(allErrs, field.Duplicate(instancePath.Child("instanceName"), instance.InstanceName))
+		}
+		instanceNames[instance.InstanceName] = true
+
+		trait, ok := release.Spec.Traits[instance.Name]
+		if !ok {
+			allErrs = append(allErrs, field.NotFound(instancePath.Child("name"), instance.Name))
+			continue
+		}
+
+		allErrs = append(allErrs, validateParameters(
+			instancePath.Child("parameters"),
+			instance.Parameters,
+			specPath.Child("traits").Key(instance.Name).Child("schema"),
+			trait.Schema.Types, trait.Schema.Parameters, trait.Schema.EnvOverrides,
+		)...)
+	}
+
+	return allErrs
+}
+
+// validateParameters validates the parameter values at fldPath against the schema built from the
+// given types and parameters schema sections. Values of fields that are only declared in the envOverrides
+// section are left out, as they are validated together with the overrides of a ReleaseBinding. Errors in
+// the schema itself are reported against schemaPath.
+func validateParameters(
+	fldPath *field.Path,
+	values *runtime.RawExtension,
+	schemaPath *field.Path,
+	types, parameters, envOverrides *runtime.RawExtension,
+) field.ErrorList {
+	parameterFields, err := unmarshalFields(parameters)
+	if err != nil {
+		return field.ErrorList{field.Invalid(schemaPath, "", err.Error())}
+	}
+	overrideFields, err := unmarshalFields(envOverrides)
+	if err != nil {
+		return field.ErrorList{field.Invalid(schemaPath, "", err.Error())}
+	}
+	def, err := buildSchemaDefinition(types, parameterFields)
+	if err != nil {
+		return field.ErrorList{field.Invalid(schemaPath, "", err.Error())}
+	}
+
+	params := map[string]any{}
+	if values != nil && len(values.Raw) > 0 {
+		if err := json.Unmarshal(values.Raw, &params); err != nil {
+			return field.ErrorList{field.Invalid(fldPath, string(values.Raw), err.Error())}
+		}
+	}
+
+	removeOverrideFields(params, parameterFields, overrideFields)
+
+	validate := schema.Validate
+	if len(overrideFields) > 0 {
+		validate = schema.ValidateStructure
+	}
+	errs, err := validate(fldPath, params, def)
+	if err != nil {
+		return field.ErrorList{field.Invalid(schemaPath, "", err.Error())}
+	}
+	return errs
+}
+
+// removeOverrideFields removes the values of the fields that are declared by the envOverrides fields but not
+// by the parameters fields, descending into objects declared by both.
+func removeOverrideFields(values, parameterFields, overrideFields map[string]any) {
+	for name, override := range overrideFields {
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		parameter, declared := parameterFields[name]
+		if !declared {
+			delete(values, name)
+			continue
+		}
+		nestedValue, isObject := value.(map[string]any)
+		nestedParameter, parameterIsObject := parameter.(map[string]any)
+		nestedOverride, overrideIsObject := override.(map[string]any)
+		if isObject && parameterIsObject && overrideIsObject {
+			removeOverrideFields(nestedValue, nestedParameter, nestedOverride)
+		}
+	}
+}
+
+// buildSchemaDefinition assembles a schema definition from the raw types and the parameters fields of a
+// ComponentType or Trait.
+func buildSchemaDefinition(types *runtime.RawExtension, parameterFields map[string]any) (schema.Definition, error) {
+	var def schema.Definition
+
+	if types != nil && len(types.Raw) > 0 {
+		if err := yaml.Unmarshal(types.Raw, &def.Types); err != nil {
+			return def, err
+		}
+	}
+	if parameterFields != nil {
+		def.Schemas = append(def.Schemas, parameterFields)
+	}
+
+	return def, nil
+}
+
+// unmarshalFields decodes a raw schema section into its field map, which is nil for an empty section
+func unmarshalFields(section *runtime.RawExtension) (map[string]any, error) {
+	if section == nil || len(section.Raw) == 0 {
+		return nil, nil
+	}
+	var fields map[string]any
+	if err := yaml.Unmarshal(section.Raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newTestRelease(params string, traits ...openchoreov1alpha1.ComponentTrait) *openchoreov1alpha1.ComponentRelease {
+	return &openchoreov1alpha1.ComponentRelease{
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			ComponentType: openchoreov1alpha1.ComponentTypeSpec{
+				WorkloadType: "deployment",
+				Schema: openchoreov1alpha1.ComponentTypeSchema{
+					Parameters:   &runtime.RawExtension{Raw: []byte(`{"replicas":"integer | default=1 minimum=1"}`)},
+					EnvOverrides: &runtime.RawExtension{Raw: []byte(`{"cpu":"string | default=100m"}`)},
+				},
+			},
+			Traits: map[string]openchoreov1alpha1.TraitSpec{
+				"storage": {
+					Schema: openchoreov1alpha1.TraitSchema{
+						Parameters: &runtime.RawExtension{Raw: []byte(`{"mountPath":"string","size":"string | default=1Gi"}`)},
+					},
+				},
+			},
+			ComponentProfile: openchoreov1alpha1.ComponentProfile{
+				Parameters: &runtime.RawExtension{Raw: []byte(params)},
+				Traits:     traits,
+			},
+		},
+	}
+}
+
+func storageInstance(instanceName, params string) openchoreov1alpha1.ComponentTrait {
+	return openchoreov1alpha1.ComponentTrait{
+		Name:         "storage",
+		InstanceName: instanceName,
+		Parameters:   &runtime.RawExtension{Raw: []byte(params)},
+	}
+}
+
+func TestValidateComponentRelease(t *testing.T) {
+	tests := []struct {
+		name       string
+		release    *openchoreov1alpha1.ComponentRelease
+		wantErrors []string
+	}{
+		{
+			name:    "Valid release",
+			release: newTestRelease(`{"replicas":2,"cpu":"1"}`, storageInstance("data", `{"mountPath":"/data"}`)),
+		},
+		{
+			name:    "Valid release without parameters",
+			release: newTestRelease(``),
+		},
+		{
+			name:       "Invalid component parameters",
+			release:    newTestRelease(`{"replicas":0,"image":"nginx"}`),
+			wantErrors: []string{"spec.componentProfile.parameters.replicas", "spec.componentProfile.parameters.image"},
+		},
+		{
+			name:       "Invalid trait instance parameters",
+			release:    newTestRelease(`{}`, storageInstance("data", `{"size":5}`)),
+			wantErrors: []string{"spec.componentProfile.traits[0].parameters.mountPath", "spec.componentProfile.traits[0].parameters.size"},
+		},
+		{
+			name: "Duplicate trait instance names",
+			release: newTestRelease(`{}`,
+				storageInstance("data", `{"mountPath":"/data"}`),
+				storageInstance("data", `{"mountPath":"/cache"}`)),
+			wantErrors: []string{"spec.componentProfile.traits[1].instanceName: Duplicate value"},
+		},
+		{
+			name: "Trait not embedded in the release",
+			release: newTestRelease(`{}`, openchoreov1alpha1.ComponentTrait{
+				Name:         "ingress",
+				InstanceName: "public",
+			}),
+			wantErrors: []string{"spec.componentProfile.traits[0].name: Not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateComponentRelease(tt.release)
+			if len(errs) != len(tt.wantErrors) {
+				t.Fatalf("validateComponentRelease() returned %d errors, want %d: %v", len(errs), len(tt.wantErrors), errs)
+			}
+			for _, want := range tt.wantErrors {
+				if !strings.Contains(errs.ToAggregate().Error(), want) {
+					t.Errorf("validateComponentRelease() errors %v do not contain %q", errs, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateComponentReleaseWithRequiredEnvOverrides(t *testing.T) {
+	withRequiredEnvOverrides := func(params string, traits ...openchoreov1alpha1.ComponentTrait) *openchoreov1alpha1.ComponentRelease {
+		release := newTestRelease(params, traits...)
+		release.Spec.ComponentType.Schema.Parameters = &runtime.RawExtension{Raw: []byte(
+			`{"replicas":"integer | default=1 minimum=1","resources":{"memory":"string | default=256Mi"},` +
+				`"$validations":[{"rule":"self.replicas <= self.maxReplicas"}]}`)}
+		release.Spec.ComponentType.Schema.EnvOverrides = &runtime.RawExtension{Raw: []byte(
+			`{"maxReplicas":"integer","resources":{"cpu":"string"}}`)}
+		storage := release.Spec.Traits["storage"]
+		storage.Schema.EnvOverrides = &runtime.RawExtension{Raw: []byte(`{"storageClass":"string"}`)}
+		release.Spec.Traits["storage"] = storage
+		return release
+	}
+
+	tests := []struct {
+		name       string
+		release    *openchoreov1alpha1.ComponentRelease
+		wantErrors []string
+	}{
+		{
+			name:    "Required envOverrides are supplied by the ReleaseBinding",
+			release: withRequiredEnvOverrides(`{"replicas":2,"resources":{}}`, storageInstance("data", `{"mountPath":"/data"}`)),
+		},
+		{
+			name: "Parameters may set envOverrides fields",
+			release: withRequiredEnvOverrides(`{"replicas":2,"maxReplicas":4,"resources":{"memory":"1Gi","cpu":"1"}}`,
+				storageInstance("data", `{"mountPath":"/data","storageClass":"fast"}`)),
+		},
+		{
+			name:       "Parameters are still validated",
+			release:    withRequiredEnvOverrides(`{"replicas":0,"resources":{"disk":"1Gi"}}`, storageInstance("data", `{}`)),
+			wantErrors: []string{"spec.componentProfile.parameters.replicas", "spec.componentProfile.parameters.resources.disk", "spec.componentProfile.traits[0].parameters.mountPath"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateComponentRelease(tt.release)
+			if len(errs) != len(tt.wantErrors) {
+				t.Fatalf("validateComponentRelease() returned %d errors, want %d: %v", len(errs), len(tt.wantErrors), errs)
+			}
+			for _, want := range tt.wantErrors {
+				if !strings.Contains(errs.ToAggregate().Error(), want) {
+					t.Errorf("validateComponentRelease() errors %v do not contain %q", errs, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// componentReleaseOwnerIndex is the field index name for ComponentRelease owner fields
+	componentReleaseOwnerIndex = "componentReleaseOwnerComposite"
+	// releaseBindingOwnerIndex is the field index name for ReleaseBinding owner fields
+	releaseBindingOwnerIndex = "releaseBindingOwnerComposite"
+)
+
+// makeOwnerKey creates the composite index key for a component: projectName/componentName
+func makeOwnerKey(projectName, componentName string) string {
+	return fmt.Sprintf("%s/%s", projectName, componentName)
+}
+
+// setupComponentReleaseOwnerIndex sets up the field index for ComponentRelease owner references
+func (r *Reconciler) setupComponentReleaseOwnerIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.ComponentRelease{},
+		componentReleaseOwnerIndex, func(obj client.Object) []string {
+			release := obj.(*openchoreov1alpha1.ComponentRelease)
+			return []string{makeOwnerKey(release.Spec.Owner.ProjectName, release.Spec.Owner.ComponentName)}
+		})
+}
+
+// setupReleaseBindingOwnerIndex sets up the field index for ReleaseBinding owner references
+func (r *Reconciler) setupReleaseBindingOwnerIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.ReleaseBinding{},
+		releaseBindingOwnerIndex, func(obj client.Object) []string {
+			binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+			return []string{makeOwnerKey(binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName)}
+		})
+}
+
+// findReleaseForReleaseBinding enqueues the ComponentRelease referenced by a ReleaseBinding.
+// Reconciling the bound release re-evaluates retention for the component whenever a binding
+// moves to a different release or is deleted.
+func (r *Reconciler) findReleaseForReleaseBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if binding.Spec.ReleaseName == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      binding.Spec.ReleaseName,
+				Namespace: binding.Namespace,
+			},
+		},
+	}
+}
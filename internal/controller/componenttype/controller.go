@@ -6,11 +6,15 @@ package componenttype
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -24,9 +28,37 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componenttypes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componenttypes/finalizers,verbs=update
 
-// Reconcile is part of the main kubernetes reconciliation loop
+// Reconcile validates the templates of a ComponentType and reports the result in its status
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	// No-op controller - ComponentTypes are managed externally
+	logger := log.FromContext(ctx)
+
+	ct := &openchoreov1alpha1.ComponentType{}
+	if err := r.Get(ctx, req.NamespacedName, ct); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if ct.Status.ObservedGeneration == ct.Generation && meta.FindStatusCondition(ct.Status.Conditions, string(ConditionTemplatesValid)) != nil {
+		return ctrl.Result{}, nil
+	}
+
+	templateErrs, err := component.ValidateComponentType(ct)
+	switch {
+	case err != nil:
+		logger.Info("ComponentType schema is invalid", "error", err)
+		controller.MarkFalseCondition(ct, ConditionTemplatesValid, ReasonInvalidSchema, err.Error())
+	case len(templateErrs) > 0:
+		logger.Info("ComponentType has invalid templates", "errors", len(templateErrs))
+		controller.MarkFalseCondition(ct, ConditionTemplatesValid, ReasonInvalidTemplates,
+			component.FormatTemplateErrors(templateErrs))
+	default:
+		controller.MarkTrueCondition(ct, ConditionTemplatesValid, ReasonTemplatesValid, "All template expressions are valid")
+	}
+	ct.Status.ObservedGeneration = ct.Generation
+
+	if err := r.Status().Update(ctx, ct); err != nil {
+		logger.Error(err, "Failed to update ComponentType status")
+		return ctrl.Result{}, err
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -6,6 +6,7 @@ package dataplane
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,6 +27,14 @@ type Reconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// ProbeInterval is how often the dataplane cluster is probed, controller.DefaultClusterProbeInterval if unset
+	ProbeInterval time.Duration
+	// SecretReader reads the secrets holding the credentials of the dataplane cluster, the client if unset
+	SecretReader client.Reader
+
+	// probeCluster and lookupHost replace the cluster probe and the DNS lookups of the gateway hosts in tests
+	probeCluster controller.ClusterProbeFunc
+	lookupHost   func(ctx context.Context, host string) ([]string, error)
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -67,33 +76,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	// Handle create
-	// Ignore reconcile if the Dataplane is already available since this is a one-time create
-	if r.shouldIgnoreReconcile(dataPlane) {
-		return ctrl.Result{}, nil
+	// The dataplane is probed periodically, and again as soon as its spec changes
+	probeInterval := r.probeInterval()
+	if delay := controller.ClusterProbeDelay(dataPlane.Status.Cluster, dataPlane.Status.ObservedGeneration,
+		dataPlane.Generation, probeInterval); delay > 0 {
+		return ctrl.Result{RequeueAfter: delay}, nil
 	}
 
 	// Set the observed generation
 	dataPlane.Status.ObservedGeneration = dataPlane.Generation
 
-	// Update the status condition to indicate the project is created/ready
+	// Update the status condition to indicate the dataplane is created/ready
+	created := meta.FindStatusCondition(dataPlane.Status.Conditions, string(ConditionCreated)) == nil
 	meta.SetStatusCondition(
 		&dataPlane.Status.Conditions,
 		NewDataPlaneCreatedCondition(dataPlane.Generation),
 	)
 
-	// Update status if needed
-	if err := controller.UpdateStatusConditions(ctx, r.Client, old, dataPlane); err != nil {
-		return ctrl.Result{}, err
+	r.probe(ctx, dataPlane)
+
+	// The probe time always changes, so the status is updated on every probe
+	if err := r.Status().Update(ctx, dataPlane); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update dataplane status: %w", err)
 	}
 
-	r.Recorder.Event(dataPlane, corev1.EventTypeNormal, "ReconcileComplete", fmt.Sprintf("Successfully created %s", dataPlane.Name))
+	if created {
+		r.Recorder.Event(dataPlane, corev1.EventTypeNormal, "ReconcileComplete", fmt.Sprintf("Successfully created %s", dataPlane.Name))
+	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: probeInterval}, nil
 }
 
-func (r *Reconciler) shouldIgnoreReconcile(dataPlane *openchoreov1alpha1.DataPlane) bool {
-	return meta.FindStatusCondition(dataPlane.Status.Conditions, string(controller.TypeAvailable)) != nil
+func (r *Reconciler) probeInterval() time.Duration {
+	if r.ProbeInterval > 0 {
+		return r.ProbeInterval
+	}
+	return controller.DefaultClusterProbeInterval
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -15,6 +15,9 @@ const (
 
 	// ConditionFinalizing represents whether the dataplane is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionGatewayResolvable represents whether the gateway virtual hosts of the dataplane resolve
+	ConditionGatewayResolvable controller.ConditionType = "GatewayResolvable"
 )
 
 const (
@@ -23,6 +26,12 @@ const (
 
 	// ReasonDataplaneFinalizing is the reason used when a dataplane's dependents are being deleted
 	ReasonDataplaneFinalizing controller.ConditionReason = "DataplaneFinalizing"
+
+	// ReasonGatewayHostsResolved is the reason used when all the gateway virtual hosts resolve
+	ReasonGatewayHostsResolved controller.ConditionReason = "GatewayHostsResolved"
+
+	// ReasonGatewayHostsUnresolved is the reason used when some of the gateway virtual hosts do not resolve
+	ReasonGatewayHostsUnresolved controller.ConditionReason = "GatewayHostsUnresolved"
 )
 
 // NewDataPlaneCreatedCondition creates a condition to indicate the dataplane is created/ready
@@ -0,0 +1,94 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// gatewayLookupTimeout bounds the DNS lookups of the gateway virtual hosts
+	gatewayLookupTimeout = 5 * time.Second
+
+	// gatewayProbeSubdomain is the subdomain of the gateway virtual hosts that is looked up. Components are
+	// served on subdomains of the virtual hosts, which a wildcard record resolves without the host itself.
+	gatewayProbeSubdomain = "openchoreo-gateway-probe"
+)
+
+// probe checks that the dataplane cluster is reachable, which capabilities are installed in it and whether
+// the gateway virtual hosts resolve, and records the results in the status of the dataplane.
+func (r *Reconciler) probe(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane) {
+	logger := log.FromContext(ctx).WithValues("dataplane", dataPlane.Name)
+
+	probeCluster := r.probeCluster
+	if probeCluster == nil {
+		probeCluster = kubernetesClient.ProbeCluster
+	}
+
+	wasReachable := meta.IsStatusConditionTrue(dataPlane.Status.Conditions, string(controller.ConditionClusterReachable))
+	secretReader := r.SecretReader
+	if secretReader == nil {
+		secretReader = r.Client
+	}
+	result, err := probeCluster(ctx, secretReader, dataPlane, dataPlane.Spec.KubernetesCluster, kubernetesClient.DataPlaneCapabilities)
+	if err != nil {
+		logger.Info("Failed to probe dataplane cluster", "error", err)
+		if wasReachable {
+			r.Recorder.Event(dataPlane, corev1.EventTypeWarning, string(controller.ReasonClusterUnreachable), err.Error())
+		}
+	}
+	dataPlane.Status.Cluster = controller.SetClusterProbeConditions(dataPlane, dataPlane.Status.Cluster, result, err)
+
+	r.checkGatewayHosts(ctx, dataPlane)
+}
+
+// checkGatewayHosts checks that subdomains of the gateway virtual hosts of the dataplane resolve, as components
+// exposed through the gateway are unreachable otherwise.
+func (r *Reconciler) checkGatewayHosts(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane) {
+	var hosts []string
+	for _, host := range []string{dataPlane.Spec.Gateway.PublicVirtualHost, dataPlane.Spec.Gateway.OrganizationVirtualHost} {
+		if host != "" && !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		meta.RemoveStatusCondition(&dataPlane.Status.Conditions, string(ConditionGatewayResolvable))
+		return
+	}
+
+	lookupHost := r.lookupHost
+	if lookupHost == nil {
+		lookupHost = net.DefaultResolver.LookupHost
+	}
+	ctx, cancel := context.WithTimeout(ctx, gatewayLookupTimeout)
+	defer cancel()
+
+	var unresolved []string
+	for _, host := range hosts {
+		if _, err := lookupHost(ctx, gatewayProbeSubdomain+"."+host); err != nil {
+			unresolved = append(unresolved, host)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		controller.MarkFalseCondition(dataPlane, ConditionGatewayResolvable, ReasonGatewayHostsUnresolved,
+			fmt.Sprintf("Subdomains of the gateway virtual hosts do not resolve: %s", strings.Join(unresolved, ", ")))
+		return
+	}
+	controller.MarkTrueCondition(dataPlane, ConditionGatewayResolvable, ReasonGatewayHostsResolved,
+		"Gateway virtual hosts resolve")
+}
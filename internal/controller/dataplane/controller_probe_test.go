@@ -0,0 +1,51 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestCheckGatewayHosts(t *testing.T) {
+	// Only a wildcard record exists for the public virtual host, as is common for gateways
+	records := map[string]bool{
+		gatewayProbeSubdomain + ".apps.example.com": true,
+		"internal.example.com":                      true,
+	}
+	var lookedUp []string
+	r := &Reconciler{
+		lookupHost: func(_ context.Context, host string) ([]string, error) {
+			lookedUp = append(lookedUp, host)
+			if records[host] {
+				return []string{"192.0.2.1"}, nil
+			}
+			return nil, errors.New("no such host")
+		},
+	}
+
+	dataPlane := &openchoreov1alpha1.DataPlane{}
+	dataPlane.Spec.Gateway.PublicVirtualHost = "apps.example.com"
+	r.checkGatewayHosts(context.Background(), dataPlane)
+	if !meta.IsStatusConditionTrue(dataPlane.Status.Conditions, string(ConditionGatewayResolvable)) {
+		t.Errorf("expected the wildcard host to resolve, got %+v", dataPlane.Status.Conditions)
+	}
+
+	// The organization virtual host only resolves without a subdomain
+	dataPlane.Spec.Gateway.OrganizationVirtualHost = "internal.example.com"
+	r.checkGatewayHosts(context.Background(), dataPlane)
+	condition := meta.FindStatusCondition(dataPlane.Status.Conditions, string(ConditionGatewayResolvable))
+	if condition == nil || condition.Status != "False" || !strings.HasSuffix(condition.Message, ": internal.example.com") {
+		t.Errorf("expected the organization host to be reported, got %+v", condition)
+	}
+	if lookedUp[len(lookedUp)-1] != gatewayProbeSubdomain+".internal.example.com" {
+		t.Errorf("expected a subdomain to be looked up, got %v", lookedUp)
+	}
+}
@@ -9,3 +9,7 @@ package dataplane
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Secrets holding the cluster credentials of DataPlanes and BuildPlanes are read by the controllers connecting to them,
+// which watch the metadata of secrets to notice when the credentials are rotated
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
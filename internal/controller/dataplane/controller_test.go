@@ -10,12 +10,16 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	org "github.com/openchoreo/openchoreo/internal/controller/organization"
 	"github.com/openchoreo/openchoreo/internal/controller/testutils"
 )
@@ -102,6 +106,46 @@ var _ = Describe("DataPlane Controller", func() {
 				Expect(dataPlane.Spec).NotTo(BeNil())
 			})
 
+			By("Probing the dataplane cluster", func() {
+				dpReconciler := &Reconciler{
+					Client:        k8sClient,
+					Scheme:        k8sClient.Scheme(),
+					Recorder:      record.NewFakeRecorder(100),
+					ProbeInterval: time.Minute,
+					probeCluster: func(context.Context, client.Reader, client.Object,
+						openchoreov1alpha1.KubernetesClusterSpec, []kubernetesClient.ClusterCapability,
+					) (*kubernetesClient.ClusterProbeResult, error) {
+						return &kubernetesClient.ClusterProbeResult{
+							ServerVersion: "v1.32.3",
+							Capabilities: []openchoreov1alpha1.ClusterCapabilityStatus{
+								{Name: "GatewayAPI", Available: true},
+							},
+						}, nil
+					},
+				}
+				result, err := dpReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: dpNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+				dataPlane := &openchoreov1alpha1.DataPlane{}
+				Expect(k8sClient.Get(ctx, dpNamespacedName, dataPlane)).To(Succeed())
+				Expect(dataPlane.Status.Cluster).NotTo(BeNil())
+				Expect(dataPlane.Status.Cluster.ServerVersion).To(Equal("v1.32.3"))
+				Expect(meta.IsStatusConditionTrue(dataPlane.Status.Conditions,
+					string(controller.ConditionClusterReachable))).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(dataPlane.Status.Conditions,
+					string(controller.ConditionCapabilitiesAvailable))).To(BeTrue())
+
+				// The cluster is not probed again until the probe interval elapses
+				result, err = dpReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: dpNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeNumerically("<=", time.Minute))
+			})
+
 			By("Deleting the dataplane resource", func() {
 				err := k8sClient.Get(ctx, dpNamespacedName, dataplane)
 				Expect(err).NotTo(HaveOccurred())
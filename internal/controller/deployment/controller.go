@@ -27,7 +27,8 @@ import (
 // Reconciler reconciles a Deployment object
 type Reconciler struct {
 	client.Client
-	k8sClientMgr *kubernetesClient.KubeMultiClientManager
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
 	Scheme       *runtime.Scheme
 	recorder     record.EventRecorder
 }
@@ -134,8 +135,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.recorder = mgr.GetEventRecorderFor("deployment-controller")
 	}
 
-	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
 	}
 
 	// Set up the index for the deployment artifact reference
@@ -201,9 +202,10 @@ func (r *Reconciler) getDPClient(ctx context.Context, env *openchoreov1alpha1.En
 	}
 
 	dpClient, err := kubernetesClient.GetK8sClient(
-		r.k8sClientMgr,
-		dataplaneRes.Namespace,
-		dataplaneRes.Name,
+		ctx,
+		r.K8sClientMgr,
+		r.Client,
+		dataplaneRes,
 		dataplaneRes.Spec.KubernetesCluster,
 	)
 	if err != nil {
@@ -29,7 +29,8 @@ import (
 // Reconciler reconciles a Endpoint object
 type Reconciler struct {
 	client.Client
-	k8sClientMgr *kubernetesClient.KubeMultiClientManager
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
 	Scheme       *runtime.Scheme
 	recorder     record.EventRecorder
 }
@@ -185,8 +186,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.recorder = mgr.GetEventRecorderFor("endpoint-controller")
 	}
 
-	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
 	}
 
 	if err := r.setupDataPlaneRefIndex(context.Background(), mgr); err != nil {
@@ -218,9 +219,10 @@ func (r *Reconciler) getDPClient(ctx context.Context, env *openchoreov1alpha1.En
 
 	// Get the DP client using the credentials from the dataplane
 	dpClient, err := kubernetesClient.GetK8sClient(
-		r.k8sClientMgr,
-		dataplaneRes.Namespace,
-		dataplaneRes.Name,
+		ctx,
+		r.K8sClientMgr,
+		r.Client,
+		dataplaneRes,
 		dataplaneRes.Spec.KubernetesCluster,
 	)
 	if err != nil {
@@ -27,7 +27,8 @@ import (
 // Reconciler reconciles a Environment object
 type Reconciler struct {
 	client.Client
-	k8sClientMgr *kubernetesClient.KubeMultiClientManager
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
 	Scheme       *runtime.Scheme
 	Recorder     record.EventRecorder
 }
@@ -101,8 +102,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.Recorder = mgr.GetEventRecorderFor("environment-controller")
 	}
 
-	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -133,9 +134,10 @@ func (r *Reconciler) getDPClient(ctx context.Context, env *openchoreov1alpha1.En
 	}
 
 	dpClient, err := kubernetesClient.GetK8sClient(
-		r.k8sClientMgr,
-		dataplaneRes.Namespace,
-		dataplaneRes.Name,
+		ctx,
+		r.K8sClientMgr,
+		r.Client,
+		dataplaneRes,
 		dataplaneRes.Spec.KubernetesCluster,
 	)
 	if err != nil {
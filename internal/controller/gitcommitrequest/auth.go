@@ -0,0 +1,110 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Keys of the auth secret of a GitCommitRequest
+const (
+	secretKeyUsername      = "username"
+	secretKeyPassword      = "password"
+	secretKeyToken         = "token"
+	secretKeySSHPrivateKey = "ssh-privatekey"
+	secretKeySSHPassphrase = "ssh-passphrase"
+	secretKeyKnownHosts    = "known_hosts"
+)
+
+const (
+	// defaultGitUsername is used for SSH URLs without a user and for tokens without a username.
+	// The providers only check the token, but Git requires a non-empty username.
+	defaultGitUsername = "git"
+)
+
+// buildAuth builds the Git auth for the repository URL from the auth secret. SSH URLs use the private key and
+// verify the host key against the known hosts of the secret, which are written to a file in workDir.
+// HTTPS URLs use a token, or a username and password. Local repositories need no auth.
+func buildAuth(repoURL string, secret *corev1.Secret, workDir string) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL: %w", err)
+	}
+
+	switch endpoint.Protocol {
+	case "ssh":
+		return buildSSHAuth(endpoint, secret, workDir)
+	case "http", "https":
+		if token, ok := secret.Data[secretKeyToken]; ok {
+			return &http.BasicAuth{Username: usernameOrDefault(secret), Password: string(token)}, nil
+		}
+		if user, ok := secret.Data[secretKeyUsername]; ok {
+			return &http.BasicAuth{Username: string(user), Password: string(secret.Data[secretKeyPassword])}, nil
+		}
+		return nil, fmt.Errorf("secret %s has neither %q nor %q for an HTTPS repository",
+			secret.Name, secretKeyToken, secretKeyUsername)
+	case "file":
+		// Local repositories are accessed without credentials
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported repository URL scheme %q", endpoint.Protocol)
+	}
+}
+
+func buildSSHAuth(endpoint *transport.Endpoint, secret *corev1.Secret, workDir string) (transport.AuthMethod, error) {
+	key, ok := secret.Data[secretKeySSHPrivateKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no %q for an SSH repository", secret.Name, secretKeySSHPrivateKey)
+	}
+	knownHosts := secret.Data[secretKeyKnownHosts]
+	if len(knownHosts) == 0 {
+		return nil, fmt.Errorf("secret %s has no %q to verify the SSH host key", secret.Name, secretKeyKnownHosts)
+	}
+
+	user := endpoint.User
+	if user == "" {
+		user = defaultGitUsername
+	}
+	auth, err := gitssh.NewPublicKeys(user, key, string(secret.Data[secretKeySSHPassphrase]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH private key: %w", err)
+	}
+
+	knownHostsFile := filepath.Join(workDir, secretKeyKnownHosts)
+	if err := os.WriteFile(knownHostsFile, knownHosts, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write known hosts: %w", err)
+	}
+	auth.HostKeyCallback, err = gitssh.NewKnownHostsCallback(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid known hosts: %w", err)
+	}
+	return auth, nil
+}
+
+// pullRequestToken returns the token used to call the API of the provider
+func pullRequestToken(secret *corev1.Secret) (string, error) {
+	if secret == nil {
+		return "", errors.New("an auth secret with a token is required to open pull requests")
+	}
+	token := string(secret.Data[secretKeyToken])
+	if token == "" {
+		return "", fmt.Errorf("secret %s has no %q to open pull requests", secret.Name, secretKeyToken)
+	}
+	return token, nil
+}
+
+func usernameOrDefault(secret *corev1.Secret) string {
+	if user := string(secret.Data[secretKeyUsername]); user != "" {
+		return user
+	}
+	return defaultGitUsername
+}
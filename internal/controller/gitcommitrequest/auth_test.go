@@ -0,0 +1,104 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const githubKnownHosts = "github.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl\n"
+
+func newPrivateKey(t *testing.T) []byte {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func newSecret(data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "git-credentials"}, Data: map[string][]byte{}}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return secret
+}
+
+func TestBuildAuthHTTPS(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         map[string]string
+		wantUsername string
+		wantPassword string
+	}{
+		{name: "token", data: map[string]string{"token": "ghp_secret"}, wantUsername: "git", wantPassword: "ghp_secret"},
+		{name: "token with username", data: map[string]string{"token": "glpat", "username": "oauth2"}, wantUsername: "oauth2", wantPassword: "glpat"},
+		{name: "basic auth", data: map[string]string{"username": "bot", "password": "pass"}, wantUsername: "bot", wantPassword: "pass"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := buildAuth("https://github.com/acme/gitops.git", newSecret(tt.data), t.TempDir())
+			if err != nil {
+				t.Fatalf("buildAuth() error = %v", err)
+			}
+			basic, ok := auth.(*http.BasicAuth)
+			if !ok || basic.Username != tt.wantUsername || basic.Password != tt.wantPassword {
+				t.Errorf("unexpected auth %#v", auth)
+			}
+		})
+	}
+}
+
+func TestBuildAuthSSH(t *testing.T) {
+	key := string(newPrivateKey(t))
+
+	auth, err := buildAuth("git@github.com:acme/gitops.git",
+		newSecret(map[string]string{"ssh-privatekey": key, "known_hosts": githubKnownHosts}), t.TempDir())
+	if err != nil {
+		t.Fatalf("buildAuth() error = %v", err)
+	}
+	publicKeys, ok := auth.(*gitssh.PublicKeys)
+	if !ok || publicKeys.User != "git" || publicKeys.HostKeyCallback == nil {
+		t.Fatalf("unexpected auth %#v", auth)
+	}
+
+	errTests := []struct {
+		name    string
+		repoURL string
+		data    map[string]string
+		wantErr string
+	}{
+		{name: "missing known hosts", repoURL: "ssh://git@github.com/acme/gitops.git",
+			data: map[string]string{"ssh-privatekey": key}, wantErr: "known_hosts"},
+		{name: "missing private key", repoURL: "git@github.com:acme/gitops.git",
+			data: map[string]string{"token": "ghp_secret"}, wantErr: "ssh-privatekey"},
+		{name: "invalid private key", repoURL: "git@github.com:acme/gitops.git",
+			data: map[string]string{"ssh-privatekey": "not a key", "known_hosts": githubKnownHosts}, wantErr: "invalid SSH private key"},
+		{name: "missing HTTPS credentials", repoURL: "https://github.com/acme/gitops.git",
+			data: map[string]string{"ssh-privatekey": key}, wantErr: "token"},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildAuth(tt.repoURL, newSecret(tt.data), t.TempDir())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("buildAuth() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
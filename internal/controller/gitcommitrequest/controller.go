@@ -7,17 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"path/filepath"
-	"time"
+	"strings"
 
-	jsonpatch "github.com/evanphx/json-patch/v5"
 	git "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
-	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,35 +22,39 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/gitcommitrequest/pullrequest"
 )
 
+// headBranchPrefix is the prefix of the head branches that the controller creates for pull requests
+const headBranchPrefix = "openchoreo/"
+
 // Reconciler reconciles a GitCommitRequest object
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// newPullRequestProvider replaces the providers that open pull requests in tests
+	newPullRequestProvider func(spec openchoreov1alpha1.GitPullRequest, repoURL, token string) (pullrequest.Provider, error)
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests/finalizers,verbs=update
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the GitCommitRequest object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile clones the branch of the GitCommitRequest, commits the file edits and pushes the commit to the
+// branch. When a pull request is requested, the commit is pushed to the head branch instead and a pull request
+// into the branch is opened through the provider of the repository.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Fetch the Workload instance for this reconcile request
+	// Fetch the GitCommitRequest instance for this reconcile request
 	gcr := &openchoreov1alpha1.GitCommitRequest{}
 	if err := r.Get(ctx, req.NamespacedName, gcr); err != nil {
 		if client.IgnoreNotFound(err) != nil {
-			logger.Error(err, "Failed to get Workload")
+			logger.Error(err, "Failed to get GitCommitRequest")
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
@@ -65,87 +65,147 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
-	// 1. Build Git auth
+	// 1. Prepare a work dir holding the clone and the known hosts
+	workDir, err := os.MkdirTemp("", "repo-*")
+	if err != nil {
+		return r.fail(ctx, gcr, fmt.Errorf("failed to create temp directory: %w", err))
+	}
+	// Ensure cleanup of temp directory
+	defer func() {
+		if cleanupErr := os.RemoveAll(workDir); cleanupErr != nil {
+			logger.Error(cleanupErr, "Failed to cleanup temp directory", "path", workDir)
+		}
+	}()
+
+	// 2. Build Git auth
 	var auth transport.AuthMethod
+	var secret *corev1.Secret
 	if gcr.Spec.AuthSecretRef != "" {
-		sec := &corev1.Secret{}
+		secret = &corev1.Secret{}
 		if err := r.Get(ctx,
-			types.NamespacedName{Name: gcr.Spec.AuthSecretRef, Namespace: gcr.Namespace}, sec); err != nil {
+			types.NamespacedName{Name: gcr.Spec.AuthSecretRef, Namespace: gcr.Namespace}, secret); err != nil {
 			return r.fail(ctx, gcr, fmt.Errorf("secret: %w", err))
 		}
-		if user, ok := sec.Data["username"]; ok {
-			auth = &http.BasicAuth{
-				Username: string(user),
-				Password: string(sec.Data["password"]),
-			}
+		if auth, err = buildAuth(gcr.Spec.RepoURL, secret, workDir); err != nil {
+			return r.fail(ctx, gcr, err)
 		}
-		// else if key, ok := sec.Data["ssh-privatekey"]; ok {
-		// signer, _ := ssh.ParsePrivateKey(key)                  // import "golang.org/x/crypto/ssh"
-		// auth = &gitssh.PublicKeys{User: "git", Signer: signer} // import gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
-		// }
 	}
 
-	// 2. Clone repo to a tmp dir
-	tmp, err := os.MkdirTemp("", "repo-*")
-	if err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to create temp directory: %w", err))
+	// 3. Clone the branch, or the default branch when none is set
+	cloneOptions := &git.CloneOptions{
+		URL:          gcr.Spec.RepoURL,
+		SingleBranch: true,
+		Depth:        1,
+		Auth:         auth,
 	}
-	// Ensure cleanup of temp directory
-	defer func() {
-		if cleanupErr := os.RemoveAll(tmp); cleanupErr != nil {
-			logger.Error(cleanupErr, "Failed to cleanup temp directory", "path", tmp)
-		}
-	}()
-
-	repo, err := git.PlainCloneContext(ctx, tmp, false, &git.CloneOptions{
-		URL:           gcr.Spec.RepoURL,
-		ReferenceName: plumbing.NewBranchReferenceName(gcr.Spec.Branch),
-		SingleBranch:  true,
-		Depth:         1,
-		Auth:          auth,
-	})
+	if gcr.Spec.Branch != "" {
+		cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(gcr.Spec.Branch)
+	}
+	repoDir := filepath.Join(workDir, "repo")
+	repo, err := git.PlainCloneContext(ctx, repoDir, false, cloneOptions)
 	if err != nil {
 		return r.fail(ctx, gcr, fmt.Errorf("failed to clone repository: %w", err))
 	}
-
-	// 3. Mutate files
-	if err := applyEdits(tmp, gcr.Spec.Files); err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to apply file edits: %w", err))
-	}
-	wt, err := repo.Worktree()
+	head, err := repo.Head()
 	if err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to get worktree: %w", err))
+		return r.fail(ctx, gcr, fmt.Errorf("failed to resolve HEAD: %w", err))
 	}
-	if _, err := wt.Add("."); err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to stage changes: %w", err))
+	branch := head.Name().Short()
+
+	// 4. Commit the edits and push them to the branch, or to the head branch of the pull request
+	targetBranch := branch
+	if gcr.Spec.PullRequest != nil {
+		targetBranch = headBranch(gcr)
+		if targetBranch == branch {
+			return r.fail(ctx, gcr, fmt.Errorf("head branch %q of the pull request is the base branch", targetBranch))
+		}
+	}
+	// Only the head branches owned by the controller are overwritten, any other branch is pushed to as is
+	force := gcr.Spec.PullRequest != nil && strings.HasPrefix(targetBranch, headBranchPrefix)
+	commit, err := commitAndPush(ctx, repo, repoDir, gcr, auth, branch, targetBranch, force)
+	if errors.Is(err, errNothingToCommit) {
+		gcr.Status.Phase = "Succeeded"
+		gcr.Status.ObservedSHA = commit.String()
+		gcr.Status.ObservedBranch = branch
+		gcr.Status.Message = "no changes to commit"
+		_ = r.Status().Update(ctx, gcr)
+		return ctrl.Result{}, nil
 	}
-	commit, err := wt.Commit(gcr.Spec.Message, &git.CommitOptions{
-		Author: &object.Signature{
-			Name:  gcr.Spec.Author.Name,
-			Email: gcr.Spec.Author.Email,
-			When:  time.Now(),
-		},
-	})
 	if err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to create commit: %w", err))
+		return r.fail(ctx, gcr, err)
 	}
-	// 4. Push
-	if err := repo.Push(&git.PushOptions{Auth: auth}); err != nil &&
-		!errors.Is(err, git.NoErrAlreadyUpToDate) {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to push commit: %w", err))
+
+	// 5. Open the pull request
+	message := "commit pushed"
+	if gcr.Spec.PullRequest != nil {
+		pull, err := r.openPullRequest(ctx, gcr, secret, targetBranch, branch)
+		if err != nil {
+			return r.fail(ctx, gcr, err)
+		}
+		gcr.Status.PullRequestURL = pull.URL
+		message = "pull request opened"
 	}
 
-	// 5. Update status
+	// 6. Update status
 	gcr.Status.Phase = "Succeeded"
 	gcr.Status.ObservedSHA = commit.String()
-	gcr.Status.ObservedBranch = gcr.Spec.Branch
-	gcr.Status.Message = "commit pushed"
+	gcr.Status.ObservedBranch = targetBranch
+	gcr.Status.Message = message
 	_ = r.Status().Update(ctx, gcr)
 
-	logger.Info("Git commit completed", "sha", commit.String())
+	logger.Info("Git commit completed", "sha", commit.String(), "branch", targetBranch)
 	return ctrl.Result{}, nil
 }
 
+// openPullRequest opens a pull request from the head branch into the base branch, unless one is already open
+// from an earlier attempt.
+func (r *Reconciler) openPullRequest(ctx context.Context, gcr *openchoreov1alpha1.GitCommitRequest,
+	secret *corev1.Secret, headBranch, baseBranch string) (*pullrequest.PullRequest, error) {
+	token, err := pullRequestToken(secret)
+	if err != nil {
+		return nil, err
+	}
+	newProvider := r.newPullRequestProvider
+	if newProvider == nil {
+		newProvider = pullrequest.New
+	}
+	provider, err := newProvider(*gcr.Spec.PullRequest, gcr.Spec.RepoURL, token)
+	if err != nil {
+		return nil, err
+	}
+
+	pull, err := provider.FindPullRequest(ctx, headBranch, baseBranch)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", provider.GetName(), err)
+	}
+	if pull != nil {
+		return pull, nil
+	}
+
+	title := gcr.Spec.PullRequest.Title
+	if title == "" {
+		title = gcr.Spec.Message
+	}
+	pull, err = provider.CreatePullRequest(ctx, pullrequest.Request{
+		Title:      title,
+		Body:       gcr.Spec.PullRequest.Body,
+		HeadBranch: headBranch,
+		BaseBranch: baseBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", provider.GetName(), err)
+	}
+	return pull, nil
+}
+
+// headBranch returns the branch that the commit of a pull request is pushed to
+func headBranch(gcr *openchoreov1alpha1.GitCommitRequest) string {
+	if gcr.Spec.PullRequest.HeadBranch != "" {
+		return gcr.Spec.PullRequest.HeadBranch
+	}
+	return headBranchPrefix + gcr.Name
+}
+
 // helper to set failed status once
 //
 //nolint:unparam
@@ -157,28 +217,6 @@ func (r *Reconciler) fail(ctx context.Context,
 	return ctrl.Result{}, err
 }
 
-func applyEdits(root string, edits []openchoreov1alpha1.FileEdit) error {
-	for _, e := range edits {
-		abs := filepath.Join(root, e.Path)
-		if err := os.MkdirAll(filepath.Dir(abs), fs.ModePerm); err != nil {
-			return err
-		}
-		if e.Patch != "" {
-			original, _ := os.ReadFile(abs)
-			p, _ := jsonpatch.DecodePatch([]byte(e.Patch))
-			mod, err := p.Apply(original)
-			if err != nil {
-				return err
-			}
-			e.Content = string(mod)
-		}
-		if err := os.WriteFile(abs, []byte(e.Content), 0o600); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
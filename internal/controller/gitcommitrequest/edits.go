@@ -0,0 +1,144 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	git "github.com/go-git/go-git/v5"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// applyEdits writes or deletes the files of the edits in the worktree at root. The files are accessed through an os.Root,
+// so that neither the paths nor symlinks in the repository can reach files outside of it.
+func applyEdits(root string, edits []openchoreov1alpha1.FileEdit) error {
+	repoRoot, err := os.OpenRoot(root)
+	if err != nil {
+		return err
+	}
+	defer repoRoot.Close()
+
+	for _, e := range edits {
+		path, err := cleanPath(e.Path)
+		if err != nil {
+			return err
+		}
+		if e.Delete {
+			if err := removeAll(repoRoot, path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("%s: %w", e.Path, err)
+			}
+			continue
+		}
+		if err := mkdirAll(repoRoot, filepath.Dir(path)); err != nil {
+			return fmt.Errorf("%s: %w", e.Path, err)
+		}
+
+		content := []byte(e.Content)
+		if e.Patch != "" {
+			content, err = applyPatch(repoRoot, path, e.Patch)
+			if err != nil {
+				return fmt.Errorf("%s: %w", e.Path, err)
+			}
+		}
+
+		f, err := repoRoot.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Path, err)
+		}
+		_, err = f.Write(content)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Path, err)
+		}
+	}
+	return nil
+}
+
+// cleanPath validates the path of a file edit and returns it relative to the root of the repository.
+// Paths must be relative, stay inside the repository and not touch its .git directory.
+func cleanPath(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("file path is empty")
+	}
+	if filepath.IsAbs(path) || strings.HasPrefix(path, "/") {
+		return "", fmt.Errorf("file path %q must be relative to the repository", path)
+	}
+	cleaned := filepath.Clean(filepath.FromSlash(path))
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("file path %q is outside of the repository", path)
+	}
+	first, _, _ := strings.Cut(cleaned, string(filepath.Separator))
+	if first == git.GitDirName {
+		return "", fmt.Errorf("file path %q is inside the %s directory", path, git.GitDirName)
+	}
+	return cleaned, nil
+}
+
+// mkdirAll creates the directory and its parents inside the root
+func mkdirAll(root *os.Root, dir string) error {
+	if dir == "." {
+		return nil
+	}
+	if err := mkdirAll(root, filepath.Dir(dir)); err != nil {
+		return err
+	}
+	if err := root.Mkdir(dir, fs.ModePerm); err != nil && !errors.Is(err, fs.ErrExist) {
+		return err
+	}
+	return nil
+}
+
+// removeAll removes the file, or the directory and everything it contains, inside the root
+func removeAll(root *os.Root, path string) error {
+	info, err := root.Lstat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		entries, err := fs.ReadDir(root.FS(), filepath.ToSlash(path))
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := removeAll(root, filepath.Join(path, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return root.Remove(path)
+}
+
+// applyPatch applies the RFC-6902 JSON patch to the file, which must exist
+func applyPatch(root *os.Root, path, patch string) ([]byte, error) {
+	p, err := jsonpatch.DecodePatch([]byte(patch))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+
+	f, err := root.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the file to patch: %w", err)
+	}
+	defer f.Close()
+	original, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the file to patch: %w", err)
+	}
+
+	patched, err := p.Apply(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply JSON patch: %w", err)
+	}
+	return patched, nil
+}
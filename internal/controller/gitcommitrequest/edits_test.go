@@ -0,0 +1,99 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestApplyEdits(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "app.json"), []byte(`{"replicas":1}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "canary.yaml"), []byte("kind: Release\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	outside := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "deploy", "prod", "nested"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "deploy", "prod", "nested", "release.yaml"), []byte("kind: Release\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "deploy", "prod", "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	err := applyEdits(root, []openchoreov1alpha1.FileEdit{
+		{Path: "deploy/dev/release.yaml", Content: "kind: Release\n"},
+		{Path: "app.json", Patch: `[{"op":"replace","path":"/replicas","value":3}]`},
+		{Path: "canary.yaml", Delete: true},
+		{Path: "missing.yaml", Delete: true},
+		{Path: "deploy/prod", Delete: true},
+	})
+	if err != nil {
+		t.Fatalf("applyEdits() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(root, "deploy", "dev", "release.yaml"))
+	if err != nil || string(content) != "kind: Release\n" {
+		t.Errorf("unexpected content %q, error %v", content, err)
+	}
+	content, err = os.ReadFile(filepath.Join(root, "app.json"))
+	if err != nil || string(content) != `{"replicas":3}` {
+		t.Errorf("unexpected patched content %q, error %v", content, err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "canary.yaml")); !os.IsNotExist(err) {
+		t.Errorf("expected canary.yaml to be deleted, got %v", err)
+	}
+	if _, err := os.Lstat(filepath.Join(root, "deploy", "prod")); !os.IsNotExist(err) {
+		t.Errorf("expected deploy/prod to be deleted, got %v", err)
+	}
+	if _, err := os.Stat(outside); err != nil {
+		t.Errorf("expected the symlink target to be kept, got %v", err)
+	}
+}
+
+func TestApplyEditsRejectsInvalidEdits(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    openchoreov1alpha1.FileEdit
+		wantErr string
+	}{
+		{name: "parent traversal", edit: openchoreov1alpha1.FileEdit{Path: "../outside.txt"}, wantErr: "outside of the repository"},
+		{name: "nested traversal", edit: openchoreov1alpha1.FileEdit{Path: "a/../../outside.txt"}, wantErr: "outside of the repository"},
+		{name: "absolute path", edit: openchoreov1alpha1.FileEdit{Path: "/etc/passwd"}, wantErr: "must be relative"},
+		{name: "git directory", edit: openchoreov1alpha1.FileEdit{Path: ".git/config"}, wantErr: "inside the .git directory"},
+		{name: "empty path", edit: openchoreov1alpha1.FileEdit{Path: ""}, wantErr: "file path is empty"},
+		{name: "symlink escape", edit: openchoreov1alpha1.FileEdit{Path: "link/outside.txt"}, wantErr: "link/outside.txt"},
+		{name: "invalid patch", edit: openchoreov1alpha1.FileEdit{Path: "app.json", Patch: `{"op":"add"}`}, wantErr: "invalid JSON patch"},
+		{name: "patch of missing file", edit: openchoreov1alpha1.FileEdit{Path: "missing.json", Patch: `[]`}, wantErr: "failed to read the file to patch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outside := t.TempDir()
+			root := t.TempDir()
+			if err := os.WriteFile(filepath.Join(root, "app.json"), []byte(`{}`), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Symlink(outside, filepath.Join(root, "link")); err != nil {
+				t.Fatal(err)
+			}
+
+			err := applyEdits(root, []openchoreov1alpha1.FileEdit{tt.edit})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("applyEdits() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if _, statErr := os.Stat(filepath.Join(outside, "outside.txt")); !os.IsNotExist(statErr) {
+				t.Error("expected no file to be written outside of the repository")
+			}
+		})
+	}
+}
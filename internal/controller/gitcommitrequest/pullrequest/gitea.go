@@ -0,0 +1,75 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Gitea opens pull requests through the Gitea REST API
+type Gitea struct {
+	api  *apiClient
+	repo Repository
+}
+
+func newGitea(baseURL string, repo Repository, token string) *Gitea {
+	return &Gitea{
+		api:  newAPIClient(baseURL, map[string]string{"Authorization": "token " + token}),
+		repo: repo,
+	}
+}
+
+type giteaPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Head    struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+// GetName returns the name of the provider
+func (g *Gitea) GetName() string {
+	return "gitea"
+}
+
+// FindPullRequest returns the open pull request from the head branch into the base branch.
+// Gitea cannot filter pull requests by branch, so the most recently updated ones are searched.
+func (g *Gitea) FindPullRequest(ctx context.Context, headBranch, baseBranch string) (*PullRequest, error) {
+	query := url.Values{"state": {"open"}, "sort": {"recentupdate"}, "limit": {"50"}}
+	var pulls []giteaPullRequest
+	if err := g.api.do(ctx, http.MethodGet, g.pullsPath()+"?"+query.Encode(), nil, &pulls); err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	for _, pull := range pulls {
+		if pull.Head.Ref == headBranch && pull.Base.Ref == baseBranch {
+			return &PullRequest{Number: pull.Number, URL: pull.HTMLURL}, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreatePullRequest opens a pull request from the head branch into the base branch
+func (g *Gitea) CreatePullRequest(ctx context.Context, req Request) (*PullRequest, error) {
+	body := map[string]string{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.HeadBranch,
+		"base":  req.BaseBranch,
+	}
+	pull := giteaPullRequest{}
+	if err := g.api.do(ctx, http.MethodPost, g.pullsPath(), body, &pull); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	return &PullRequest{Number: pull.Number, URL: pull.HTMLURL}, nil
+}
+
+func (g *Gitea) pullsPath() string {
+	return fmt.Sprintf("/repos/%s/%s/pulls", url.PathEscape(g.repo.Owner()), url.PathEscape(g.repo.Name()))
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GitHub opens pull requests through the GitHub REST API
+type GitHub struct {
+	api  *apiClient
+	repo Repository
+}
+
+func newGitHub(baseURL string, repo Repository, token string) *GitHub {
+	return &GitHub{
+		api: newAPIClient(baseURL, map[string]string{
+			"Accept":               "application/vnd.github+json",
+			"Authorization":        "Bearer " + token,
+			"X-GitHub-Api-Version": "2022-11-28",
+		}),
+		repo: repo,
+	}
+}
+
+type githubPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+}
+
+// GetName returns the name of the provider
+func (g *GitHub) GetName() string {
+	return "github"
+}
+
+// FindPullRequest returns the open pull request from the head branch into the base branch
+func (g *GitHub) FindPullRequest(ctx context.Context, headBranch, baseBranch string) (*PullRequest, error) {
+	query := url.Values{
+		"state": {"open"},
+		"head":  {g.repo.Owner() + ":" + headBranch},
+		"base":  {baseBranch},
+	}
+	var pulls []githubPullRequest
+	if err := g.api.do(ctx, http.MethodGet, g.pullsPath()+"?"+query.Encode(), nil, &pulls); err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	if len(pulls) == 0 {
+		return nil, nil
+	}
+	return &PullRequest{Number: pulls[0].Number, URL: pulls[0].HTMLURL}, nil
+}
+
+// CreatePullRequest opens a pull request from the head branch into the base branch
+func (g *GitHub) CreatePullRequest(ctx context.Context, req Request) (*PullRequest, error) {
+	body := map[string]string{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.HeadBranch,
+		"base":  req.BaseBranch,
+	}
+	pull := githubPullRequest{}
+	if err := g.api.do(ctx, http.MethodPost, g.pullsPath(), body, &pull); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	return &PullRequest{Number: pull.Number, URL: pull.HTMLURL}, nil
+}
+
+func (g *GitHub) pullsPath() string {
+	return fmt.Sprintf("/repos/%s/%s/pulls", url.PathEscape(g.repo.Owner()), url.PathEscape(g.repo.Name()))
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GitLab opens merge requests through the GitLab REST API
+type GitLab struct {
+	api  *apiClient
+	repo Repository
+}
+
+func newGitLab(baseURL string, repo Repository, token string) *GitLab {
+	return &GitLab{
+		api:  newAPIClient(baseURL, map[string]string{"PRIVATE-TOKEN": token}),
+		repo: repo,
+	}
+}
+
+type gitlabMergeRequest struct {
+	IID    int    `json:"iid"`
+	WebURL string `json:"web_url"`
+}
+
+// GetName returns the name of the provider
+func (g *GitLab) GetName() string {
+	return "gitlab"
+}
+
+// FindPullRequest returns the open merge request from the head branch into the base branch
+func (g *GitLab) FindPullRequest(ctx context.Context, headBranch, baseBranch string) (*PullRequest, error) {
+	query := url.Values{
+		"state":         {"opened"},
+		"source_branch": {headBranch},
+		"target_branch": {baseBranch},
+	}
+	var mergeRequests []gitlabMergeRequest
+	if err := g.api.do(ctx, http.MethodGet, g.mergeRequestsPath()+"?"+query.Encode(), nil, &mergeRequests); err != nil {
+		return nil, fmt.Errorf("failed to list merge requests: %w", err)
+	}
+	if len(mergeRequests) == 0 {
+		return nil, nil
+	}
+	return &PullRequest{Number: mergeRequests[0].IID, URL: mergeRequests[0].WebURL}, nil
+}
+
+// CreatePullRequest opens a merge request from the head branch into the base branch
+func (g *GitLab) CreatePullRequest(ctx context.Context, req Request) (*PullRequest, error) {
+	body := map[string]string{
+		"title":         req.Title,
+		"description":   req.Body,
+		"source_branch": req.HeadBranch,
+		"target_branch": req.BaseBranch,
+	}
+	mergeRequest := gitlabMergeRequest{}
+	if err := g.api.do(ctx, http.MethodPost, g.mergeRequestsPath(), body, &mergeRequest); err != nil {
+		return nil, fmt.Errorf("failed to create merge request: %w", err)
+	}
+	return &PullRequest{Number: mergeRequest.IID, URL: mergeRequest.WebURL}, nil
+}
+
+// mergeRequestsPath addresses the project by its URL-encoded path, which supports subgroups
+func (g *GitLab) mergeRequestsPath() string {
+	return "/projects/" + url.PathEscape(g.repo.Path) + "/merge_requests"
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Provider defines the interface for the services hosting Git repositories (GitHub, GitLab, Gitea)
+type Provider interface {
+	// GetName returns the name of the provider
+	GetName() string
+
+	// FindPullRequest returns the open pull request from the head branch into the base branch,
+	// or nil if there is none
+	FindPullRequest(ctx context.Context, headBranch, baseBranch string) (*PullRequest, error)
+
+	// CreatePullRequest opens a pull request from the head branch into the base branch
+	CreatePullRequest(ctx context.Context, req Request) (*PullRequest, error)
+}
+
+// Request describes a pull request to open
+type Request struct {
+	Title      string
+	Body       string
+	HeadBranch string
+	BaseBranch string
+}
+
+// PullRequest is a pull request opened on the provider
+type PullRequest struct {
+	// Number identifies the pull request in the repository
+	Number int
+	// URL is the web page of the pull request
+	URL string
+}
+
+// New creates the provider configured in the pull request spec for the repository, authenticating with the token
+func New(spec openchoreov1alpha1.GitPullRequest, repoURL, token string) (Provider, error) {
+	repo, err := ParseRepository(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// The default API is served by the host of the repository, so that the token is never sent to another host
+	switch spec.Provider {
+	case openchoreov1alpha1.GitProviderGitHub:
+		defaultURL := "https://" + repo.Host + "/api/v3"
+		if repo.Host == "github.com" {
+			defaultURL = "https://api.github.com"
+		}
+		return newGitHub(apiURL(spec.APIURL, defaultURL), repo, token), nil
+	case openchoreov1alpha1.GitProviderGitLab:
+		return newGitLab(apiURL(spec.APIURL, "https://"+repo.Host+"/api/v4"), repo, token), nil
+	case openchoreov1alpha1.GitProviderGitea:
+		return newGitea(apiURL(spec.APIURL, "https://"+repo.Host+"/api/v1"), repo, token), nil
+	default:
+		return nil, fmt.Errorf("unsupported git provider %q", spec.Provider)
+	}
+}
+
+func apiURL(configured, defaultURL string) string {
+	if configured == "" {
+		return defaultURL
+	}
+	return strings.TrimSuffix(configured, "/")
+}
+
+// Repository identifies a repository on a provider
+type Repository struct {
+	// Host serving the repository
+	Host string
+	// Path of the repository without the .git suffix, e.g. org/repo or group/subgroup/repo
+	Path string
+}
+
+// Owner returns the user or organization owning the repository
+func (r Repository) Owner() string {
+	owner, _, _ := strings.Cut(r.Path, "/")
+	return owner
+}
+
+// Name returns the name of the repository
+func (r Repository) Name() string {
+	return r.Path[strings.LastIndex(r.Path, "/")+1:]
+}
+
+// ParseRepository extracts the repository from an HTTPS, SSH or scp-like (git@host:org/repo.git) URL
+func ParseRepository(repoURL string) (Repository, error) {
+	endpoint, err := transport.NewEndpoint(repoURL)
+	if err != nil {
+		return Repository{}, fmt.Errorf("invalid repository URL %q: %w", repoURL, err)
+	}
+	path := strings.TrimSuffix(strings.Trim(endpoint.Path, "/"), ".git")
+	if endpoint.Host == "" || !strings.Contains(path, "/") {
+		return Repository{}, fmt.Errorf("repository URL %q does not have the form <host>/<owner>/<repo>", repoURL)
+	}
+	return Repository{Host: endpoint.Host, Path: path}, nil
+}
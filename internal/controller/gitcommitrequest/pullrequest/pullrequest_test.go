@@ -0,0 +1,238 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pullrequest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestParseRepository(t *testing.T) {
+	tests := []struct {
+		repoURL  string
+		want     Repository
+		wantErr  bool
+		owner    string
+		repoName string
+	}{
+		{repoURL: "https://github.com/acme/gitops.git", want: Repository{Host: "github.com", Path: "acme/gitops"}, owner: "acme", repoName: "gitops"},
+		{repoURL: "git@github.com:acme/gitops.git", want: Repository{Host: "github.com", Path: "acme/gitops"}, owner: "acme", repoName: "gitops"},
+		{repoURL: "ssh://git@gitlab.com/acme/platform/gitops.git", want: Repository{Host: "gitlab.com", Path: "acme/platform/gitops"},
+			owner: "acme", repoName: "gitops"},
+		{repoURL: "https://github.com/gitops", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repoURL, func(t *testing.T) {
+			got, err := ParseRepository(tt.repoURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRepository() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want || got.Owner() != tt.owner || got.Name() != tt.repoName {
+				t.Errorf("ParseRepository() = %+v (owner %s, name %s)", got, got.Owner(), got.Name())
+			}
+		})
+	}
+}
+
+// recordedRequest is a request received by the fake provider API
+type recordedRequest struct {
+	method string
+	uri    string
+	auth   string
+	body   map[string]string
+}
+
+// newProviderServer serves the responses by request method and records the requests it receives
+func newProviderServer(t *testing.T, responses map[string]any) (*httptest.Server, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded := recordedRequest{
+			method: r.Method,
+			uri:    r.RequestURI,
+			auth:   r.Header.Get("Authorization") + r.Header.Get("PRIVATE-TOKEN"),
+		}
+		if r.Body != nil && r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&recorded.body)
+		}
+		requests = append(requests, recorded)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_ = json.NewEncoder(w).Encode(responses[r.Method])
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestProviders(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		provider  openchoreov1alpha1.GitProvider
+		repoURL   string
+		responses map[string]any
+		wantAuth  string
+		wantFind  string
+		wantPost  string
+		wantBody  map[string]string
+		wantURL   string
+	}{
+		{
+			name:     "github",
+			provider: openchoreov1alpha1.GitProviderGitHub,
+			repoURL:  "https://github.com/acme/gitops.git",
+			responses: map[string]any{
+				http.MethodGet:  []any{},
+				http.MethodPost: map[string]any{"number": 12, "html_url": "https://github.com/acme/gitops/pull/12"},
+			},
+			wantAuth: "Bearer secret",
+			wantFind: "/repos/acme/gitops/pulls?base=main&head=acme%3Aopenchoreo%2Fpromote&state=open",
+			wantPost: "/repos/acme/gitops/pulls",
+			wantBody: map[string]string{"title": "Promote", "body": "Details", "head": "openchoreo/promote", "base": "main"},
+			wantURL:  "https://github.com/acme/gitops/pull/12",
+		},
+		{
+			name:     "gitlab",
+			provider: openchoreov1alpha1.GitProviderGitLab,
+			repoURL:  "git@gitlab.com:acme/platform/gitops.git",
+			responses: map[string]any{
+				http.MethodGet:  []any{},
+				http.MethodPost: map[string]any{"iid": 4, "web_url": "https://gitlab.com/acme/platform/gitops/-/merge_requests/4"},
+			},
+			wantAuth: "secret",
+			wantFind: "/projects/acme%2Fplatform%2Fgitops/merge_requests?source_branch=openchoreo%2Fpromote&state=opened&target_branch=main",
+			wantPost: "/projects/acme%2Fplatform%2Fgitops/merge_requests",
+			wantBody: map[string]string{"title": "Promote", "description": "Details", "source_branch": "openchoreo/promote", "target_branch": "main"},
+			wantURL:  "https://gitlab.com/acme/platform/gitops/-/merge_requests/4",
+		},
+		{
+			name:     "gitea",
+			provider: openchoreov1alpha1.GitProviderGitea,
+			repoURL:  "https://git.example.com/acme/gitops.git",
+			responses: map[string]any{
+				http.MethodGet: []any{map[string]any{
+					"number": 1, "html_url": "https://git.example.com/acme/gitops/pulls/1",
+					"head": map[string]any{"ref": "feature"}, "base": map[string]any{"ref": "main"},
+				}},
+				http.MethodPost: map[string]any{"number": 2, "html_url": "https://git.example.com/acme/gitops/pulls/2"},
+			},
+			wantAuth: "token secret",
+			wantFind: "/repos/acme/gitops/pulls?limit=50&sort=recentupdate&state=open",
+			wantPost: "/repos/acme/gitops/pulls",
+			wantBody: map[string]string{"title": "Promote", "body": "Details", "head": "openchoreo/promote", "base": "main"},
+			wantURL:  "https://git.example.com/acme/gitops/pulls/2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newProviderServer(t, tt.responses)
+			provider, err := New(openchoreov1alpha1.GitPullRequest{Provider: tt.provider, APIURL: server.URL + "/"}, tt.repoURL, "secret")
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if provider.GetName() != string(tt.provider) {
+				t.Errorf("GetName() = %s", provider.GetName())
+			}
+
+			existing, err := provider.FindPullRequest(ctx, "openchoreo/promote", "main")
+			if err != nil || existing != nil {
+				t.Fatalf("FindPullRequest() = %+v, %v", existing, err)
+			}
+			pull, err := provider.CreatePullRequest(ctx, Request{
+				Title: "Promote", Body: "Details", HeadBranch: "openchoreo/promote", BaseBranch: "main",
+			})
+			if err != nil {
+				t.Fatalf("CreatePullRequest() error = %v", err)
+			}
+			if pull.URL != tt.wantURL {
+				t.Errorf("CreatePullRequest() URL = %s, want %s", pull.URL, tt.wantURL)
+			}
+
+			if len(*requests) != 2 {
+				t.Fatalf("expected 2 requests, got %+v", *requests)
+			}
+			find, create := (*requests)[0], (*requests)[1]
+			if find.method != http.MethodGet || find.uri != tt.wantFind {
+				t.Errorf("unexpected find request %s %s, want %s", find.method, find.uri, tt.wantFind)
+			}
+			if create.method != http.MethodPost || create.uri != tt.wantPost {
+				t.Errorf("unexpected create request %s %s, want %s", create.method, create.uri, tt.wantPost)
+			}
+			for key, value := range tt.wantBody {
+				if create.body[key] != value {
+					t.Errorf("create request %s = %q, want %q", key, create.body[key], value)
+				}
+			}
+			if find.auth != tt.wantAuth || create.auth != tt.wantAuth {
+				t.Errorf("unexpected auth %q, %q, want %q", find.auth, create.auth, tt.wantAuth)
+			}
+		})
+	}
+}
+
+func TestDefaultAPIURL(t *testing.T) {
+	tests := []struct {
+		provider openchoreov1alpha1.GitProvider
+		repoURL  string
+		want     string
+	}{
+		{provider: openchoreov1alpha1.GitProviderGitHub, repoURL: "https://github.com/acme/gitops.git", want: "https://api.github.com"},
+		{provider: openchoreov1alpha1.GitProviderGitHub, repoURL: "git@github.acme.com:acme/gitops.git", want: "https://github.acme.com/api/v3"},
+		{provider: openchoreov1alpha1.GitProviderGitLab, repoURL: "git@gitlab.com:acme/platform/gitops.git", want: "https://gitlab.com/api/v4"},
+		{provider: openchoreov1alpha1.GitProviderGitLab, repoURL: "https://gitlab.acme.com/acme/gitops.git", want: "https://gitlab.acme.com/api/v4"},
+		{provider: openchoreov1alpha1.GitProviderGitea, repoURL: "https://git.acme.com/acme/gitops.git", want: "https://git.acme.com/api/v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repoURL, func(t *testing.T) {
+			provider, err := New(openchoreov1alpha1.GitPullRequest{Provider: tt.provider}, tt.repoURL, "secret")
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			var api *apiClient
+			switch p := provider.(type) {
+			case *GitHub:
+				api = p.api
+			case *GitLab:
+				api = p.api
+			case *Gitea:
+				api = p.api
+			}
+			if api == nil || api.baseURL != tt.want {
+				t.Errorf("API of %s = %+v, want %s", provider.GetName(), api, tt.want)
+			}
+		})
+	}
+}
+
+func TestProviderErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+	}))
+	defer server.Close()
+
+	provider, err := New(openchoreov1alpha1.GitPullRequest{Provider: openchoreov1alpha1.GitProviderGitHub, APIURL: server.URL},
+		"https://github.com/acme/gitops.git", "secret")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := provider.CreatePullRequest(context.Background(), Request{HeadBranch: "a", BaseBranch: "main"}); err == nil {
+		t.Error("expected an error for a rejected pull request")
+	}
+
+	if _, err := New(openchoreov1alpha1.GitPullRequest{Provider: "bitbucket"}, "https://bitbucket.org/acme/gitops.git", "secret"); err == nil {
+		t.Error("expected an error for an unsupported provider")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// maxPushAttempts is how many times a commit is pushed when the branch keeps moving on the remote
+const maxPushAttempts = 3
+
+// errNothingToCommit is returned when the edits do not change any file of the branch
+var errNothingToCommit = errors.New("nothing to commit")
+
+// commitAndPush commits the edits on top of the cloned branch and pushes the commit to the target branch.
+// When the push is rejected because the branch moved on the remote, the edits are applied again on top of
+// the new head of the branch and the push is retried. Forced pushes overwrite the target branch instead, so they
+// are only used for the head branches that the controller owns.
+// When the edits do not change the branch, the current head of the branch is returned with errNothingToCommit.
+func commitAndPush(ctx context.Context, repo *git.Repository, dir string, gcr *openchoreov1alpha1.GitCommitRequest,
+	auth transport.AuthMethod, baseBranch, targetBranch string, force bool) (plumbing.Hash, error) {
+	logger := log.FromContext(ctx)
+
+	head, err := repo.Head()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	base := head.Hash()
+
+	for attempt := 1; ; attempt++ {
+		commit, err := commitEdits(repo, dir, gcr)
+		if errors.Is(err, errNothingToCommit) {
+			// The branch may already hold the edits after it moved on the remote
+			return base, err
+		}
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		refSpec := config.RefSpec(fmt.Sprintf("%s:%s",
+			plumbing.NewBranchReferenceName(baseBranch), plumbing.NewBranchReferenceName(targetBranch)))
+		pushErr := repo.PushContext(ctx, &git.PushOptions{
+			RefSpecs: []config.RefSpec{refSpec},
+			Auth:     auth,
+			Force:    force,
+		})
+		if pushErr == nil || errors.Is(pushErr, git.NoErrAlreadyUpToDate) {
+			return commit, nil
+		}
+		if force || attempt == maxPushAttempts {
+			return plumbing.ZeroHash, fmt.Errorf("failed to push commit: %w", pushErr)
+		}
+
+		// A rejected push is only retried when the branch has moved since it was cloned
+		remoteHead, err := fetchBranch(ctx, repo, baseBranch, auth)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to push commit: %w (%w)", pushErr, err)
+		}
+		if remoteHead == base {
+			return plumbing.ZeroHash, fmt.Errorf("failed to push commit: %w", pushErr)
+		}
+		logger.Info("Branch moved on the remote, applying the edits on top of it",
+			"branch", baseBranch, "head", remoteHead.String(), "attempt", attempt)
+
+		if err := resetTo(repo, remoteHead); err != nil {
+			return plumbing.ZeroHash, err
+		}
+		base = remoteHead
+	}
+}
+
+// commitEdits applies the edits to the worktree and commits all changes
+func commitEdits(repo *git.Repository, dir string, gcr *openchoreov1alpha1.GitCommitRequest) (plumbing.Hash, error) {
+	if err := applyEdits(dir, gcr.Spec.Files); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to apply file edits: %w", err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to get worktree: %w", err)
+	}
+	if _, err := wt.Add("."); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to stage changes: %w", err)
+	}
+	commit, err := wt.Commit(gcr.Spec.Message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  gcr.Spec.Author.Name,
+			Email: gcr.Spec.Author.Email,
+			When:  time.Now(),
+		},
+	})
+	if errors.Is(err, git.ErrEmptyCommit) {
+		return plumbing.ZeroHash, errNothingToCommit
+	}
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create commit: %w", err)
+	}
+	return commit, nil
+}
+
+// fetchBranch fetches the branch from the remote and returns its head
+func fetchBranch(ctx context.Context, repo *git.Repository, branch string, auth transport.AuthMethod) (plumbing.Hash, error) {
+	remoteRef := plumbing.NewRemoteReferenceName(git.DefaultRemoteName, branch)
+	err := repo.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs: []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", plumbing.NewBranchReferenceName(branch), remoteRef))},
+		Depth:    1,
+		Auth:     auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return plumbing.ZeroHash, fmt.Errorf("failed to fetch branch %s: %w", branch, err)
+	}
+	ref, err := repo.Reference(remoteRef, true)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to resolve branch %s: %w", branch, err)
+	}
+	return ref.Hash(), nil
+}
+
+// resetTo moves the checked out branch and the worktree to the commit, dropping the commit of the edits
+func resetTo(repo *git.Repository, commit plumbing.Hash) error {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+	if err := wt.Reset(&git.ResetOptions{Commit: commit, Mode: git.HardReset}); err != nil {
+		return fmt.Errorf("failed to reset to %s: %w", commit, err)
+	}
+	return nil
+}
@@ -0,0 +1,426 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/gitcommitrequest/pullrequest"
+)
+
+// newRemote creates a bare repository with a main branch holding the files, and returns its URL
+func newRemote(t *testing.T, files map[string]string) string {
+	t.Helper()
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	if _, err := git.PlainInit(remote, true); err != nil {
+		t.Fatal(err)
+	}
+	repo, dir := cloneRemote(t, remote, true)
+	commitFiles(t, repo, dir, files)
+	return remote
+}
+
+// cloneRemote clones the main branch of the remote, or sets up an empty clone of it
+func cloneRemote(t *testing.T, remote string, empty bool) (*git.Repository, string) {
+	t.Helper()
+	dir := t.TempDir()
+	if !empty {
+		repo, err := git.PlainClone(dir, false, &git.CloneOptions{URL: remote, ReferenceName: plumbing.Main})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return repo, dir
+	}
+	repo, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.Main},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{remote}}); err != nil {
+		t.Fatal(err)
+	}
+	return repo, dir
+}
+
+// commitFiles commits the files to the main branch and pushes it
+func commitFiles(t *testing.T, repo *git.Repository, dir string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, path), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wt.Add("."); err != nil {
+		t.Fatal(err)
+	}
+	signature := &object.Signature{Name: "dev", Email: "dev@example.com", When: time.Now()}
+	if _, err := wt.Commit("update", &git.CommitOptions{Author: signature}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Push(&git.PushOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readBranchFile reads a file from the head of a branch of the remote
+func readBranchFile(t *testing.T, remote, branch, path string) string {
+	t.Helper()
+	repo, err := git.PlainOpen(remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		t.Fatalf("branch %s: %v", branch, err)
+	}
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := commit.File(path)
+	if err != nil {
+		t.Fatalf("%s on %s: %v", path, branch, err)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+// divergeBranch creates a branch on the remote holding a commit of the files on top of main, which main does not hold
+func divergeBranch(t *testing.T, remote, branch string, files map[string]string) {
+	t.Helper()
+	remoteRepo, err := git.PlainOpen(remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, err := remoteRepo.Reference(plumbing.Main, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, dir := cloneRemote(t, remote, false)
+	commitFiles(t, repo, dir, files)
+	moved, err := remoteRepo.Reference(plumbing.Main, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []*plumbing.Reference{
+		plumbing.NewHashReference(plumbing.NewBranchReferenceName(branch), moved.Hash()),
+		main,
+	} {
+		if err := remoteRepo.Storer.SetReference(ref); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newGitCommitRequest(remote string) *openchoreov1alpha1.GitCommitRequest {
+	return &openchoreov1alpha1.GitCommitRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "promote-orders", Namespace: "default"},
+		Spec: openchoreov1alpha1.GitCommitRequestSpec{
+			RepoURL: remote,
+			Branch:  "main",
+			Message: "Promote orders",
+			Author:  openchoreov1alpha1.GitCommitAuthor{Name: "OpenChoreo", Email: "bot@openchoreo.dev"},
+			Files: []openchoreov1alpha1.FileEdit{
+				{Path: "orders/dev/release.yaml", Content: "kind: Release\n"},
+				{Path: "config.json", Patch: `[{"op":"add","path":"/image","value":"orders:v2"}]`},
+			},
+		},
+	}
+}
+
+func TestCommitAndPushRetriesAfterTheBranchMoved(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemote(t, map[string]string{"config.json": `{"replicas":1}`})
+	gcr := newGitCommitRequest(remote)
+
+	repo, dir := cloneRemote(t, remote, false)
+
+	// Someone else pushes to the branch after it was cloned
+	other, otherDir := cloneRemote(t, remote, false)
+	commitFiles(t, other, otherDir, map[string]string{"config.json": `{"replicas":2}`})
+
+	commit, err := commitAndPush(ctx, repo, dir, gcr, nil, "main", "main", false)
+	if err != nil {
+		t.Fatalf("commitAndPush() error = %v", err)
+	}
+
+	if got := readBranchFile(t, remote, "main", "config.json"); got != `{"replicas":2,"image":"orders:v2"}` {
+		t.Errorf("expected the patch to be applied on top of the new head, got %s", got)
+	}
+	remoteRepo, err := git.PlainOpen(remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := remoteRepo.Reference(plumbing.NewBranchReferenceName("main"), true)
+	if err != nil || ref.Hash() != commit {
+		t.Errorf("expected main to point to the pushed commit %s, got %v", commit, ref)
+	}
+}
+
+func TestCommitAndPushWhenTheBranchAlreadyHoldsTheEdits(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemote(t, map[string]string{"config.json": `{"replicas":1}`})
+	gcr := newGitCommitRequest(remote)
+	gcr.Spec.Files = gcr.Spec.Files[:1]
+
+	repo, dir := cloneRemote(t, remote, false)
+
+	// The same edits are pushed to the branch after it was cloned
+	other, otherDir := cloneRemote(t, remote, false)
+	commitFiles(t, other, otherDir, map[string]string{"orders/dev/release.yaml": "kind: Release\n"})
+	otherHead, err := other.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit, err := commitAndPush(ctx, repo, dir, gcr, nil, "main", "main", false)
+	if !errors.Is(err, errNothingToCommit) {
+		t.Fatalf("commitAndPush() error = %v, want %v", err, errNothingToCommit)
+	}
+	if commit != otherHead.Hash() {
+		t.Errorf("expected the head of the moved branch %s, got %s", otherHead.Hash(), commit)
+	}
+}
+
+type fakeProvider struct {
+	existing *pullrequest.PullRequest
+	created  []pullrequest.Request
+}
+
+func (f *fakeProvider) GetName() string {
+	return "fake"
+}
+
+func (f *fakeProvider) FindPullRequest(_ context.Context, _, _ string) (*pullrequest.PullRequest, error) {
+	return f.existing, nil
+}
+
+func (f *fakeProvider) CreatePullRequest(_ context.Context, req pullrequest.Request) (*pullrequest.PullRequest, error) {
+	f.created = append(f.created, req)
+	return &pullrequest.PullRequest{Number: 7, URL: "https://git.example.com/acme/gitops/pulls/7"}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	reconcileRequest := func(t *testing.T, gcr *openchoreov1alpha1.GitCommitRequest, provider *fakeProvider,
+		objects ...client.Object) *openchoreov1alpha1.GitCommitRequest {
+		t.Helper()
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(append(objects, gcr)...).
+			WithStatusSubresource(&openchoreov1alpha1.GitCommitRequest{}).
+			Build()
+		r := &Reconciler{
+			Client: k8sClient,
+			Scheme: scheme,
+			newPullRequestProvider: func(openchoreov1alpha1.GitPullRequest, string, string) (pullrequest.Provider, error) {
+				return provider, nil
+			},
+		}
+		_, _ = r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gcr)})
+		current := &openchoreov1alpha1.GitCommitRequest{}
+		if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(gcr), current); err != nil {
+			t.Fatal(err)
+		}
+		return current
+	}
+
+	t.Run("push to the branch", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+
+		current := reconcileRequest(t, newGitCommitRequest(remote), nil)
+
+		if current.Status.Phase != "Succeeded" || current.Status.ObservedBranch != "main" {
+			t.Fatalf("unexpected status %+v", current.Status)
+		}
+		if got := readBranchFile(t, remote, "main", "orders/dev/release.yaml"); got != "kind: Release\n" {
+			t.Errorf("unexpected release.yaml %q", got)
+		}
+	})
+
+	t.Run("delete a file", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`, "orders/dev/canary.yaml": "kind: Release\n"})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.Files = []openchoreov1alpha1.FileEdit{{Path: "orders/dev/canary.yaml", Delete: true}}
+
+		current := reconcileRequest(t, gcr, nil)
+
+		if current.Status.Phase != "Succeeded" {
+			t.Fatalf("unexpected status %+v", current.Status)
+		}
+		repo, _ := cloneRemote(t, remote, false)
+		head, err := repo.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		commit, err := repo.CommitObject(head.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := commit.File("orders/dev/canary.yaml"); err == nil {
+			t.Error("expected canary.yaml to be deleted from the branch")
+		}
+	})
+
+	t.Run("no changes to commit", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.Files = []openchoreov1alpha1.FileEdit{{Path: "config.json", Content: `{}`}}
+
+		current := reconcileRequest(t, gcr, nil)
+
+		if current.Status.Phase != "Succeeded" || current.Status.Message != "no changes to commit" {
+			t.Errorf("unexpected status %+v", current.Status)
+		}
+	})
+
+	t.Run("open a pull request", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.AuthSecretRef = "git-credentials"
+		gcr.Spec.PullRequest = &openchoreov1alpha1.GitPullRequest{Provider: openchoreov1alpha1.GitProviderGitea}
+		secret := newSecret(map[string]string{"token": "gitea-token"})
+		secret.Namespace = "default"
+		provider := &fakeProvider{}
+
+		current := reconcileRequest(t, gcr, provider, secret)
+
+		if current.Status.Phase != "Succeeded" || current.Status.PullRequestURL != "https://git.example.com/acme/gitops/pulls/7" {
+			t.Fatalf("unexpected status %+v", current.Status)
+		}
+		if current.Status.ObservedBranch != "openchoreo/promote-orders" {
+			t.Errorf("unexpected observed branch %q", current.Status.ObservedBranch)
+		}
+		want := pullrequest.Request{Title: "Promote orders", HeadBranch: "openchoreo/promote-orders", BaseBranch: "main"}
+		if len(provider.created) != 1 || provider.created[0] != want {
+			t.Errorf("unexpected pull requests %+v", provider.created)
+		}
+		if got := readBranchFile(t, remote, "openchoreo/promote-orders", "orders/dev/release.yaml"); got != "kind: Release\n" {
+			t.Errorf("unexpected release.yaml %q", got)
+		}
+		if got := readBranchFile(t, remote, "main", "config.json"); got != `{}` {
+			t.Errorf("expected main to be left alone, got config.json %q", got)
+		}
+	})
+
+	t.Run("reuse an open pull request", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.AuthSecretRef = "git-credentials"
+		gcr.Spec.PullRequest = &openchoreov1alpha1.GitPullRequest{Provider: openchoreov1alpha1.GitProviderGitHub, HeadBranch: "release"}
+		secret := newSecret(map[string]string{"token": "ghp_secret"})
+		secret.Namespace = "default"
+		provider := &fakeProvider{existing: &pullrequest.PullRequest{Number: 3, URL: "https://github.com/acme/gitops/pull/3"}}
+
+		current := reconcileRequest(t, gcr, provider, secret)
+
+		if current.Status.PullRequestURL != "https://github.com/acme/gitops/pull/3" || len(provider.created) != 0 {
+			t.Errorf("expected the open pull request to be reused, got %+v", current.Status)
+		}
+	})
+
+	t.Run("reject the base branch as head branch", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.AuthSecretRef = "git-credentials"
+		gcr.Spec.PullRequest = &openchoreov1alpha1.GitPullRequest{Provider: openchoreov1alpha1.GitProviderGitea, HeadBranch: "main"}
+		secret := newSecret(map[string]string{"token": "gitea-token"})
+		secret.Namespace = "default"
+		provider := &fakeProvider{}
+
+		current := reconcileRequest(t, gcr, provider, secret)
+
+		if current.Status.Phase != "Failed" || len(provider.created) != 0 {
+			t.Errorf("unexpected status %+v", current.Status)
+		}
+		if got := readBranchFile(t, remote, "main", "config.json"); got != `{}` {
+			t.Errorf("expected main to be left alone, got config.json %q", got)
+		}
+	})
+
+	t.Run("do not overwrite a head branch outside of openchoreo/", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		divergeBranch(t, remote, "release", map[string]string{"config.json": `{"replicas":2}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.AuthSecretRef = "git-credentials"
+		gcr.Spec.PullRequest = &openchoreov1alpha1.GitPullRequest{Provider: openchoreov1alpha1.GitProviderGitHub, HeadBranch: "release"}
+		secret := newSecret(map[string]string{"token": "ghp_secret"})
+		secret.Namespace = "default"
+
+		current := reconcileRequest(t, gcr, &fakeProvider{}, secret)
+
+		if current.Status.Phase != "Failed" {
+			t.Errorf("unexpected status %+v", current.Status)
+		}
+		if got := readBranchFile(t, remote, "release", "config.json"); got != `{"replicas":2}` {
+			t.Errorf("expected release to be left alone, got config.json %q", got)
+		}
+	})
+
+	t.Run("overwrite the head branch of an earlier attempt", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		divergeBranch(t, remote, "openchoreo/promote-orders", map[string]string{"config.json": `{"replicas":2}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.AuthSecretRef = "git-credentials"
+		gcr.Spec.PullRequest = &openchoreov1alpha1.GitPullRequest{Provider: openchoreov1alpha1.GitProviderGitea}
+		secret := newSecret(map[string]string{"token": "gitea-token"})
+		secret.Namespace = "default"
+
+		current := reconcileRequest(t, gcr, &fakeProvider{}, secret)
+
+		if current.Status.Phase != "Succeeded" {
+			t.Fatalf("unexpected status %+v", current.Status)
+		}
+		if got := readBranchFile(t, remote, "openchoreo/promote-orders", "config.json"); got != `{"image":"orders:v2"}` {
+			t.Errorf("expected the head branch to be overwritten, got config.json %q", got)
+		}
+	})
+
+	t.Run("reject paths outside of the repository", func(t *testing.T) {
+		remote := newRemote(t, map[string]string{"config.json": `{}`})
+		gcr := newGitCommitRequest(remote)
+		gcr.Spec.Files = []openchoreov1alpha1.FileEdit{{Path: "../../escape.txt", Content: "x"}}
+
+		current := reconcileRequest(t, gcr, nil)
+
+		if current.Status.Phase != "Failed" {
+			t.Errorf("unexpected status %+v", current.Status)
+		}
+	})
+}
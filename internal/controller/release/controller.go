@@ -22,18 +22,27 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 const (
 	// ControllerName is the name of the controller managing Release resources
 	ControllerName = "release-controller"
+
+	// FieldManager is the server-side apply field manager that owns the fields of the resources
+	// applied to the dataplane. Fields changed by other managers are detected as drift.
+	FieldManager = ControllerName
 )
 
 // Reconciler reconciles a Release object
 type Reconciler struct {
 	client.Client
-	k8sClientMgr *kubernetesClient.KubeMultiClientManager
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
 	Scheme       *runtime.Scheme
+	// TemplateEngine evaluates the CEL health checks of releases, bounded by its cost limits.
+	// An engine with the default cost limits is used if unset.
+	TemplateEngine *template.Engine
 }
 
 // TODO: Optimize to apply resource only if spec has changed
@@ -84,12 +93,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Get desired resources from spec
-	desiredResources, err := r.makeDesiredResources(release)
+	desiredResources, err := MakeDesiredResources(release)
 	if err != nil {
 		logger.Error(err, "Failed to make desired resources")
 		return ctrl.Result{}, err
 	}
 
+	// Resources applied by an external GitOps tool are only observed
+	if release.Spec.ApplyMode == openchoreov1alpha1.ReleaseApplyModeExternal {
+		return r.observeResources(ctx, dpClient, old, release, desiredResources)
+	}
+
 	// Ensure namespaces exist before applying resources
 	desiredNamespaces := r.makeDesiredNamespaces(release, desiredResources)
 	if err := r.ensureNamespaces(ctx, dpClient, desiredNamespaces); err != nil {
@@ -98,8 +112,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// PHASE 1: Apply desired resources to the dataplane
-	// This ensures all resources in the spec are created/updated with proper tracking labels
-	if err := r.applyResources(ctx, dpClient, desiredResources); err != nil {
+	// This ensures all resources in the spec are created/updated with proper tracking labels.
+	// Resources that were changed out-of-band are detected here and re-applied or left in place
+	// depending on the drift policy of the Release.
+	appliedResources, err := r.applyResources(ctx, dpClient, release, desiredResources)
+	if err != nil {
 		logger.Error(err, "Failed to apply resources to dataplane")
 		return ctrl.Result{}, err
 	}
@@ -125,7 +142,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// PHASE 4: Update status with applied resources inventory (done last after all operations)
 	// This maintains an inventory of what we applied for future cleanup operations
-	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources); err != nil || statusUpdated {
+	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources, appliedResources); err != nil || statusUpdated {
 		// Return after updating the status to ensure it is persisted before continuing
 		return ctrl.Result{}, err
 	}
@@ -146,6 +163,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// observeResources updates the status of a Release whose resources are applied by an external GitOps tool
+// from the live resources in the data plane, without changing the data plane.
+func (r *Reconciler) observeResources(ctx context.Context, dpClient client.Client, old, release *openchoreov1alpha1.Release,
+	desiredResources []*unstructured.Unstructured) (ctrl.Result, error) {
+	gvks := findAllKnownGVKs(desiredResources, release.Status.Resources)
+	liveResources, err := r.listLiveResourcesByGVKs(ctx, dpClient, release, gvks)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources, nil); err != nil || statusUpdated {
+		return ctrl.Result{}, err
+	}
+
+	if r.hasTransitioningResources(release.Status.Resources) {
+		return ctrl.Result{RequeueAfter: getProgressingRequeueInterval(release)}, nil
+	}
+	return ctrl.Result{RequeueAfter: getStableRequeueInterval(release)}, nil
+}
+
 // getDPClient gets the dataplane client for the specified environment
 func (r *Reconciler) getDPClient(ctx context.Context, orgName string, environmentName string) (client.Client, error) {
 	env := &openchoreov1alpha1.Environment{}
@@ -158,7 +195,7 @@ func (r *Reconciler) getDPClient(ctx context.Context, orgName string, environmen
 		return nil, fmt.Errorf("failed to get dataplane %s for environment %s: %w", env.Spec.DataPlaneRef, environmentName, err)
 	}
 
-	dpClient, err := kubernetesClient.GetK8sClient(r.k8sClientMgr, dataplane.Namespace, dataplane.Name, dataplane.Spec.KubernetesCluster)
+	dpClient, err := kubernetesClient.GetK8sClient(ctx, r.K8sClientMgr, r.Client, dataplane, dataplane.Spec.KubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dataplane client for %s: %w", dataplane.Name, err)
 	}
@@ -166,22 +203,66 @@ func (r *Reconciler) getDPClient(ctx context.Context, orgName string, environmen
 	return dpClient, nil
 }
 
-// applyResources applies the given resources to the dataplane
-func (r *Reconciler) applyResources(ctx context.Context, dpClient client.Client, resources []*unstructured.Unstructured) error {
+// applyResources applies the given resources to the dataplane using server-side apply.
+// A resource that was already applied with the same content is first checked for drift. Drifted resources
+// are re-applied unless the Release only reports drift, in which case they are left untouched.
+// Returns the outcome of applying each resource keyed by the resource ID.
+func (r *Reconciler) applyResources(ctx context.Context, dpClient client.Client, release *openchoreov1alpha1.Release,
+	resources []*unstructured.Unstructured) (map[string]appliedResource, error) {
+	logger := log.FromContext(ctx)
+
+	previouslyApplied := make(map[string]openchoreov1alpha1.ResourceStatus, len(release.Status.Resources))
+	for _, resource := range release.Status.Resources {
+		previouslyApplied[resource.ID] = resource
+	}
+
+	results := make(map[string]appliedResource, len(resources))
 	for _, obj := range resources {
 		resourceID := obj.GetLabels()[labels.LabelKeyReleaseResourceID]
 
+		hash, err := computeResourceHash(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute hash of resource %s: %w", resourceID, err)
+		}
+		result := appliedResource{hash: hash}
+
+		// Only a resource that is unchanged since it was last applied can drift; otherwise
+		// the differences are caused by the release itself
+		if previous, found := previouslyApplied[resourceID]; found && previous.AppliedHash == hash {
+			drift, err := detectDrift(ctx, dpClient, obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to detect drift of resource %s: %w", resourceID, err)
+			}
+			result.drift = drift
+		}
+
+		if result.drift != nil && release.Spec.DriftPolicy == openchoreov1alpha1.DriftPolicyReport {
+			logger.Info("Resource drifted from the desired state, leaving it in place as per the drift policy",
+				"resourceID", resourceID, "fields", result.drift.Fields, "deleted", result.drift.Deleted)
+			results[resourceID] = result
+			continue
+		}
+
 		// Apply the resource using server-side apply
-		if err := dpClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName)); err != nil {
-			return fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+		if err := dpClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(FieldManager)); err != nil {
+			return nil, fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+		}
+
+		if result.drift != nil {
+			logger.Info("Resource drifted from the desired state, re-applied it",
+				"resourceID", resourceID, "fields", result.drift.Fields, "deleted", result.drift.Deleted)
+			result.drift.Healed = true
 		}
+		results[resourceID] = result
 	}
 
-	return nil
+	return results, nil
 }
 
-// makeDesiredResources creates the desired resources from the Release spec
-func (r *Reconciler) makeDesiredResources(release *openchoreov1alpha1.Release) ([]*unstructured.Unstructured, error) {
+// MakeDesiredResources creates the desired resources from the Release spec, with the labels that the
+// release controller tracks them by. Resources exported for external GitOps tools carry the same labels,
+// so that the release controller can observe them once they are applied.
+func MakeDesiredResources(release *openchoreov1alpha1.Release) ([]*unstructured.Unstructured, error) {
 	desiredObjects := make([]*unstructured.Unstructured, 0, len(release.Spec.Resources))
 
 	for _, resource := range release.Spec.Resources {
@@ -517,8 +598,8 @@ func addJitter(base time.Duration, maxJitter time.Duration) time.Duration {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -14,6 +14,8 @@ import (
 const (
 	// ConditionFinalizing represents whether the Release is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+	// ConditionDrifted represents whether resources in the data plane drifted from the desired state
+	ConditionDrifted controller.ConditionType = "Drifted"
 )
 
 // Constants for condition reasons
@@ -25,6 +27,15 @@ const (
 	ReasonCleanupInProgress controller.ConditionReason = "CleanupInProgress"
 	// ReasonCleanupFailed cleanup of dataplane resources failed
 	ReasonCleanupFailed controller.ConditionReason = "CleanupFailed"
+
+	// Reasons for Drifted condition type
+
+	// ReasonDriftDetected resources were changed out-of-band and the drift is left in place
+	ReasonDriftDetected controller.ConditionReason = "DriftDetected"
+	// ReasonDriftHealed resources were changed out-of-band and the desired state was re-applied
+	ReasonDriftHealed controller.ConditionReason = "DriftHealed"
+	// ReasonNoDrift resources match the desired state
+	ReasonNoDrift controller.ConditionReason = "NoDrift"
 )
 
 func NewReleaseFinalizingCondition(generation int64) metav1.Condition {
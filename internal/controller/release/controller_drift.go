@@ -0,0 +1,168 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// appliedResource records the outcome of applying a desired resource to the dataplane
+type appliedResource struct {
+	// hash of the desired resource that is in effect in the dataplane
+	hash string
+	// drift detected on the live resource before it was applied, nil if the resource did not drift
+	drift *openchoreov1alpha1.ResourceDrift
+}
+
+// computeResourceHash computes a hash of a desired resource. The hash is stored in the status
+// once the resource is applied so that later reconciliations can tell whether the desired
+// resource changed since it was last applied.
+func computeResourceHash(obj *unstructured.Unstructured) (string, error) {
+	// json.Marshal sorts map keys, so the encoding is deterministic
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	hasher := fnv.New64a()
+	hasher.Write(data)
+	return fmt.Sprintf("%016x", hasher.Sum64()), nil
+}
+
+// detectDrift checks whether the live resource in the dataplane was changed out-of-band.
+// The desired resource is applied as a dry run so that the API server fills in defaults and merges
+// the fields owned by other field managers; any difference between the dry run result and the live
+// resource is a field that applying the desired resource would change.
+// Returns nil if the live resource matches the desired resource.
+func detectDrift(ctx context.Context, dpClient client.Client, desired *unstructured.Unstructured) (*openchoreov1alpha1.ResourceDrift, error) {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := dpClient.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &openchoreov1alpha1.ResourceDrift{
+				Deleted:    true,
+				DetectedAt: metav1.Now(),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get live resource: %w", err)
+	}
+
+	dryRun := desired.DeepCopy()
+	if err := dpClient.Patch(ctx, dryRun, client.Apply, client.ForceOwnership, client.FieldOwner(FieldManager), client.DryRunAll); err != nil {
+		return nil, fmt.Errorf("failed to dry run apply: %w", err)
+	}
+
+	fields := findDriftedFields(normalizeForDrift(dryRun), normalizeForDrift(live))
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return &openchoreov1alpha1.ResourceDrift{
+		Fields:     fields,
+		DetectedAt: metav1.Now(),
+	}, nil
+}
+
+// normalizeForDrift removes the fields that the API server changes on every write and the status,
+// which is not part of the desired state
+func normalizeForDrift(obj *unstructured.Unstructured) map[string]any {
+	normalized := obj.DeepCopy().Object
+	unstructured.RemoveNestedField(normalized, "metadata", "managedFields")
+	unstructured.RemoveNestedField(normalized, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(normalized, "metadata", "generation")
+	unstructured.RemoveNestedField(normalized, "status")
+	return normalized
+}
+
+// findDriftedFields returns the sorted paths of the fields that differ between the expected and the actual object.
+// Nested fields are separated by dots and list items are addressed by their index, e.g. "spec.containers[0].image".
+func findDriftedFields(expected, actual map[string]any) []string {
+	var fields []string
+	collectDriftedFields("", expected, actual, &fields)
+	sort.Strings(fields)
+	return fields
+}
+
+func collectDriftedFields(path string, expected, actual any, fields *[]string) {
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		if !ok {
+			*fields = append(*fields, path)
+			return
+		}
+		keys := make(map[string]bool, len(expectedValue)+len(actualValue))
+		for key := range expectedValue {
+			keys[key] = true
+		}
+		for key := range actualValue {
+			keys[key] = true
+		}
+		for key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			collectDriftedFields(fieldPath, expectedValue[key], actualValue[key], fields)
+		}
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok || len(expectedValue) != len(actualValue) {
+			*fields = append(*fields, path)
+			return
+		}
+		for i := range expectedValue {
+			collectDriftedFields(fmt.Sprintf("%s[%d]", path, i), expectedValue[i], actualValue[i], fields)
+		}
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			*fields = append(*fields, path)
+		}
+	}
+}
+
+// isSameDrift checks whether two drifts describe the same out-of-band changes
+func isSameDrift(a, b *openchoreov1alpha1.ResourceDrift) bool {
+	return a.Deleted == b.Deleted && a.Healed == b.Healed && slices.Equal(a.Fields, b.Fields)
+}
+
+// setDriftCondition sets the Drifted condition of the Release from the drift recorded on its resources
+func setDriftCondition(release *openchoreov1alpha1.Release) {
+	var drifted, healed []string
+	for _, resource := range release.Status.Resources {
+		if resource.Drift == nil {
+			continue
+		}
+		if resource.Drift.Healed {
+			healed = append(healed, resource.ID)
+		} else {
+			drifted = append(drifted, resource.ID)
+		}
+	}
+
+	switch {
+	case len(drifted) > 0:
+		controller.MarkTrueCondition(release, ConditionDrifted, ReasonDriftDetected,
+			fmt.Sprintf("Resources changed out-of-band: %s", strings.Join(drifted, ", ")))
+	case len(healed) > 0:
+		controller.MarkFalseCondition(release, ConditionDrifted, ReasonDriftHealed,
+			fmt.Sprintf("Re-applied resources changed out-of-band: %s", strings.Join(healed, ", ")))
+	default:
+		controller.MarkFalseCondition(release, ConditionDrifted, ReasonNoDrift,
+			"Resources match the desired state")
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// TestFindDriftedFields tests finding the fields that were changed on a live resource
+func TestFindDriftedFields(t *testing.T) {
+	expected := map[string]any{
+		"metadata": map[string]any{
+			"name":   "app",
+			"labels": map[string]any{"app": "web"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"containers": []any{
+				map[string]any{"name": "main", "image": "app:v1"},
+			},
+			"ports": []any{int64(80)},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(obj map[string]any)
+		want   []string
+	}{
+		{
+			name:   "no changes",
+			mutate: func(obj map[string]any) {},
+			want:   nil,
+		},
+		{
+			name: "scalar changed",
+			mutate: func(obj map[string]any) {
+				_ = unstructured.SetNestedField(obj, int64(5), "spec", "replicas")
+			},
+			want: []string{"spec.replicas"},
+		},
+		{
+			name: "field added and removed",
+			mutate: func(obj map[string]any) {
+				_ = unstructured.SetNestedField(obj, "other", "metadata", "labels", "team")
+				unstructured.RemoveNestedField(obj, "metadata", "labels", "app")
+			},
+			want: []string{"metadata.labels.app", "metadata.labels.team"},
+		},
+		{
+			name: "list item changed",
+			mutate: func(obj map[string]any) {
+				containers, _, _ := unstructured.NestedSlice(obj, "spec", "containers")
+				containers[0].(map[string]any)["image"] = "app:debug"
+				_ = unstructured.SetNestedSlice(obj, containers, "spec", "containers")
+			},
+			want: []string{"spec.containers[0].image"},
+		},
+		{
+			name: "list length changed",
+			mutate: func(obj map[string]any) {
+				_ = unstructured.SetNestedSlice(obj, []any{int64(80), int64(443)}, "spec", "ports")
+			},
+			want: []string{"spec.ports"},
+		},
+		{
+			name: "type changed",
+			mutate: func(obj map[string]any) {
+				_ = unstructured.SetNestedField(obj, "web", "metadata", "labels")
+			},
+			want: []string{"metadata.labels"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := (&unstructured.Unstructured{Object: expected}).DeepCopy().Object
+			tt.mutate(actual)
+
+			got := findDriftedFields(expected, actual)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("findDriftedFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNormalizeForDrift tests that server managed fields are ignored when comparing resources
+func TestNormalizeForDrift(t *testing.T) {
+	applied := newTestObject("apps/v1", "Deployment", map[string]any{"replicas": int64(1)}, map[string]any{"readyReplicas": int64(1)})
+	applied.SetResourceVersion("2")
+	applied.SetGeneration(3)
+	applied.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: FieldManager}})
+
+	live := newTestObject("apps/v1", "Deployment", map[string]any{"replicas": int64(1)}, nil)
+	live.SetResourceVersion("1")
+
+	if fields := findDriftedFields(normalizeForDrift(applied), normalizeForDrift(live)); len(fields) != 0 {
+		t.Errorf("expected no drifted fields, got %v", fields)
+	}
+}
+
+// TestComputeResourceHash tests that the hash only changes with the content of the resource
+func TestComputeResourceHash(t *testing.T) {
+	obj := newTestObject("v1", "ConfigMap", nil, nil)
+	obj.Object["data"] = map[string]any{"a": "1", "b": "2"}
+
+	hash, err := computeResourceHash(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	same, err := computeResourceHash(obj.DeepCopy())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hash != same {
+		t.Errorf("expected equal hashes for equal resources, got %s and %s", hash, same)
+	}
+
+	changed := obj.DeepCopy()
+	changed.Object["data"] = map[string]any{"a": "1", "b": "3"}
+	other, err := computeResourceHash(changed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hash == other {
+		t.Errorf("expected different hashes for different resources, got %s", hash)
+	}
+}
+
+// TestSetDriftCondition tests the Drifted condition of a Release
+func TestSetDriftCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		resources  []openchoreov1alpha1.ResourceStatus
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "no drift",
+			resources:  []openchoreov1alpha1.ResourceStatus{{ID: "deployment"}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonNoDrift),
+		},
+		{
+			name: "healed drift",
+			resources: []openchoreov1alpha1.ResourceStatus{
+				{ID: "deployment", Drift: &openchoreov1alpha1.ResourceDrift{Fields: []string{"spec.replicas"}, Healed: true}},
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonDriftHealed),
+		},
+		{
+			name: "reported drift takes precedence over healed drift",
+			resources: []openchoreov1alpha1.ResourceStatus{
+				{ID: "deployment", Drift: &openchoreov1alpha1.ResourceDrift{Fields: []string{"spec.replicas"}, Healed: true}},
+				{ID: "service", Drift: &openchoreov1alpha1.ResourceDrift{Deleted: true}},
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonDriftDetected),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := &openchoreov1alpha1.Release{Status: openchoreov1alpha1.ReleaseStatus{Resources: tt.resources}}
+			setDriftCondition(release)
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, string(ConditionDrifted))
+			if condition == nil {
+				t.Fatal("expected Drifted condition to be set")
+			}
+			if condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("got condition %s/%s, want %s/%s", condition.Status, condition.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+// TestBuildResourceStatusDrift tests how drift is carried over between reconciliations
+func TestBuildResourceStatusDrift(t *testing.T) {
+	desired := newTestObject("v1", "ConfigMap", nil, nil)
+	desired.SetLabels(map[string]string{labels.LabelKeyReleaseResourceID: "config"})
+
+	firstDetected := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	oldRelease := func(drift *openchoreov1alpha1.ResourceDrift) *openchoreov1alpha1.Release {
+		return &openchoreov1alpha1.Release{Status: openchoreov1alpha1.ReleaseStatus{
+			Resources: []openchoreov1alpha1.ResourceStatus{{ID: "config", AppliedHash: "hash-1", Drift: drift}},
+		}}
+	}
+
+	tests := []struct {
+		name           string
+		old            *openchoreov1alpha1.Release
+		applied        appliedResource
+		wantDrift      bool
+		wantDetectedAt *metav1.Time
+	}{
+		{
+			name:      "healed drift is kept while the resource is unchanged",
+			old:       oldRelease(&openchoreov1alpha1.ResourceDrift{Healed: true, DetectedAt: firstDetected}),
+			applied:   appliedResource{hash: "hash-1"},
+			wantDrift: true,
+		},
+		{
+			name:    "healed drift is cleared when the release changes the resource",
+			old:     oldRelease(&openchoreov1alpha1.ResourceDrift{Healed: true, DetectedAt: firstDetected}),
+			applied: appliedResource{hash: "hash-2"},
+		},
+		{
+			name:    "reported drift is cleared once the resource matches again",
+			old:     oldRelease(&openchoreov1alpha1.ResourceDrift{Fields: []string{"data.key"}, DetectedAt: firstDetected}),
+			applied: appliedResource{hash: "hash-1"},
+		},
+		{
+			name: "ongoing drift keeps the time it was first detected",
+			old:  oldRelease(&openchoreov1alpha1.ResourceDrift{Fields: []string{"data.key"}, DetectedAt: firstDetected}),
+			applied: appliedResource{hash: "hash-1", drift: &openchoreov1alpha1.ResourceDrift{
+				Fields: []string{"data.key"}, DetectedAt: metav1.Now(),
+			}},
+			wantDrift:      true,
+			wantDetectedAt: &firstDetected,
+		},
+	}
+
+	r := &Reconciler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := r.buildResourceStatus(context.Background(), tt.old, []*unstructured.Unstructured{desired}, nil,
+				map[string]appliedResource{"config": tt.applied})
+			if len(statuses) != 1 {
+				t.Fatalf("expected 1 resource status, got %d", len(statuses))
+			}
+
+			status := statuses[0]
+			if status.AppliedHash != tt.applied.hash {
+				t.Errorf("AppliedHash = %q, want %q", status.AppliedHash, tt.applied.hash)
+			}
+			if (status.Drift != nil) != tt.wantDrift {
+				t.Fatalf("Drift = %v, want drift %v", status.Drift, tt.wantDrift)
+			}
+			if tt.wantDetectedAt != nil && !status.Drift.DetectedAt.Equal(tt.wantDetectedAt) {
+				t.Errorf("DetectedAt = %v, want %v", status.Drift.DetectedAt, tt.wantDetectedAt)
+			}
+		})
+	}
+}
@@ -55,6 +55,17 @@ func (r *Reconciler) finalize(ctx context.Context, old, release *openchoreov1alp
 		return ctrl.Result{}, nil
 	}
 
+	// Resources applied by an external GitOps tool are pruned by that tool once the ReleaseBinding finalizer
+	// removes them from Git
+	if release.Spec.ApplyMode == openchoreov1alpha1.ReleaseApplyModeExternal {
+		if controllerutil.RemoveFinalizer(release, DataPlaneCleanupFinalizer) {
+			if err := r.Update(ctx, release); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// STEP 2: Get dataplane client and find all managed resources
 	dpClient, err := r.getDPClient(ctx, release.Namespace, release.Spec.EnvironmentName)
 	if err != nil {
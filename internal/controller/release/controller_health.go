@@ -0,0 +1,274 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// defaultHealthExpressionEngine evaluates the CEL health expressions declared on releases when the
+// reconciler has no template engine. The engine caches compiled programs, so it is shared across reconciliations.
+var defaultHealthExpressionEngine = template.NewEngine()
+
+// healthExpressionEngine returns the engine evaluating the CEL health expressions declared on releases.
+func (r *Reconciler) healthExpressionEngine() *template.Engine {
+	if r.TemplateEngine != nil {
+		return r.TemplateEngine
+	}
+	return defaultHealthExpressionEngine
+}
+
+// getHealthCheckFuncForRelease returns the health check for a resource type, preferring a CEL
+// health check declared on the release over the built-in health check. CEL health checks are
+// evaluated with the engine against the given budget.
+func getHealthCheckFuncForRelease(healthChecks []openchoreov1alpha1.HealthCheck, gvk schema.GroupVersionKind,
+	engine *template.Engine, budget *template.Budget) HealthCheckFunc {
+	apiVersion := gvk.GroupVersion().String()
+	for _, check := range healthChecks {
+		if check.APIVersion == apiVersion && check.Kind == gvk.Kind {
+			return newCELHealthCheckFunc(engine, check.Expression, budget)
+		}
+	}
+	return GetHealthCheckFunc(gvk)
+}
+
+// newCELHealthCheckFunc creates a health check that evaluates a CEL expression against the live resource.
+// The resource is available to the expression as "object".
+func newCELHealthCheckFunc(engine *template.Engine, expression string, budget *template.Budget) HealthCheckFunc {
+	return func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+		result, err := engine.Render(expression, map[string]any{"object": obj.Object}, template.WithBudget(budget))
+		if err != nil {
+			return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to evaluate health expression: %w", err)
+		}
+		return toHealthStatus(result)
+	}
+}
+
+// toHealthStatus converts the result of a health expression to a HealthStatus.
+// A boolean result maps true to Healthy and false to Progressing.
+func toHealthStatus(result any) (openchoreov1alpha1.HealthStatus, error) {
+	switch v := result.(type) {
+	case bool:
+		if v {
+			return openchoreov1alpha1.HealthStatusHealthy, nil
+		}
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	case string:
+		switch status := openchoreov1alpha1.HealthStatus(v); status {
+		case openchoreov1alpha1.HealthStatusHealthy,
+			openchoreov1alpha1.HealthStatusProgressing,
+			openchoreov1alpha1.HealthStatusDegraded,
+			openchoreov1alpha1.HealthStatusSuspended,
+			openchoreov1alpha1.HealthStatusUnknown:
+			return status, nil
+		}
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("health expression returned unknown health status %q", v)
+	default:
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("health expression must return a string or a boolean, got %T", result)
+	}
+}
+
+func getJobHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var job batchv1.Job
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to job: %w", err)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return openchoreov1alpha1.HealthStatusHealthy, nil
+		case batchv1.JobFailed:
+			return openchoreov1alpha1.HealthStatusDegraded, nil
+		}
+	}
+
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return openchoreov1alpha1.HealthStatusSuspended, nil
+	}
+
+	// Job is still running or waiting to be started
+	return openchoreov1alpha1.HealthStatusProgressing, nil
+}
+
+func getServiceHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var service corev1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &service); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to service: %w", err)
+	}
+
+	// Only load balancers need to wait for an external address to be assigned
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) == 0 {
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+	return openchoreov1alpha1.HealthStatusHealthy, nil
+}
+
+func getPersistentVolumeClaimHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var pvc corev1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pvc); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to persistentvolumeclaim: %w", err)
+	}
+
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	case corev1.ClaimLost:
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	default:
+		// Pending claims may be waiting for the first consumer when the storage class binds lazily
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+}
+
+// getHorizontalPodAutoscalerHealth checks the health of an HPA using the conditions reported by
+// autoscaling/v2. The autoscaling/v1 API does not expose conditions, so v1 HPAs are considered healthy.
+func getHorizontalPodAutoscalerHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	if obj.GroupVersionKind().Version == "v1" {
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	}
+
+	conditions, err := getStatusConditions(obj)
+	if err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, err
+	}
+	if len(conditions) == 0 {
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+
+	if condition := meta.FindStatusCondition(conditions, "AbleToScale"); condition != nil && condition.Status == metav1.ConditionFalse {
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	}
+	if condition := meta.FindStatusCondition(conditions, "ScalingActive"); condition != nil && condition.Status == metav1.ConditionFalse {
+		// Scaling is deliberately disabled when the target is scaled to zero
+		if condition.Reason == "ScalingDisabled" {
+			return openchoreov1alpha1.HealthStatusSuspended, nil
+		}
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	}
+	return openchoreov1alpha1.HealthStatusHealthy, nil
+}
+
+// getHTTPRouteHealth checks that every parent gateway has accepted the route and resolved its backends
+func getHTTPRouteHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var route gatewayv1.HTTPRoute
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &route); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to httproute: %w", err)
+	}
+
+	// No gateway has picked up the route yet
+	if len(route.Status.Parents) == 0 {
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+
+	health := openchoreov1alpha1.HealthStatusHealthy
+	for _, parent := range route.Status.Parents {
+		accepted := meta.FindStatusCondition(parent.Conditions, string(gatewayv1.RouteConditionAccepted))
+		resolvedRefs := meta.FindStatusCondition(parent.Conditions, string(gatewayv1.RouteConditionResolvedRefs))
+		if accepted == nil {
+			health = openchoreov1alpha1.HealthStatusProgressing
+			continue
+		}
+		if accepted.ObservedGeneration != 0 && accepted.ObservedGeneration < route.Generation {
+			// The gateway has not observed the latest route spec yet
+			health = openchoreov1alpha1.HealthStatusProgressing
+			continue
+		}
+		if accepted.Status == metav1.ConditionFalse {
+			return openchoreov1alpha1.HealthStatusDegraded, nil
+		}
+		if resolvedRefs != nil && resolvedRefs.Status == metav1.ConditionFalse {
+			return openchoreov1alpha1.HealthStatusDegraded, nil
+		}
+		if accepted.Status != metav1.ConditionTrue {
+			health = openchoreov1alpha1.HealthStatusProgressing
+		}
+	}
+	return health, nil
+}
+
+func getExternalSecretHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	conditions, err := getStatusConditions(obj)
+	if err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, err
+	}
+
+	ready := meta.FindStatusCondition(conditions, "Ready")
+	switch {
+	case ready == nil:
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	case ready.Status == metav1.ConditionTrue:
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	case ready.Status == metav1.ConditionFalse:
+		// The secret could not be synced from the provider
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	default:
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+}
+
+func getCertificateHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	conditions, err := getStatusConditions(obj)
+	if err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, err
+	}
+
+	ready := meta.FindStatusCondition(conditions, "Ready")
+	if ready != nil && ready.Status == metav1.ConditionTrue {
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	}
+
+	// A certificate that is not ready is fine as long as it is being issued
+	if issuing := meta.FindStatusCondition(conditions, "Issuing"); issuing != nil && issuing.Status == metav1.ConditionTrue {
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+	if ready != nil && ready.Status == metav1.ConditionFalse {
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	}
+	return openchoreov1alpha1.HealthStatusProgressing, nil
+}
+
+// getStatusConditions reads status.conditions of a resource that follows the metav1.Condition convention
+func getStatusConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	rawConditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status conditions: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	conditions := make([]metav1.Condition, 0, len(rawConditions))
+	for _, rawCondition := range rawConditions {
+		conditionMap, ok := rawCondition.(map[string]any)
+		if !ok {
+			continue
+		}
+		// Only the fields used for health checks are read, so that conditions with
+		// missing or differently formatted optional fields are still accepted.
+		condition := metav1.Condition{}
+		condition.Type, _, _ = unstructured.NestedString(conditionMap, "type")
+		status, _, _ := unstructured.NestedString(conditionMap, "status")
+		condition.Status = metav1.ConditionStatus(status)
+		condition.Reason, _, _ = unstructured.NestedString(conditionMap, "reason")
+		condition.ObservedGeneration, _, _ = unstructured.NestedInt64(conditionMap, "observedGeneration")
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
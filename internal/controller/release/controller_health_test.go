@@ -0,0 +1,360 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+func newTestObject(apiVersion, kind string, spec, status map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]any{"name": "test", "namespace": "dp-ns", "generation": int64(2)},
+	}}
+	if spec != nil {
+		obj.Object["spec"] = spec
+	}
+	if status != nil {
+		obj.Object["status"] = status
+	}
+	return obj
+}
+
+func conditions(conds ...map[string]any) []any {
+	result := make([]any, 0, len(conds))
+	for _, c := range conds {
+		result = append(result, c)
+	}
+	return result
+}
+
+// TestBuiltInHealthChecks tests the built-in health checks for resource kinds other than workloads
+func TestBuiltInHealthChecks(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  *unstructured.Unstructured
+		want openchoreov1alpha1.HealthStatus
+	}{
+		{
+			name: "Completed job",
+			obj: newTestObject("batch/v1", "Job", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "Complete", "status": "True"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Failed job",
+			obj: newTestObject("batch/v1", "Job", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "Failed", "status": "True"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "Suspended job",
+			obj:  newTestObject("batch/v1", "Job", map[string]any{"suspend": true}, nil),
+			want: openchoreov1alpha1.HealthStatusSuspended,
+		},
+		{
+			name: "Running job",
+			obj:  newTestObject("batch/v1", "Job", nil, map[string]any{"active": int64(1)}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "ClusterIP service",
+			obj:  newTestObject("v1", "Service", map[string]any{"type": "ClusterIP"}, nil),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Load balancer without address",
+			obj:  newTestObject("v1", "Service", map[string]any{"type": "LoadBalancer"}, map[string]any{}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "Load balancer with address",
+			obj: newTestObject("v1", "Service", map[string]any{"type": "LoadBalancer"}, map[string]any{
+				"loadBalancer": map[string]any{"ingress": []any{map[string]any{"ip": "10.0.0.1"}}},
+			}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Bound PVC",
+			obj:  newTestObject("v1", "PersistentVolumeClaim", nil, map[string]any{"phase": "Bound"}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Pending PVC",
+			obj:  newTestObject("v1", "PersistentVolumeClaim", nil, map[string]any{"phase": "Pending"}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "Lost PVC",
+			obj:  newTestObject("v1", "PersistentVolumeClaim", nil, map[string]any{"phase": "Lost"}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "Active HPA",
+			obj: newTestObject("autoscaling/v2", "HorizontalPodAutoscaler", nil, map[string]any{
+				"conditions": conditions(
+					map[string]any{"type": "AbleToScale", "status": "True"},
+					map[string]any{"type": "ScalingActive", "status": "True"},
+				),
+			}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "HPA failing to get metrics",
+			obj: newTestObject("autoscaling/v2", "HorizontalPodAutoscaler", nil, map[string]any{
+				"conditions": conditions(
+					map[string]any{"type": "AbleToScale", "status": "True"},
+					map[string]any{"type": "ScalingActive", "status": "False", "reason": "FailedGetResourceMetric"},
+				),
+			}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "HPA with scaling disabled",
+			obj: newTestObject("autoscaling/v2", "HorizontalPodAutoscaler", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "ScalingActive", "status": "False", "reason": "ScalingDisabled"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusSuspended,
+		},
+		{
+			name: "HPA v2 without conditions",
+			obj:  newTestObject("autoscaling/v2", "HorizontalPodAutoscaler", nil, nil),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "HTTPRoute without parents",
+			obj:  newTestObject("gateway.networking.k8s.io/v1", "HTTPRoute", nil, map[string]any{}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "HTTPRoute accepted",
+			obj: newTestObject("gateway.networking.k8s.io/v1", "HTTPRoute", nil, map[string]any{
+				"parents": []any{map[string]any{
+					"parentRef":      map[string]any{"name": "gateway"},
+					"controllerName": "example.com/gateway",
+					"conditions": conditions(
+						map[string]any{"type": "Accepted", "status": "True", "observedGeneration": int64(2)},
+						map[string]any{"type": "ResolvedRefs", "status": "True", "observedGeneration": int64(2)},
+					),
+				}},
+			}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "HTTPRoute accepted for an older generation",
+			obj: newTestObject("gateway.networking.k8s.io/v1", "HTTPRoute", nil, map[string]any{
+				"parents": []any{map[string]any{
+					"parentRef":      map[string]any{"name": "gateway"},
+					"controllerName": "example.com/gateway",
+					"conditions":     conditions(map[string]any{"type": "Accepted", "status": "True", "observedGeneration": int64(1)}),
+				}},
+			}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "HTTPRoute not accepted",
+			obj: newTestObject("gateway.networking.k8s.io/v1", "HTTPRoute", nil, map[string]any{
+				"parents": []any{map[string]any{
+					"parentRef":      map[string]any{"name": "gateway"},
+					"controllerName": "example.com/gateway",
+					"conditions":     conditions(map[string]any{"type": "Accepted", "status": "False", "reason": "NotAllowedByListeners"}),
+				}},
+			}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "HTTPRoute with unresolved backends",
+			obj: newTestObject("gateway.networking.k8s.io/v1", "HTTPRoute", nil, map[string]any{
+				"parents": []any{map[string]any{
+					"parentRef":      map[string]any{"name": "gateway"},
+					"controllerName": "example.com/gateway",
+					"conditions": conditions(
+						map[string]any{"type": "Accepted", "status": "True"},
+						map[string]any{"type": "ResolvedRefs", "status": "False", "reason": "BackendNotFound"},
+					),
+				}},
+			}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "Synced external secret",
+			obj: newTestObject("external-secrets.io/v1beta1", "ExternalSecret", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "Ready", "status": "True", "reason": "SecretSynced"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "External secret failing to sync",
+			obj: newTestObject("external-secrets.io/v1beta1", "ExternalSecret", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "Ready", "status": "False", "reason": "SecretSyncedError"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "New external secret",
+			obj:  newTestObject("external-secrets.io/v1beta1", "ExternalSecret", nil, nil),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "Ready certificate",
+			obj: newTestObject("cert-manager.io/v1", "Certificate", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "Ready", "status": "True"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Certificate being issued",
+			obj: newTestObject("cert-manager.io/v1", "Certificate", nil, map[string]any{
+				"conditions": conditions(
+					map[string]any{"type": "Ready", "status": "False"},
+					map[string]any{"type": "Issuing", "status": "True"},
+				),
+			}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "Certificate failed to issue",
+			obj: newTestObject("cert-manager.io/v1", "Certificate", nil, map[string]any{
+				"conditions": conditions(map[string]any{"type": "Ready", "status": "False"}),
+			}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetHealthCheckFunc(tt.obj.GroupVersionKind())(tt.obj)
+			if err != nil {
+				t.Fatalf("health check returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("health = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCELHealthChecks tests health expressions declared on a release
+func TestCELHealthChecks(t *testing.T) {
+	widget := func(status map[string]any) *unstructured.Unstructured {
+		return newTestObject("example.com/v1", "Widget", nil, status)
+	}
+
+	tests := []struct {
+		name         string
+		healthChecks []openchoreov1alpha1.HealthCheck
+		obj          *unstructured.Unstructured
+		want         openchoreov1alpha1.HealthStatus
+		wantErr      bool
+	}{
+		{
+			name: "Expression returning a health status",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Expression: "${has(object.status.phase) && object.status.phase == 'Failed' ? 'Degraded' : 'Progressing'}",
+			}},
+			obj:  widget(map[string]any{"phase": "Failed"}),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "Expression returning true",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Expression: "${object.status.ready}",
+			}},
+			obj:  widget(map[string]any{"ready": true}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Expression returning false",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Expression: "${object.status.ready}",
+			}},
+			obj:  widget(map[string]any{"ready": false}),
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "Expression returning an unknown status",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Expression: "${'Fine'}",
+			}},
+			obj:     widget(nil),
+			want:    openchoreov1alpha1.HealthStatusUnknown,
+			wantErr: true,
+		},
+		{
+			name: "Expression failing to evaluate",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Expression: "${object.status.ready}",
+			}},
+			obj:     widget(nil),
+			want:    openchoreov1alpha1.HealthStatusUnknown,
+			wantErr: true,
+		},
+		{
+			name: "Health check for another version falls back to the built-in check",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "example.com/v2",
+				Kind:       "Widget",
+				Expression: "${'Degraded'}",
+			}},
+			obj:  widget(nil),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "Health check overrides a built-in check",
+			healthChecks: []openchoreov1alpha1.HealthCheck{{
+				APIVersion: "v1",
+				Kind:       "PersistentVolumeClaim",
+				Expression: "${object.status.phase == 'Pending' ? 'Healthy' : 'Progressing'}",
+			}},
+			obj:  newTestObject("v1", "PersistentVolumeClaim", nil, map[string]any{"phase": "Pending"}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getHealthCheckFuncForRelease(tt.healthChecks, tt.obj.GroupVersionKind(), template.NewEngine(), template.NewBudget(context.Background()))(tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("health check error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("health = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCELHealthCheckIsInterruptedWithTheBudget(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	check := newCELHealthCheckFunc(template.NewEngine(), "${object.status.ready ? 'Healthy' : 'Progressing'}", template.NewBudget(ctx))
+	got, err := check(newTestObject("example.com/v1", "Widget", nil, map[string]any{"ready": true}))
+	if !template.IsEvaluationBudgetError(err) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("health check error = %v, want an interrupted evaluation", err)
+	}
+	if got != openchoreov1alpha1.HealthStatusUnknown {
+		t.Errorf("health = %q, want %q", got, openchoreov1alpha1.HealthStatusUnknown)
+	}
+}
@@ -21,18 +21,21 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 // updateStatus updates the Release status with applied resources
 // Returns true if the status was updated, false if unchanged
-func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.Release, appliedResources, liveResources []*unstructured.Unstructured) (bool, error) {
+func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.Release, desiredResources, liveResources []*unstructured.Unstructured,
+	appliedResources map[string]appliedResource) (bool, error) {
 	logger := log.FromContext(ctx)
 
 	// Build resource status from applied and live resources
-	resourceStatuses := r.buildResourceStatus(ctx, old, appliedResources, liveResources)
+	resourceStatuses := r.buildResourceStatus(ctx, old, desiredResources, liveResources, appliedResources)
 
 	// Update the status
 	release.Status.Resources = resourceStatuses
+	setDriftCondition(release)
 
 	// Check if the entire status actually changed and skip update if not
 	if apiequality.Semantic.DeepEqual(old.Status, release.Status) {
@@ -49,7 +52,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov
 }
 
 // buildResourceStatus converts applied unstructured objects to ResourceStatus entries using live resources
-func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1alpha1.Release, desiredResources, liveResources []*unstructured.Unstructured) []openchoreov1alpha1.ResourceStatus {
+func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1alpha1.Release, desiredResources, liveResources []*unstructured.Unstructured,
+	appliedResources map[string]appliedResource) []openchoreov1alpha1.ResourceStatus {
 	logger := log.FromContext(ctx)
 	// Build a map of live resources for quick lookup by resource ID
 	liveResourceMap := make(map[string]*unstructured.Unstructured)
@@ -67,6 +71,9 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 
 	resourceStatuses := make([]openchoreov1alpha1.ResourceStatus, 0, len(desiredResources))
 
+	// The health expressions of all resources are evaluated against a single budget, interrupted with the reconcile
+	budget := template.NewBudget(ctx)
+
 	for _, desiredObj := range desiredResources {
 		gvk := desiredObj.GroupVersionKind()
 		resourceID := desiredObj.GetLabels()[labels.LabelKeyReleaseResourceID]
@@ -93,8 +100,8 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 				}
 			}
 
-			// Get health check function for this resource type
-			healthCheckFunc := GetHealthCheckFunc(gvk)
+			// Get health check function for this resource type, preferring the release's own health checks
+			healthCheckFunc := getHealthCheckFuncForRelease(old.Spec.HealthChecks, gvk, r.healthExpressionEngine(), budget)
 			if healthCheckFunc != nil {
 				health, err := healthCheckFunc(liveResource)
 				if err != nil {
@@ -129,6 +136,19 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 			}
 		}
 
+		applied := appliedResources[resourceID]
+		drift := applied.drift
+		if oldResource, exists := oldResourceMap[resourceID]; exists && oldResource.Drift != nil {
+			switch {
+			case drift == nil && oldResource.Drift.Healed && oldResource.AppliedHash == applied.hash:
+				// Keep reporting a healed drift until the resource is changed by the release
+				drift = oldResource.Drift
+			case drift != nil && isSameDrift(oldResource.Drift, drift):
+				// The drift is still in place, preserve the time it was first detected
+				drift.DetectedAt = oldResource.Drift.DetectedAt
+			}
+		}
+
 		status := openchoreov1alpha1.ResourceStatus{
 			ID:               resourceID,
 			Group:            gvk.Group,
@@ -139,6 +159,8 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 			Status:           resourceStatus,
 			HealthStatus:     healthStatus,
 			LastObservedTime: lastObservedTime,
+			AppliedHash:      applied.hash,
+			Drift:            drift,
 		}
 
 		resourceStatuses = append(resourceStatuses, status)
@@ -163,7 +185,11 @@ func (r *Reconciler) hasTransitioningResources(resources []openchoreov1alpha1.Re
 	return false
 }
 
-func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+// HealthCheckFunc determines the health of a live resource
+type HealthCheckFunc func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error)
+
+// GetHealthCheckFunc returns the built-in health check for a resource type
+func GetHealthCheckFunc(gvk schema.GroupVersionKind) HealthCheckFunc {
 	switch {
 	case gvk.Group == "apps" && gvk.Kind == "Deployment":
 		return getDeploymentHealth
@@ -173,7 +199,20 @@ func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unst
 		return getPodHealth
 	case gvk.Group == "batch" && gvk.Kind == "CronJob":
 		return getCronJobHealth
-		// TODO: Add gateway http route health check, and other resources as needed
+	case gvk.Group == "batch" && gvk.Kind == "Job":
+		return getJobHealth
+	case gvk.Group == "" && gvk.Kind == "Service":
+		return getServiceHealth
+	case gvk.Group == "" && gvk.Kind == "PersistentVolumeClaim":
+		return getPersistentVolumeClaimHealth
+	case gvk.Group == "autoscaling" && gvk.Kind == "HorizontalPodAutoscaler":
+		return getHorizontalPodAutoscalerHealth
+	case gvk.Group == "gateway.networking.k8s.io" && gvk.Kind == "HTTPRoute":
+		return getHTTPRouteHealth
+	case gvk.Group == "external-secrets.io" && gvk.Kind == "ExternalSecret":
+		return getExternalSecretHealth
+	case gvk.Group == "cert-manager.io" && gvk.Kind == "Certificate":
+		return getCertificateHealth
 	}
 	return getUnknownResourceHealth
 }
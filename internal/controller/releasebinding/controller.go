@@ -18,6 +18,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -26,6 +27,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/labels"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 // Reconciler reconciles a ReleaseBinding object
@@ -47,6 +49,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
@@ -63,6 +66,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// Remove the exported resources from the GitOps repository before the ReleaseBinding is deleted
+	if !releaseBinding.DeletionTimestamp.IsZero() {
+		logger.Info("Finalizing ReleaseBinding")
+		return r.finalize(ctx, releaseBinding)
+	}
+
 	// Keep a copy for comparison
 	old := releaseBinding.DeepCopy()
 
@@ -80,6 +89,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}
 	}()
 
+	// Record the bound release before anything else so that rollbacks are tracked
+	// even if the release cannot be rendered
+	recordReleaseHistory(releaseBinding)
+
 	// Fetch ComponentRelease
 	componentRelease := &openchoreov1alpha1.ComponentRelease{}
 	if err := r.Get(ctx, types.NamespacedName{
@@ -123,6 +136,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
+	// Exported resources are removed from the GitOps repository when the ReleaseBinding is deleted
+	if environment.Spec.GitOps != nil {
+		if finalizerAdded, err := r.ensureFinalizer(ctx, releaseBinding); err != nil || finalizerAdded {
+			// Return after adding the finalizer to ensure the finalizer is persisted
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Check if DataPlaneRef is configured in the Environment
 	if environment.Spec.DataPlaneRef == "" {
 		msg := fmt.Sprintf("Environment %q has no DataPlaneRef configured", environment.Name)
@@ -214,181 +235,187 @@ func (r *Reconciler) validateComponentRelease(componentRelease *openchoreov1alph
 	return nil
 }
 
-// buildMetadataContext creates the MetadataContext from ComponentRelease, component, project, dataplane, and environment.
-func (r *Reconciler) buildMetadataContext(
-	componentRelease *openchoreov1alpha1.ComponentRelease,
-	component *openchoreov1alpha1.Component,
-	project *openchoreov1alpha1.Project,
-	dataPlane *openchoreov1alpha1.DataPlane,
-	environment *openchoreov1alpha1.Environment,
-	environmentName string,
-) pipelinecontext.MetadataContext {
-	// Extract information
-	organizationName := componentRelease.Namespace
-	projectName := componentRelease.Spec.Owner.ProjectName
-	componentName := componentRelease.Spec.Owner.ComponentName
-	componentUID := string(component.UID)
-	projectUID := string(project.UID)
-	dataPlaneName := dataPlane.Name
-	dataPlaneUID := string(dataPlane.UID)
-	environmentUID := string(environment.UID)
-
-	// Generate base name using platform naming conventions
-	// Format: {component}-{env}-{hash}
-	baseName := dpkubernetes.GenerateK8sName(componentName, environmentName)
-
-	// Generate namespace using platform naming conventions
-	// Format: dp-{org}-{project}-{env}-{hash}
-	namespace := dpkubernetes.GenerateK8sNameWithLengthLimit(
-		dpkubernetes.MaxNamespaceNameLength,
-		"dp", organizationName, projectName, environmentName,
-	)
-
-	// Build standard labels
-	standardLabels := map[string]string{
-		labels.LabelKeyOrganizationName: organizationName,
-		labels.LabelKeyProjectName:      projectName,
-		labels.LabelKeyComponentName:    componentName,
-		labels.LabelKeyEnvironmentName:  environmentName,
-	}
-
-	// Build pod selectors
-	podSelectors := map[string]string{
-		labels.LabelKeyComponentUID:   componentUID,
-		labels.LabelKeyEnvironmentUID: environmentUID,
-		labels.LabelKeyProjectUID:     projectUID,
-	}
-
-	return pipelinecontext.MetadataContext{
-		Name:            baseName,
-		Namespace:       namespace,
-		Labels:          standardLabels,
-		Annotations:     map[string]string{},
-		PodSelectors:    podSelectors,
-		ComponentName:   componentName,
-		ComponentUID:    componentUID,
-		ProjectName:     projectName,
-		ProjectUID:      projectUID,
-		DataPlaneName:   dataPlaneName,
-		DataPlaneUID:    dataPlaneUID,
-		EnvironmentName: environmentName,
-		EnvironmentUID:  environmentUID,
-	}
-}
+// reconcileRelease creates or updates the Release resource and sets appropriate status conditions.
+// With a canary rollout in progress, the canary is rendered into a second Release and the stable Release
+// routes part of the traffic to it.
+func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment,
+	dataPlane *openchoreov1alpha1.DataPlane, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
 
-// collectSecretReferences collects all SecretReferences needed for rendering from workload and releaseBinding.
-func (r *Reconciler) collectSecretReferences(ctx context.Context, workload *openchoreov1alpha1.Workload, releaseBinding *openchoreov1alpha1.ReleaseBinding) (map[string]*openchoreov1alpha1.SecretReference, error) {
-	secretRefs := make(map[string]*openchoreov1alpha1.SecretReference)
+	// Work out which releases are rolled out before rendering
+	syncRolloutTarget(releaseBinding, metav1.Now())
+	rollout := releaseBinding.Status.Rollout
 
-	// Helper function to collect secret reference
-	collectSecretRef := func(refName string, namespace string) error {
-		if refName == "" {
-			return nil
-		}
-		if _, exists := secretRefs[refName]; !exists {
-			secretRef := &openchoreov1alpha1.SecretReference{}
-			if err := r.Get(ctx, client.ObjectKey{
-				Name:      refName,
-				Namespace: namespace,
-			}, secretRef); err != nil {
-				return fmt.Errorf("failed to get SecretReference %s: %w", refName, err)
+	// The stable release differs from the bound release while a canary is rolled out or after it was aborted
+	stableRelease := componentRelease
+	if rollout != nil && rollout.StableRelease != componentRelease.Name {
+		stableRelease = &openchoreov1alpha1.ComponentRelease{}
+		if err := r.Get(ctx, types.NamespacedName{
+			Name:      rollout.StableRelease,
+			Namespace: releaseBinding.Namespace,
+		}, stableRelease); err != nil {
+			if apierrors.IsNotFound(err) {
+				msg := fmt.Sprintf("Stable ComponentRelease %q not found", rollout.StableRelease)
+				controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+					ReasonComponentReleaseNotFound, msg)
+				logger.Info(msg, "componentRelease", rollout.StableRelease)
+				return ctrl.Result{}, nil
 			}
-			secretRefs[refName] = secretRef
+			logger.Error(err, "Failed to get stable ComponentRelease", "componentRelease", rollout.StableRelease)
+			return ctrl.Result{}, err
+		}
+		if err := r.validateComponentRelease(stableRelease, releaseBinding); err != nil {
+			msg := fmt.Sprintf("Invalid stable ComponentRelease configuration: %v", err)
+			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+				ReasonInvalidReleaseConfiguration, msg)
+			logger.Error(err, "Stable ComponentRelease validation failed")
+			return ctrl.Result{}, nil
 		}
-		return nil
 	}
 
-	if workload != nil {
-		for _, container := range workload.Spec.Containers {
-			for _, env := range container.Env {
-				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
+	// Resolve the connections to other components before rendering, so that their addresses can be injected.
+	// Pending connections are injected once their target is rendered, which reconciles this binding again.
+	renderedReleases := []*openchoreov1alpha1.ComponentRelease{stableRelease}
+	if isCanaryActive(rollout) {
+		renderedReleases = append(renderedReleases, componentRelease)
+	}
+	connectionEndpoints, resolved, err := r.reconcileConnections(ctx, releaseBinding, renderedReleases...)
+	if err != nil {
+		logger.Error(err, "Failed to resolve connections")
+		return ctrl.Result{}, err
+	}
+	if !resolved {
+		r.setReadyCondition(releaseBinding)
+		return ctrl.Result{}, nil
+	}
 
-			for _, file := range container.Files {
-				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
+	metadata := BuildMetadataContext(component, project, dataPlane, environment)
+	renderedResources, err := r.renderResources(ctx, releaseBinding, stableRelease, environment, dataPlane, component, project,
+		metadata, connectionEndpoints[stableRelease.Name])
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var canaryResources []map[string]any
+	if isCanaryActive(rollout) {
+		canary := canaryMetadata(metadata)
+		renderedCanaryResources, err := r.renderResources(ctx, releaseBinding, componentRelease, environment, dataPlane, component, project,
+			canary, connectionEndpoints[componentRelease.Name])
+		if err != nil {
+			return ctrl.Result{}, err
 		}
+		canaryResources = splitCanaryTraffic(renderedResources, renderedCanaryResources, metadata.Name, canary.Name, rollout.CurrentWeight)
 	}
 
-	// Collect from releaseBinding workload overrides if present
-	if releaseBinding.Spec.WorkloadOverrides != nil {
-		for _, container := range releaseBinding.Spec.WorkloadOverrides.Containers {
-			for _, env := range container.Env {
-				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
+	// Convert rendered resources to Release format
+	releaseResources, err := r.convertToReleaseResources(renderedResources)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to convert resources: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to convert resources to Release format")
+		return ctrl.Result{}, fmt.Errorf("failed to convert resources: %w", err)
+	}
 
-			for _, file := range container.Files {
-				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
+	// Create or update Release
+	// Release name format: {component}-{environment}
+	releaseName := fmt.Sprintf("%s-%s", componentRelease.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+	release, op, err := r.createOrUpdateRelease(ctx, releaseBinding, stableRelease, environment, releaseName, releaseResources)
+	if err != nil {
+		return r.handleReleaseError(ctx, releaseBinding, releaseName, err)
+	}
+
+	// Create or update the canary Release next to the stable Release, or remove it once the rollout is over
+	var canaryRelease *openchoreov1alpha1.Release
+	canaryOp := controllerutil.OperationResultNone
+	if isCanaryActive(rollout) {
+		canaryReleaseResources, err := r.convertToReleaseResources(canaryResources)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to convert canary resources: %v", err)
+			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+				ReasonRenderingFailed, msg)
+			logger.Error(err, "Failed to convert canary resources to Release format")
+			return ctrl.Result{}, fmt.Errorf("failed to convert canary resources: %w", err)
+		}
+		canaryRelease, canaryOp, err = r.createOrUpdateRelease(ctx, releaseBinding, componentRelease, environment,
+			canaryReleaseName(releaseBinding), canaryReleaseResources)
+		if err != nil {
+			return r.handleReleaseError(ctx, releaseBinding, canaryReleaseName(releaseBinding), err)
 		}
+	} else if err := r.deleteCanaryRelease(ctx, releaseBinding); err != nil {
+		logger.Error(err, "Failed to delete canary Release")
+		return ctrl.Result{}, err
 	}
 
-	return secretRefs, nil
+	// Mirror the Releases into the GitOps repository of the environment
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, stableRelease, release, canaryRelease); err != nil {
+		logger.Error(err, "Failed to export the Releases to the GitOps repository")
+		return ctrl.Result{}, err
+	}
+
+	// Set ReleaseSynced condition based on operation result
+	if op != controllerutil.OperationResultNone || canaryOp != controllerutil.OperationResultNone {
+		msg := fmt.Sprintf("Release %q %s with %d resources", release.Name, op, len(releaseResources))
+		if canaryOp != controllerutil.OperationResultNone {
+			msg = fmt.Sprintf("Canary Release %q %s with %d resources", canaryReleaseName(releaseBinding), canaryOp, len(canaryResources))
+		}
+		controller.MarkTrueCondition(releaseBinding, ConditionReleaseSynced, ReasonReleaseCreated, msg)
+		logger.Info(msg, "release", release.Name, "resourceCount", len(releaseResources))
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	msg := fmt.Sprintf("Release %q is up to date", release.Name)
+	controller.MarkTrueCondition(releaseBinding, ConditionReleaseSynced, ReasonReleaseSynced, msg)
+
+	// Evaluate resource readiness from Release status (with component for workload type)
+	if err := r.setResourcesReadyStatus(ctx, releaseBinding, release, component); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to set resources ready status: %w", err)
+	}
+
+	// Set overall Ready condition based on ReleaseSynced and ResourcesReady
+	r.setReadyCondition(releaseBinding)
+
+	// Move the canary through its steps once the Releases are in place
+	return r.progressRollout(ctx, releaseBinding, environment, dataPlane, component, project)
 }
 
-// reconcileRelease creates or updates the Release resource and sets appropriate status conditions.
-func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+// renderResources renders a ComponentRelease into the environment with the given metadata and resolved connections
+func (r *Reconciler) renderResources(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
 	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment,
-	dataPlane *openchoreov1alpha1.DataPlane, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (ctrl.Result, error) {
+	dataPlane *openchoreov1alpha1.DataPlane, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project,
+	metadata pipelinecontext.MetadataContext, connectionEndpoints map[string]pipelinecontext.ConnectionEndpoint) ([]map[string]any, error) {
 	logger := log.FromContext(ctx)
 
-	// Build MetadataContext with computed names
-	metadataContext := r.buildMetadataContext(componentRelease, component, project, dataPlane, environment, releaseBinding.Spec.Environment)
-
-	// Build Component from ComponentRelease for rendering
-	// The pipeline expects a Component object, so we need to reconstruct it from the ComponentRelease
-	snapshotComponent := buildComponentFromRelease(componentRelease)
-	snapshotComponentType := buildComponentTypeFromRelease(componentRelease)
-	snapshotTraits := buildTraitsFromRelease(componentRelease)
-	snapshotWorkload := buildWorkloadFromRelease(componentRelease)
+	// Build the render input from the ComponentRelease snapshot.
+	// The pipeline expects Component, ComponentType, Traits and Workload objects,
+	// so they are reconstructed from the ComponentRelease.
+	renderInput := NewRenderInput(componentRelease, releaseBinding, environment, dataPlane, component, project)
+	renderInput.Metadata = metadata
+	renderInput.ConnectionEndpoints = connectionEndpoints
 
 	// Collect all SecretReferences needed for rendering (must be done after workload merge)
-	secretReferences, err := r.collectSecretReferences(ctx, snapshotWorkload, releaseBinding)
+	secretReferences, err := CollectSecretReferences(ctx, r.Client, renderInput.Workload, releaseBinding)
 	if err != nil {
 		msg := fmt.Sprintf("Failed to collect SecretReferences: %v", err)
 		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
 			ReasonRenderingFailed, msg)
 		logger.Error(err, "Failed to collect SecretReferences")
-		return ctrl.Result{}, fmt.Errorf("failed to collect SecretReferences: %w", err)
-	}
-
-	// Prepare RenderInput
-	renderInput := &componentpipeline.RenderInput{
-		ComponentType:    snapshotComponentType,
-		Component:        snapshotComponent,
-		Traits:           snapshotTraits,
-		Workload:         snapshotWorkload,
-		Environment:      environment,
-		ReleaseBinding:   releaseBinding,
-		DataPlane:        dataPlane,
-		SecretReferences: secretReferences,
-		Metadata:         metadataContext,
+		return nil, fmt.Errorf("failed to collect SecretReferences: %w", err)
 	}
+	renderInput.SecretReferences = secretReferences
 
 	// Render resources using the shared pipeline instance
-	renderOutput, err := r.Pipeline.Render(renderInput)
+	renderOutput, err := r.Pipeline.RenderWithContext(ctx, renderInput)
 	if err != nil {
+		reason := ReasonRenderingFailed
+		if template.IsEvaluationBudgetError(err) {
+			reason = ReasonTemplateBudgetExceeded
+		}
 		msg := fmt.Sprintf("Failed to render resources: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, reason, msg)
 		logger.Error(err, "Failed to render resources")
-		return ctrl.Result{}, fmt.Errorf("failed to render resources: %w", err)
+		return nil, fmt.Errorf("failed to render resources: %w", err)
 	}
 
 	// Log warnings if any
@@ -397,22 +424,16 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 			"warnings", renderOutput.Metadata.Warnings)
 	}
 
-	// Convert rendered resources to Release format
-	releaseResources, err := r.convertToReleaseResources(renderOutput.Resources)
-	if err != nil {
-		msg := fmt.Sprintf("Failed to convert resources: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to convert resources to Release format")
-		return ctrl.Result{}, fmt.Errorf("failed to convert resources: %w", err)
-	}
+	return renderOutput.Resources, nil
+}
 
-	// Create or update Release
-	// Release name format: {component}-{environment}-{releaseHash}
-	releaseName := fmt.Sprintf("%s-%s", componentRelease.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+// createOrUpdateRelease creates or updates a Release owned by the ReleaseBinding with the given resources
+func (r *Reconciler) createOrUpdateRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment, name string,
+	releaseResources []openchoreov1alpha1.Resource) (*openchoreov1alpha1.Release, controllerutil.OperationResult, error) {
 	release := &openchoreov1alpha1.Release{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      releaseName,
+			Name:      name,
 			Namespace: releaseBinding.Namespace,
 		},
 	}
@@ -443,113 +464,54 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 			},
 			EnvironmentName: releaseBinding.Spec.Environment,
 			Resources:       releaseResources,
+			HealthChecks:    componentRelease.Spec.ComponentType.HealthChecks,
+			DriftPolicy:     releaseBinding.Spec.DriftPolicy,
+			ApplyMode:       releaseApplyMode(environment),
 		}
 
 		return controllerutil.SetControllerReference(releaseBinding, release, r.Scheme)
 	})
-
-	if err != nil {
-		// Check for ownership conflict
-		if strings.Contains(err.Error(), "not owned by") {
-			msg := fmt.Sprintf("Release %q exists but is owned by another resource", release.Name)
-			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-				ReasonReleaseOwnershipConflict, msg)
-			logger.Error(err, msg)
-			return ctrl.Result{}, nil
-		}
-
-		// Transient errors
-		msg := fmt.Sprintf("Failed to reconcile Release: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonReleaseUpdateFailed, msg)
-		logger.Error(err, "Failed to reconcile Release", "release", release.Name)
-		return ctrl.Result{}, err
-	}
-
-	// Set ReleaseSynced condition based on operation result
-	switch op {
-	case controllerutil.OperationResultCreated, controllerutil.OperationResultUpdated:
-		msg := fmt.Sprintf("Release %q %s with %d resources", release.Name, op, len(releaseResources))
-		controller.MarkTrueCondition(releaseBinding, ConditionReleaseSynced, ReasonReleaseCreated, msg)
-		logger.Info(fmt.Sprintf("Release %s", op), "release", release.Name, "resourceCount", len(releaseResources))
-		return ctrl.Result{Requeue: true}, nil
-
-	case controllerutil.OperationResultNone:
-		msg := fmt.Sprintf("Release %q is up to date", release.Name)
-		controller.MarkTrueCondition(releaseBinding, ConditionReleaseSynced, ReasonReleaseSynced, msg)
-	}
-
-	// Evaluate resource readiness from Release status (with component for workload type)
-	if err := r.setResourcesReadyStatus(ctx, releaseBinding, release, component); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to set resources ready status: %w", err)
-	}
-
-	// Set overall Ready condition based on ReleaseSynced and ResourcesReady
-	r.setReadyCondition(releaseBinding)
-
-	return ctrl.Result{}, nil
+	return release, op, err
 }
 
-// Helper functions to build snapshot structures from ComponentRelease
+// handleReleaseError sets the ReleaseSynced condition for a Release that could not be created or updated
+func (r *Reconciler) handleReleaseError(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	releaseName string, err error) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
 
-func buildComponentFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Component {
-	return &openchoreov1alpha1.Component{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      componentRelease.Spec.Owner.ComponentName,
-			Namespace: componentRelease.Namespace,
-		},
-		Spec: openchoreov1alpha1.ComponentSpec{
-			Owner: openchoreov1alpha1.ComponentOwner{
-				ProjectName: componentRelease.Spec.Owner.ProjectName,
-			},
-			Parameters: componentRelease.Spec.ComponentProfile.Parameters,
-			Traits:     componentRelease.Spec.ComponentProfile.Traits,
-		},
+	// Check for ownership conflict
+	if strings.Contains(err.Error(), "not owned by") {
+		msg := fmt.Sprintf("Release %q exists but is owned by another resource", releaseName)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonReleaseOwnershipConflict, msg)
+		logger.Error(err, msg)
+		return ctrl.Result{}, nil
 	}
-}
 
-func buildComponentTypeFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.ComponentType {
-	return &openchoreov1alpha1.ComponentType{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "from-release", // Name doesn't matter for rendering
-			Namespace: componentRelease.Namespace,
-		},
-		Spec: componentRelease.Spec.ComponentType,
-	}
+	// Transient errors
+	msg := fmt.Sprintf("Failed to reconcile Release: %v", err)
+	controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+		ReasonReleaseUpdateFailed, msg)
+	logger.Error(err, "Failed to reconcile Release", "release", releaseName)
+	return ctrl.Result{}, err
 }
 
-func buildTraitsFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) []openchoreov1alpha1.Trait {
-	if len(componentRelease.Spec.Traits) == 0 {
-		return nil
-	}
-
-	traits := make([]openchoreov1alpha1.Trait, 0, len(componentRelease.Spec.Traits))
-	for name, spec := range componentRelease.Spec.Traits {
-		traits = append(traits, openchoreov1alpha1.Trait{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: componentRelease.Namespace,
-			},
-			Spec: spec,
-		})
+// deleteCanaryRelease removes the canary Release of the ReleaseBinding if it exists
+func (r *Reconciler) deleteCanaryRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) error {
+	canaryRelease := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      canaryReleaseName(releaseBinding),
+		Namespace: releaseBinding.Namespace,
+	}, canaryRelease); err != nil {
+		return client.IgnoreNotFound(err)
 	}
-	return traits
-}
 
-func buildWorkloadFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Workload {
-	return &openchoreov1alpha1.Workload{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "from-release", // Name doesn't matter for rendering
-			Namespace: componentRelease.Namespace,
-		},
-		Spec: openchoreov1alpha1.WorkloadSpec{
-			Owner: openchoreov1alpha1.WorkloadOwner{
-				ProjectName:   componentRelease.Spec.Owner.ProjectName,
-				ComponentName: componentRelease.Spec.Owner.ComponentName,
-			},
-			WorkloadTemplateSpec: componentRelease.Spec.Workload,
-		},
+	// Only remove a Release created for this ReleaseBinding
+	hasOwner, err := controllerutil.HasOwnerReference(canaryRelease.GetOwnerReferences(), releaseBinding, r.Scheme)
+	if err != nil || !hasOwner {
+		return err
 	}
+	return client.IgnoreNotFound(r.Delete(ctx, canaryRelease))
 }
 
 // convertToReleaseResources converts unstructured resources to Release.Resource format
@@ -600,9 +562,19 @@ func (r *Reconciler) generateResourceID(resource map[string]any, index int) stri
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := SetupConnectionIndexes(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ReleaseBinding{}).
 		Owns(&openchoreov1alpha1.Release{}).
+		Watches(&openchoreov1alpha1.GitCommitRequest{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingForGitCommitRequest)).
+		Watches(&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findDependentsForReleaseBinding)).
+		Watches(&openchoreov1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.findDependentsForRelease)).
 		Named("releasebinding").
 		Complete(r)
 }
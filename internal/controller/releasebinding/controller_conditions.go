@@ -21,6 +21,20 @@ const (
 	// ConditionReady indicates the overall readiness of the ReleaseBinding
 	// This is the top-level condition that aggregates ReleaseSynced and ResourcesReady
 	ConditionReady controller.ConditionType = "Ready"
+
+	// ConditionRolledBack indicates that the ReleaseBinding was moved back to a release
+	// that had previously been bound to the environment. The condition is removed when a
+	// release that has not been bound before is deployed.
+	ConditionRolledBack controller.ConditionType = "RolledBack"
+
+	// ConditionConnectionsResolved indicates that the API connections of the workload are resolved
+	// to the endpoints of their target components in the environment. The condition is only set
+	// when the workload has API connections.
+	ConditionConnectionsResolved controller.ConditionType = "ConnectionsResolved"
+
+	// ConditionGitOpsCleanedUp indicates whether the exported resources were removed from the GitOps
+	// repository while the ReleaseBinding is deleted. The condition is only set when the removal fails.
+	ConditionGitOpsCleanedUp controller.ConditionType = "GitOpsCleanedUp"
 )
 
 // Constants for condition reasons
@@ -34,6 +48,10 @@ const (
 	ReasonReleaseSynced controller.ConditionReason = "ReleaseSynced"
 	// ReasonResourcesReady indicates all resources are ready
 	ReasonResourcesReady controller.ConditionReason = "ResourcesReady"
+	// ReasonRolledBack indicates the binding now references a previously bound release
+	ReasonRolledBack controller.ConditionReason = "RolledBack"
+	// ReasonConnectionsResolved indicates all API connections are resolved
+	ReasonConnectionsResolved controller.ConditionReason = "ConnectionsResolved"
 
 	// Configuration issues (Status=False)
 
@@ -52,10 +70,25 @@ const (
 	// ReasonInvalidReleaseConfiguration indicates the ComponentRelease configuration is invalid
 	ReasonInvalidReleaseConfiguration controller.ConditionReason = "InvalidReleaseConfiguration"
 
+	// Connection issues (Status=False)
+
+	// ReasonInvalidConnection indicates an API connection does not identify its target endpoint
+	ReasonInvalidConnection controller.ConditionReason = "InvalidConnection"
+	// ReasonConnectionTargetNotFound indicates the target component is not deployed to the environment
+	// or does not have the endpoint
+	ReasonConnectionTargetNotFound controller.ConditionReason = "ConnectionTargetNotFound"
+	// ReasonConnectionTargetNotReady indicates the resources of the target component are not rendered yet
+	// or do not expose the endpoint
+	ReasonConnectionTargetNotReady controller.ConditionReason = "ConnectionTargetNotReady"
+	// ReasonConnectionNotAllowed indicates the visibility of the target endpoint does not allow the connection
+	ReasonConnectionNotAllowed controller.ConditionReason = "ConnectionNotAllowed"
+
 	// Rendering issues (Status=False)
 
 	// ReasonRenderingFailed indicates failure to render resources
 	ReasonRenderingFailed controller.ConditionReason = "RenderingFailed"
+	// ReasonTemplateBudgetExceeded indicates the templates exceeded their evaluation cost limits or timeout
+	ReasonTemplateBudgetExceeded controller.ConditionReason = "TemplateBudgetExceeded"
 
 	// Release management issues (Status=False)
 
@@ -97,4 +130,9 @@ const (
 	ReasonCronJobScheduled controller.ConditionReason = "CronJobScheduled"
 	// ReasonCronJobSuspended indicates CronJob is suspended
 	ReasonCronJobSuspended controller.ConditionReason = "CronJobSuspended"
+
+	// GitOps cleanup issues (Status=False)
+
+	// ReasonGitOpsCleanupFailed indicates the GitCommitRequest removing the exported resources failed
+	ReasonGitOpsCleanupFailed controller.ConditionReason = "GitOpsCleanupFailed"
 )
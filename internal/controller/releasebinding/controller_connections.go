@@ -0,0 +1,443 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+// Parameters of API connections
+const (
+	connectionParamProject   = "projectName"
+	connectionParamComponent = "componentName"
+	connectionParamEndpoint  = "endpoint"
+)
+
+// connectionError reports an API connection that cannot be resolved, with the condition reason describing why
+type connectionError struct {
+	reason  controller.ConditionReason
+	message string
+}
+
+func (e *connectionError) Error() string {
+	return e.message
+}
+
+// reconcileConnections resolves the API connections of the rendered releases to the endpoints of the
+// target components in the environment, and records them as dependencies of the ReleaseBinding.
+// It returns the resolved endpoints of each release, keyed by release name and connection name.
+// When a connection cannot be resolved, the ConnectionsResolved condition is marked false and the
+// ReleaseBinding is reconciled again when the target changes. Connections whose target is deployed but
+// not rendered yet are pending: the releases are rendered without them, so that components connecting
+// to each other do not wait on one another. Any other unresolved connection also marks the ReleaseSynced
+// condition false and ok is false.
+func (r *Reconciler) reconcileConnections(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	releases ...*openchoreov1alpha1.ComponentRelease) (endpoints map[string]map[string]pipelinecontext.ConnectionEndpoint, ok bool, err error) {
+	logger := log.FromContext(ctx)
+
+	endpoints = make(map[string]map[string]pipelinecontext.ConnectionEndpoint, len(releases))
+	var statuses []openchoreov1alpha1.ConnectionStatus
+	var connErrs []*connectionError
+	for _, release := range releases {
+		releaseEndpoints, releaseStatuses, err := r.resolveConnections(ctx, releaseBinding, release)
+		var connErr *connectionError
+		switch {
+		case errors.As(err, &connErr):
+			connErrs = append(connErrs, connErr)
+		case err != nil:
+			return nil, false, err
+		}
+		endpoints[release.Name] = releaseEndpoints
+		statuses = mergeConnectionStatuses(statuses, releaseStatuses)
+	}
+
+	releaseBinding.Status.Connections = statuses
+	if len(statuses) == 0 {
+		meta.RemoveStatusCondition(&releaseBinding.Status.Conditions, string(ConditionConnectionsResolved))
+		return endpoints, true, nil
+	}
+
+	if len(connErrs) > 0 {
+		reason := connErrs[0].reason
+		messages := make([]string, 0, len(connErrs))
+		for _, connErr := range connErrs {
+			// The stable and canary releases usually share their connections
+			if !slices.Contains(messages, connErr.message) {
+				messages = append(messages, connErr.message)
+			}
+			if reason == ReasonConnectionTargetNotReady {
+				reason = connErr.reason
+			}
+		}
+		msg := strings.Join(messages, "; ")
+		controller.MarkFalseCondition(releaseBinding, ConditionConnectionsResolved, reason, msg)
+		if reason == ReasonConnectionTargetNotReady {
+			logger.Info("Rendering without the pending connections", "message", msg)
+			return endpoints, true, nil
+		}
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, reason, msg)
+		logger.Info("Connections could not be resolved", "reason", reason, "message", msg)
+		return nil, false, nil
+	}
+
+	controller.MarkTrueCondition(releaseBinding, ConditionConnectionsResolved, ReasonConnectionsResolved,
+		fmt.Sprintf("%d connections resolved", len(statuses)))
+	return endpoints, true, nil
+}
+
+// resolveConnections resolves the API connections of the workload of a ComponentRelease.
+// Every connection is returned in the statuses so that unresolved targets are recorded as
+// dependencies too, and the endpoints of the resolved connections are returned either way.
+// The returned error is a *connectionError describing all connections that cannot be resolved,
+// with the reason of the first one that is not pending, or any other error when the targets
+// cannot be read.
+func (r *Reconciler) resolveConnections(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease) (map[string]pipelinecontext.ConnectionEndpoint, []openchoreov1alpha1.ConnectionStatus, error) {
+	connections := componentRelease.Spec.Workload.Connections
+	names := make([]string, 0, len(connections))
+	for name, connection := range connections {
+		if connection.Type == openchoreov1alpha1.ConnectionTypeAPI {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	endpoints := make(map[string]pipelinecontext.ConnectionEndpoint, len(names))
+	statuses := make([]openchoreov1alpha1.ConnectionStatus, 0, len(names))
+	var connErrs []*connectionError
+	for _, name := range names {
+		params := connections[name].Params
+		status := openchoreov1alpha1.ConnectionStatus{
+			Name:          name,
+			ProjectName:   params[connectionParamProject],
+			ComponentName: params[connectionParamComponent],
+			Endpoint:      params[connectionParamEndpoint],
+		}
+		if status.ProjectName == "" {
+			status.ProjectName = releaseBinding.Spec.Owner.ProjectName
+		}
+
+		endpoint, err := r.resolveConnection(ctx, releaseBinding, status)
+		var connErr *connectionError
+		switch {
+		case errors.As(err, &connErr):
+			connErrs = append(connErrs, &connectionError{
+				reason:  connErr.reason,
+				message: fmt.Sprintf("connection %q: %s", name, connErr.message),
+			})
+		case err != nil:
+			return nil, nil, fmt.Errorf("failed to resolve connection %q: %w", name, err)
+		default:
+			endpoints[name] = endpoint
+			status.Visibility = endpoint.Visibility
+			status.URL = endpoint.URL()
+		}
+		statuses = append(statuses, status)
+	}
+
+	if len(connErrs) > 0 {
+		reason := connErrs[0].reason
+		messages := make([]string, 0, len(connErrs))
+		for _, connErr := range connErrs {
+			messages = append(messages, connErr.message)
+			if reason == ReasonConnectionTargetNotReady {
+				reason = connErr.reason
+			}
+		}
+		return endpoints, statuses, &connectionError{reason: reason, message: strings.Join(messages, "; ")}
+	}
+	return endpoints, statuses, nil
+}
+
+// resolveConnection resolves a connection to the address of the target endpoint, as rendered in the
+// Release of the target component in the environment of the ReleaseBinding.
+func (r *Reconciler) resolveConnection(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	target openchoreov1alpha1.ConnectionStatus) (pipelinecontext.ConnectionEndpoint, error) {
+	if target.ComponentName == "" || target.Endpoint == "" {
+		return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+			reason:  ReasonInvalidConnection,
+			message: fmt.Sprintf("the %s and %s parameters are required", connectionParamComponent, connectionParamEndpoint),
+		}
+	}
+	environment := releaseBinding.Spec.Environment
+
+	// Find the binding of the target component in the same environment
+	targetBinding, err := r.findTargetBinding(ctx, releaseBinding.Namespace, target.ProjectName, target.ComponentName, environment)
+	if err != nil {
+		return pipelinecontext.ConnectionEndpoint{}, err
+	}
+	if targetBinding == nil {
+		return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+			reason: ReasonConnectionTargetNotFound,
+			message: fmt.Sprintf("component %q of project %q is not deployed to environment %q",
+				target.ComponentName, target.ProjectName, environment),
+		}
+	}
+
+	// The endpoints are declared by the workload of the release serving the stable traffic
+	targetReleaseName := targetBinding.Spec.ReleaseName
+	if targetBinding.Status.Rollout != nil && targetBinding.Status.Rollout.StableRelease != "" {
+		targetReleaseName = targetBinding.Status.Rollout.StableRelease
+	}
+	targetRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{Name: targetReleaseName, Namespace: releaseBinding.Namespace}, targetRelease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+				reason:  ReasonConnectionTargetNotReady,
+				message: fmt.Sprintf("ComponentRelease %q of component %q not found", targetReleaseName, target.ComponentName),
+			}
+		}
+		return pipelinecontext.ConnectionEndpoint{}, err
+	}
+	endpoint, exists := targetRelease.Spec.Workload.Endpoints[target.Endpoint]
+	if !exists {
+		return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+			reason:  ReasonConnectionTargetNotFound,
+			message: fmt.Sprintf("component %q of project %q has no endpoint %q", target.ComponentName, target.ProjectName, target.Endpoint),
+		}
+	}
+
+	visibility, allowed := connectionVisibility(releaseBinding.Spec.Owner.ProjectName, target.ProjectName, endpoint)
+	if !allowed {
+		return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+			reason: ReasonConnectionNotAllowed,
+			message: fmt.Sprintf("endpoint %q of component %q is only visible within project %q; "+
+				"it must be exposed to the organization or the public to be used from project %q",
+				target.Endpoint, target.ComponentName, target.ProjectName, releaseBinding.Spec.Owner.ProjectName),
+		}
+	}
+
+	// Read the address of the endpoint from the resources rendered for the target
+	release := &openchoreov1alpha1.Release{}
+	releaseName := fmt.Sprintf("%s-%s", target.ComponentName, environment)
+	if err := r.Get(ctx, types.NamespacedName{Name: releaseName, Namespace: releaseBinding.Namespace}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+				reason:  ReasonConnectionTargetNotReady,
+				message: fmt.Sprintf("Release %q of component %q is not created yet", releaseName, target.ComponentName),
+			}
+		}
+		return pipelinecontext.ConnectionEndpoint{}, err
+	}
+
+	address, err := endpointAddress(release.Spec.Resources, endpoint, visibility)
+	if err != nil {
+		return pipelinecontext.ConnectionEndpoint{}, &connectionError{
+			reason:  ReasonConnectionTargetNotReady,
+			message: fmt.Sprintf("endpoint %q of component %q: %v", target.Endpoint, target.ComponentName, err),
+		}
+	}
+	return address, nil
+}
+
+// findTargetBinding returns the ReleaseBinding of a component in an environment, or nil if there is none
+func (r *Reconciler) findTargetBinding(ctx context.Context, namespace, projectName, componentName,
+	environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, bindings, client.InNamespace(namespace),
+		client.MatchingFields{environmentComponentIndex: makeEnvironmentComponentKey(environment, projectName, componentName)}); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		if binding := &bindings.Items[i]; binding.DeletionTimestamp.IsZero() {
+			return binding, nil
+		}
+	}
+	return nil, nil
+}
+
+// connectionVisibility returns the visibility level through which a component of the caller project
+// reaches an endpoint of the target project, and whether the endpoint is visible to the caller at all.
+// Endpoints are always visible within their project. Other projects reach them through the cluster
+// network when they are visible to the organization, or else through the public gateway.
+func connectionVisibility(callerProject, targetProject string,
+	endpoint openchoreov1alpha1.WorkloadEndpoint) (openchoreov1alpha1.ConnectionVisibility, bool) {
+	if callerProject == targetProject {
+		return openchoreov1alpha1.ConnectionVisibilityProject, true
+	}
+	visibilities := endpoint.NetworkVisibilities
+	switch {
+	case visibilities != nil && ptr.Deref(visibilities.Organization, false):
+		return openchoreov1alpha1.ConnectionVisibilityOrganization, true
+	case visibilities != nil && ptr.Deref(visibilities.Public, false):
+		return openchoreov1alpha1.ConnectionVisibilityPublic, true
+	default:
+		return "", false
+	}
+}
+
+// endpointAddress finds the address of an endpoint in the resources rendered for its component.
+// The endpoint is served by the Service that targets the port of the endpoint. Public connections
+// go through the HTTPRoute that routes to that Service.
+func endpointAddress(resources []openchoreov1alpha1.Resource, endpoint openchoreov1alpha1.WorkloadEndpoint,
+	visibility openchoreov1alpha1.ConnectionVisibility) (pipelinecontext.ConnectionEndpoint, error) {
+	objects := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		if resource.Object == nil {
+			continue
+		}
+		var object map[string]any
+		if err := json.Unmarshal(resource.Object.Raw, &object); err != nil {
+			return pipelinecontext.ConnectionEndpoint{}, fmt.Errorf("failed to decode resource %s: %w", resource.ID, err)
+		}
+		objects = append(objects, object)
+	}
+
+	serviceName, namespace, servicePort, found := findEndpointService(objects, endpoint.Port)
+	if !found {
+		return pipelinecontext.ConnectionEndpoint{}, fmt.Errorf("no Service exposes port %d", endpoint.Port)
+	}
+
+	if visibility != openchoreov1alpha1.ConnectionVisibilityPublic {
+		return pipelinecontext.ConnectionEndpoint{
+			Scheme:     endpointScheme(endpoint),
+			Host:       fmt.Sprintf("%s.%s.svc.cluster.local", serviceName, namespace),
+			Port:       servicePort,
+			Visibility: visibility,
+		}, nil
+	}
+
+	host, basePath, found := findServiceRoute(objects, serviceName)
+	if !found {
+		return pipelinecontext.ConnectionEndpoint{}, fmt.Errorf("no HTTPRoute exposes Service %q through a gateway", serviceName)
+	}
+	scheme := "https"
+	if endpoint.Type == openchoreov1alpha1.EndpointTypeWebsocket {
+		scheme = "wss"
+	}
+	return pipelinecontext.ConnectionEndpoint{
+		Scheme:     scheme,
+		Host:       host,
+		Port:       443,
+		BasePath:   basePath,
+		Visibility: visibility,
+	}, nil
+}
+
+// findEndpointService finds the Service with a port that targets the given container port
+func findEndpointService(objects []map[string]any, containerPort int32) (name, namespace string, port int32, found bool) {
+	for _, object := range objects {
+		if kind, _ := object["kind"].(string); kind != "Service" {
+			continue
+		}
+		metadata, _ := object["metadata"].(map[string]any)
+		spec, _ := object["spec"].(map[string]any)
+		ports, _ := spec["ports"].([]any)
+		for _, rawPort := range ports {
+			servicePort, _ := rawPort.(map[string]any)
+			number, ok := servicePort["port"].(float64)
+			if !ok {
+				continue
+			}
+			// The target port defaults to the port of the Service
+			target := intstr.FromInt32(int32(number))
+			switch targetPort := servicePort["targetPort"].(type) {
+			case float64:
+				target = intstr.FromInt32(int32(targetPort))
+			case string:
+				target = intstr.Parse(targetPort)
+			}
+			if target.Type == intstr.Int && target.IntVal == containerPort {
+				name, _ = metadata["name"].(string)
+				namespace, _ = metadata["namespace"].(string)
+				return name, namespace, int32(number), true
+			}
+		}
+	}
+	return "", "", 0, false
+}
+
+// findServiceRoute finds the host name and path prefix of the HTTPRoute that routes to a Service
+func findServiceRoute(objects []map[string]any, serviceName string) (host, basePath string, found bool) {
+	for _, object := range objects {
+		if kind, _ := object["kind"].(string); kind != "HTTPRoute" {
+			continue
+		}
+		spec, _ := object["spec"].(map[string]any)
+		hostnames, _ := spec["hostnames"].([]any)
+		if len(hostnames) == 0 {
+			continue
+		}
+		rules, _ := spec["rules"].([]any)
+		for _, rawRule := range rules {
+			rule, _ := rawRule.(map[string]any)
+			if !routesToService(rule, serviceName) {
+				continue
+			}
+			host, _ = hostnames[0].(string)
+			matches, _ := rule["matches"].([]any)
+			if len(matches) > 0 {
+				match, _ := matches[0].(map[string]any)
+				path, _ := match["path"].(map[string]any)
+				basePath, _ = path["value"].(string)
+			}
+			return host, strings.TrimSuffix(basePath, "/"), true
+		}
+	}
+	return "", "", false
+}
+
+func routesToService(rule map[string]any, serviceName string) bool {
+	backendRefs, _ := rule["backendRefs"].([]any)
+	for _, rawRef := range backendRefs {
+		ref, _ := rawRef.(map[string]any)
+		if name, _ := ref["name"].(string); name == serviceName {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointScheme determines the scheme of an endpoint reached through the cluster network
+func endpointScheme(endpoint openchoreov1alpha1.WorkloadEndpoint) string {
+	switch endpoint.Type {
+	case openchoreov1alpha1.EndpointTypeGRPC:
+		return "grpc"
+	case openchoreov1alpha1.EndpointTypeWebsocket:
+		return "ws"
+	case openchoreov1alpha1.EndpointTypeTCP, openchoreov1alpha1.EndpointTypeUDP:
+		return ""
+	default:
+		return "http"
+	}
+}
+
+// mergeConnectionStatuses adds the connections that are not recorded yet, keeping them sorted by name
+func mergeConnectionStatuses(statuses, others []openchoreov1alpha1.ConnectionStatus) []openchoreov1alpha1.ConnectionStatus {
+	for _, other := range others {
+		recorded := false
+		for _, status := range statuses {
+			if status.Name == other.Name && status.ProjectName == other.ProjectName &&
+				status.ComponentName == other.ComponentName && status.Endpoint == other.Endpoint {
+				recorded = true
+				break
+			}
+		}
+		if !recorded {
+			statuses = append(statuses, other)
+		}
+	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
@@ -0,0 +1,487 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func rawResource(t *testing.T, id string, object map[string]any) openchoreov1alpha1.Resource {
+	t.Helper()
+	raw, err := json.Marshal(object)
+	if err != nil {
+		t.Fatalf("failed to marshal resource: %v", err)
+	}
+	return openchoreov1alpha1.Resource{ID: id, Object: &runtime.RawExtension{Raw: raw}}
+}
+
+// inventoryResources are the resources rendered for the inventory component by the default service ComponentType
+func inventoryResources(t *testing.T) []openchoreov1alpha1.Resource {
+	return []openchoreov1alpha1.Resource{
+		rawResource(t, "service-inventory", map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "inventory", "namespace": "dp-acme-warehouse-dev-1a2b3c4d"},
+			"spec": map[string]any{
+				"ports": []any{map[string]any{"name": "http", "port": 80, "targetPort": 8080}},
+			},
+		}),
+		rawResource(t, "httproute-inventory", map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata":   map[string]any{"name": "inventory-dev", "namespace": "dp-acme-warehouse-dev-1a2b3c4d"},
+			"spec": map[string]any{
+				"hostnames": []any{"dev.choreoapis.example.com"},
+				"rules": []any{map[string]any{
+					"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/inventory"}}},
+					"backendRefs": []any{map[string]any{"name": "inventory", "port": 80}},
+				}},
+			},
+		}),
+	}
+}
+
+// newIndexedClientBuilder returns a fake client builder with the field indexes that connections are resolved with
+func newIndexedClientBuilder(scheme *runtime.Scheme) *fake.ClientBuilder {
+	return fake.NewClientBuilder().WithScheme(scheme).
+		WithIndex(&openchoreov1alpha1.ReleaseBinding{}, connectionTargetIndex, indexConnectionTargets).
+		WithIndex(&openchoreov1alpha1.ReleaseBinding{}, environmentComponentIndex, indexEnvironmentComponent)
+}
+
+func TestConnectionVisibility(t *testing.T) {
+	enabled := ptr.To(true)
+	disabled := ptr.To(false)
+
+	tests := []struct {
+		name          string
+		callerProject string
+		visibilities  *openchoreov1alpha1.WorkloadEndpointVisibility
+		want          openchoreov1alpha1.ConnectionVisibility
+		wantAllowed   bool
+	}{
+		{
+			name:          "same project",
+			callerProject: "warehouse",
+			want:          openchoreov1alpha1.ConnectionVisibilityProject,
+			wantAllowed:   true,
+		},
+		{
+			name:          "other project without visibilities",
+			callerProject: "storefront",
+		},
+		{
+			name:          "other project with disabled visibilities",
+			callerProject: "storefront",
+			visibilities:  &openchoreov1alpha1.WorkloadEndpointVisibility{Organization: disabled, Public: disabled},
+		},
+		{
+			name:          "organization visibility",
+			callerProject: "storefront",
+			visibilities:  &openchoreov1alpha1.WorkloadEndpointVisibility{Organization: enabled, Public: enabled},
+			want:          openchoreov1alpha1.ConnectionVisibilityOrganization,
+			wantAllowed:   true,
+		},
+		{
+			name:          "public visibility",
+			callerProject: "storefront",
+			visibilities:  &openchoreov1alpha1.WorkloadEndpointVisibility{Public: enabled},
+			want:          openchoreov1alpha1.ConnectionVisibilityPublic,
+			wantAllowed:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080, NetworkVisibilities: tt.visibilities}
+			got, allowed := connectionVisibility(tt.callerProject, "warehouse", endpoint)
+			if got != tt.want || allowed != tt.wantAllowed {
+				t.Errorf("connectionVisibility() = (%q, %t), want (%q, %t)", got, allowed, tt.want, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestEndpointAddress(t *testing.T) {
+	resources := inventoryResources(t)
+
+	tests := []struct {
+		name       string
+		endpoint   openchoreov1alpha1.WorkloadEndpoint
+		visibility openchoreov1alpha1.ConnectionVisibility
+		wantURL    string
+		wantErr    string
+	}{
+		{
+			name:       "in-cluster address",
+			endpoint:   openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080},
+			visibility: openchoreov1alpha1.ConnectionVisibilityProject,
+			wantURL:    "http://inventory.dp-acme-warehouse-dev-1a2b3c4d.svc.cluster.local",
+		},
+		{
+			name:       "in-cluster gRPC address",
+			endpoint:   openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeGRPC, Port: 8080},
+			visibility: openchoreov1alpha1.ConnectionVisibilityOrganization,
+			wantURL:    "grpc://inventory.dp-acme-warehouse-dev-1a2b3c4d.svc.cluster.local:80",
+		},
+		{
+			name:       "gateway address",
+			endpoint:   openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080},
+			visibility: openchoreov1alpha1.ConnectionVisibilityPublic,
+			wantURL:    "https://dev.choreoapis.example.com/inventory",
+		},
+		{
+			name:       "port not exposed by a service",
+			endpoint:   openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeREST, Port: 9090},
+			visibility: openchoreov1alpha1.ConnectionVisibilityProject,
+			wantErr:    "no Service exposes port 9090",
+		},
+		{
+			name:       "service not exposed through a gateway",
+			endpoint:   openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080},
+			visibility: openchoreov1alpha1.ConnectionVisibilityPublic,
+			wantErr:    `no HTTPRoute exposes Service "inventory" through a gateway`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered := resources
+			if tt.wantErr != "" {
+				rendered = resources[:1]
+			}
+			got, err := endpointAddress(rendered, tt.endpoint, tt.visibility)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("endpointAddress() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("endpointAddress() error = %v", err)
+			}
+			if got.URL() != tt.wantURL || got.Visibility != tt.visibility {
+				t.Errorf("endpointAddress() = %s (%s), want %s (%s)", got.URL(), got.Visibility, tt.wantURL, tt.visibility)
+			}
+		})
+	}
+}
+
+func TestReconcileConnections(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	inventoryRelease := &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-v1", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+				Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+					"internal": {Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080},
+					"api": {
+						Type: openchoreov1alpha1.EndpointTypeREST,
+						Port: 8080,
+						NetworkVisibilities: &openchoreov1alpha1.WorkloadEndpointVisibility{
+							Public: ptr.To(true),
+						},
+					},
+				},
+			},
+		},
+	}
+	inventoryBinding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "warehouse", ComponentName: "inventory"},
+			Environment: "dev",
+			ReleaseName: "inventory-v1",
+		},
+	}
+	inventoryRenderedRelease := &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-dev", Namespace: "acme"},
+		Spec:       openchoreov1alpha1.ReleaseSpec{Resources: inventoryResources(t)},
+	}
+
+	newRelease := func(params ...map[string]string) *openchoreov1alpha1.ComponentRelease {
+		connections := make(map[string]openchoreov1alpha1.WorkloadConnection, len(params))
+		names := []string{"inventory", "stock"}
+		for i, p := range params {
+			connections[names[i]] = openchoreov1alpha1.WorkloadConnection{Type: openchoreov1alpha1.ConnectionTypeAPI, Params: p}
+		}
+		return &openchoreov1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: "storefront-v1", Namespace: "acme"},
+			Spec: openchoreov1alpha1.ComponentReleaseSpec{
+				Workload: openchoreov1alpha1.WorkloadTemplateSpec{Connections: connections},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		callerProject string
+		release       *openchoreov1alpha1.ComponentRelease
+		wantResolved  bool
+		wantReason    string
+		wantURLs      map[string]string
+	}{
+		{
+			name:          "connection within the project",
+			callerProject: "warehouse",
+			release:       newRelease(map[string]string{"componentName": "inventory", "endpoint": "internal"}),
+			wantResolved:  true,
+			wantReason:    string(ReasonConnectionsResolved),
+			wantURLs:      map[string]string{"inventory": "http://inventory.dp-acme-warehouse-dev-1a2b3c4d.svc.cluster.local"},
+		},
+		{
+			name:          "public connection across projects",
+			callerProject: "storefront",
+			release:       newRelease(map[string]string{"projectName": "warehouse", "componentName": "inventory", "endpoint": "api"}),
+			wantResolved:  true,
+			wantReason:    string(ReasonConnectionsResolved),
+			wantURLs:      map[string]string{"inventory": "https://dev.choreoapis.example.com/inventory"},
+		},
+		{
+			name:          "connection breaking the visibility of the endpoint",
+			callerProject: "storefront",
+			release:       newRelease(map[string]string{"projectName": "warehouse", "componentName": "inventory", "endpoint": "internal"}),
+			wantReason:    string(ReasonConnectionNotAllowed),
+			wantURLs:      map[string]string{"inventory": ""},
+		},
+		{
+			name:          "target not deployed to the environment",
+			callerProject: "storefront",
+			release: newRelease(
+				map[string]string{"projectName": "warehouse", "componentName": "inventory", "endpoint": "api"},
+				map[string]string{"projectName": "warehouse", "componentName": "stock", "endpoint": "api"},
+			),
+			wantReason: string(ReasonConnectionTargetNotFound),
+			wantURLs: map[string]string{
+				"inventory": "https://dev.choreoapis.example.com/inventory",
+				"stock":     "",
+			},
+		},
+		{
+			name:          "connection without a target",
+			callerProject: "storefront",
+			release:       newRelease(map[string]string{"componentName": "inventory"}),
+			wantReason:    string(ReasonInvalidConnection),
+			wantURLs:      map[string]string{"inventory": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{
+				Client: newIndexedClientBuilder(scheme).
+					WithObjects(inventoryRelease, inventoryBinding, inventoryRenderedRelease).Build(),
+				Scheme: scheme,
+			}
+			binding := &openchoreov1alpha1.ReleaseBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "storefront-dev", Namespace: "acme"},
+				Spec: openchoreov1alpha1.ReleaseBindingSpec{
+					Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: tt.callerProject, ComponentName: "storefront"},
+					Environment: "dev",
+				},
+			}
+
+			endpoints, resolved, err := r.reconcileConnections(context.Background(), binding, tt.release)
+			if err != nil {
+				t.Fatalf("reconcileConnections() error = %v", err)
+			}
+			if resolved != tt.wantResolved {
+				t.Fatalf("reconcileConnections() resolved = %t, want %t", resolved, tt.wantResolved)
+			}
+
+			cond := meta.FindStatusCondition(binding.Status.Conditions, string(ConditionConnectionsResolved))
+			if cond == nil || cond.Reason != tt.wantReason {
+				t.Fatalf("ConnectionsResolved condition = %+v, want reason %s", cond, tt.wantReason)
+			}
+			if !resolved {
+				synced := meta.FindStatusCondition(binding.Status.Conditions, string(ConditionReleaseSynced))
+				if synced == nil || synced.Status != metav1.ConditionFalse || synced.Reason != tt.wantReason {
+					t.Errorf("ReleaseSynced condition = %+v, want False with reason %s", synced, tt.wantReason)
+				}
+			}
+
+			// Every connection is recorded as a dependency, resolved or not
+			if len(binding.Status.Connections) != len(tt.wantURLs) {
+				t.Fatalf("recorded %d connections, want %d", len(binding.Status.Connections), len(tt.wantURLs))
+			}
+			for _, status := range binding.Status.Connections {
+				if status.URL != tt.wantURLs[status.Name] {
+					t.Errorf("connection %q URL = %q, want %q", status.Name, status.URL, tt.wantURLs[status.Name])
+				}
+			}
+			if resolved {
+				endpoint := endpoints[tt.release.Name]["inventory"]
+				if endpoint.URL() != tt.wantURLs["inventory"] {
+					t.Errorf("endpoint URL = %q, want %q", endpoint.URL(), tt.wantURLs["inventory"])
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileMutualConnections(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	// inventory and stock connect to each other, neither is rendered yet
+	newComponent := func(name, target string) (*openchoreov1alpha1.ComponentRelease, *openchoreov1alpha1.ReleaseBinding) {
+		release := &openchoreov1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-v1", Namespace: "acme"},
+			Spec: openchoreov1alpha1.ComponentReleaseSpec{
+				Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+					Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+						"internal": {Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080},
+					},
+					Connections: map[string]openchoreov1alpha1.WorkloadConnection{
+						target: {
+							Type:   openchoreov1alpha1.ConnectionTypeAPI,
+							Params: map[string]string{"componentName": target, "endpoint": "internal"},
+						},
+					},
+				},
+			},
+		}
+		binding := &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-dev", Namespace: "acme"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "warehouse", ComponentName: name},
+				Environment: "dev",
+				ReleaseName: release.Name,
+			},
+		}
+		return release, binding
+	}
+	inventoryRelease, inventoryBinding := newComponent("inventory", "stock")
+	stockRelease, stockBinding := newComponent("stock", "inventory")
+	r := &Reconciler{
+		Client: newIndexedClientBuilder(scheme).
+			WithObjects(inventoryRelease, inventoryBinding, stockRelease, stockBinding).Build(),
+		Scheme: scheme,
+	}
+
+	// Both components are rendered without the connection to the other one instead of waiting on each other
+	for _, tc := range []struct {
+		binding *openchoreov1alpha1.ReleaseBinding
+		release *openchoreov1alpha1.ComponentRelease
+	}{{inventoryBinding, inventoryRelease}, {stockBinding, stockRelease}} {
+		endpoints, resolved, err := r.reconcileConnections(ctx, tc.binding, tc.release)
+		if err != nil {
+			t.Fatalf("reconcileConnections(%s) error = %v", tc.binding.Name, err)
+		}
+		if !resolved || len(endpoints[tc.release.Name]) != 0 {
+			t.Fatalf("reconcileConnections(%s) = %v, %t, want to render without the pending connection",
+				tc.binding.Name, endpoints, resolved)
+		}
+		cond := meta.FindStatusCondition(tc.binding.Status.Conditions, string(ConditionConnectionsResolved))
+		if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != string(ReasonConnectionTargetNotReady) {
+			t.Errorf("ConnectionsResolved condition of %s = %+v, want False with reason %s",
+				tc.binding.Name, cond, ReasonConnectionTargetNotReady)
+		}
+		if synced := meta.FindStatusCondition(tc.binding.Status.Conditions, string(ConditionReleaseSynced)); synced != nil {
+			t.Errorf("ReleaseSynced condition of %s = %+v, want it left to the rendering", tc.binding.Name, synced)
+		}
+		if err := r.Update(ctx, tc.binding); err != nil {
+			t.Fatalf("failed to record the connections of %s: %v", tc.binding.Name, err)
+		}
+	}
+
+	// Rendering inventory reconciles stock again, which then resolves its connection
+	dependents, err := FindConnectionDependents(ctx, r.Client, "acme", "warehouse", "inventory", "dev")
+	if err != nil {
+		t.Fatalf("FindConnectionDependents() error = %v", err)
+	}
+	if len(dependents) != 1 || dependents[0].Name != stockBinding.Name {
+		t.Fatalf("dependents of inventory = %v, want %s", dependents, stockBinding.Name)
+	}
+	if err := r.Create(ctx, &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-dev", Namespace: "acme"},
+		Spec:       openchoreov1alpha1.ReleaseSpec{Resources: inventoryResources(t)},
+	}); err != nil {
+		t.Fatalf("failed to create the Release of inventory: %v", err)
+	}
+
+	endpoints, resolved, err := r.reconcileConnections(ctx, stockBinding, stockRelease)
+	if err != nil || !resolved {
+		t.Fatalf("reconcileConnections(stock) = %t, %v, want resolved", resolved, err)
+	}
+	want := "http://inventory.dp-acme-warehouse-dev-1a2b3c4d.svc.cluster.local"
+	if got := endpoints[stockRelease.Name]["inventory"].URL(); got != want {
+		t.Errorf("endpoint URL = %q, want %q", got, want)
+	}
+	if cond := meta.FindStatusCondition(stockBinding.Status.Conditions, string(ConditionConnectionsResolved)); cond == nil ||
+		cond.Status != metav1.ConditionTrue {
+		t.Errorf("ConnectionsResolved condition = %+v, want True", cond)
+	}
+}
+
+func TestFindConnectionDependents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	binding := func(name, project, component, environment string, targets ...string) *openchoreov1alpha1.ReleaseBinding {
+		rb := &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: project, ComponentName: component},
+				Environment: environment,
+			},
+		}
+		for _, target := range targets {
+			rb.Status.Connections = append(rb.Status.Connections, openchoreov1alpha1.ConnectionStatus{
+				Name: target, ProjectName: "warehouse", ComponentName: target, Endpoint: "api",
+			})
+		}
+		return rb
+	}
+
+	c := newIndexedClientBuilder(scheme).WithObjects(
+		binding("storefront-dev", "storefront", "storefront", "dev", "inventory"),
+		binding("storefront-prod", "storefront", "storefront", "prod", "inventory"),
+		binding("shipping-dev", "warehouse", "shipping", "dev", "stock"),
+		binding("inventory-dev", "warehouse", "inventory", "dev", "inventory"),
+	).Build()
+
+	names := func(bindings []openchoreov1alpha1.ReleaseBinding) string {
+		result := make([]string, 0, len(bindings))
+		for _, b := range bindings {
+			result = append(result, b.Name)
+		}
+		return strings.Join(result, ",")
+	}
+
+	dependents, err := FindConnectionDependents(context.Background(), c, "acme", "warehouse", "inventory", "dev")
+	if err != nil {
+		t.Fatalf("FindConnectionDependents() error = %v", err)
+	}
+	if got := names(dependents); got != "storefront-dev" {
+		t.Errorf("dependents in dev = %s, want storefront-dev", got)
+	}
+
+	dependents, err = FindConnectionDependents(context.Background(), c, "acme", "warehouse", "inventory", "")
+	if err != nil {
+		t.Fatalf("FindConnectionDependents() error = %v", err)
+	}
+	if got := names(dependents); got != "storefront-dev,storefront-prod" {
+		t.Errorf("dependents in all environments = %s, want storefront-dev,storefront-prod", got)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// GitOpsCleanupFinalizer is the finalizer that is used to remove the exported resources from the GitOps repository.
+	GitOpsCleanupFinalizer = "openchoreo.dev/gitops-cleanup"
+)
+
+// ensureFinalizer ensures that the finalizer is added to the ReleaseBinding.
+// The first return value indicates whether the finalizer was added to the ReleaseBinding.
+func (r *Reconciler) ensureFinalizer(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) (bool, error) {
+	// If the ReleaseBinding is being deleted, no need to add the finalizer
+	if !releaseBinding.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+
+	if controllerutil.AddFinalizer(releaseBinding, GitOpsCleanupFinalizer) {
+		return true, r.Update(ctx, releaseBinding)
+	}
+
+	return false, nil
+}
+
+// finalize removes the directory of the ReleaseBinding from the GitOps repository of the environment,
+// so that an external GitOps tool prunes the resources from the data plane.
+func (r *Reconciler) finalize(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("releaseBinding", releaseBinding.Name)
+	if !controllerutil.ContainsFinalizer(releaseBinding, GitOpsCleanupFinalizer) {
+		// Nothing to do if the finalizer is not present
+		return ctrl.Result{}, nil
+	}
+
+	// The operator let go of the exported resources, e.g. because their removal keeps failing
+	if releaseBinding.Annotations[controller.AnnotationKeySkipGitOpsCleanup] == "true" {
+		logger.Info("Skipping the removal of the resources from the GitOps repository")
+		return ctrl.Result{}, r.removeFinalizer(ctx, releaseBinding)
+	}
+
+	environment := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      releaseBinding.Spec.Environment,
+		Namespace: releaseBinding.Namespace,
+	}, environment); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get Environment %s: %w", releaseBinding.Spec.Environment, err)
+	}
+
+	// Without a GitOps repository there is nothing to remove
+	if gitOps := environment.Spec.GitOps; gitOps != nil {
+		old := releaseBinding.DeepCopy()
+		removed, retryAfter, err := r.removeFromGitOps(ctx, releaseBinding, gitOps)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !removed {
+			// Report a failed removal, so that it is clear why the ReleaseBinding is not deleted
+			if !apiequality.Semantic.DeepEqual(old.Status, releaseBinding.Status) {
+				if err := r.Status().Update(ctx, releaseBinding); err != nil {
+					return ctrl.Result{}, fmt.Errorf("failed to update ReleaseBinding status: %w", err)
+				}
+			}
+			logger.Info("Waiting for the resources to be removed from the GitOps repository")
+			return ctrl.Result{RequeueAfter: retryAfter}, nil
+		}
+	}
+
+	return ctrl.Result{}, r.removeFinalizer(ctx, releaseBinding)
+}
+
+// removeFinalizer deletes the pending cleanup GitCommitRequest, if any, and removes the finalizer so that the
+// ReleaseBinding can be deleted.
+func (r *Reconciler) removeFinalizer(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) error {
+	cleanup := &openchoreov1alpha1.GitCommitRequest{}
+	cleanup.Name = gitOpsCleanupRequestName(releaseBinding)
+	cleanup.Namespace = releaseBinding.Namespace
+	if err := r.Delete(ctx, cleanup); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete GitCommitRequest %s: %w", cleanup.Name, err)
+	}
+
+	if controllerutil.RemoveFinalizer(releaseBinding, GitOpsCleanupFinalizer) {
+		return r.Update(ctx, releaseBinding)
+	}
+	return nil
+}
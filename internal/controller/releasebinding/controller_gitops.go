@@ -0,0 +1,369 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/release"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// gitOpsAuthorName and gitOpsAuthorEmail identify the commits of the GitOps export
+	gitOpsAuthorName  = "OpenChoreo"
+	gitOpsAuthorEmail = "gitops@openchoreo.dev"
+
+	// gitCommitSucceeded is the phase of a GitCommitRequest whose commit was pushed
+	gitCommitSucceeded = "Succeeded"
+	// gitCommitFailed is the phase of a GitCommitRequest whose commit could not be pushed
+	gitCommitFailed = "Failed"
+
+	// gitOpsCleanupAttemptAnnotation counts the attempts to remove the exported resources of a ReleaseBinding,
+	// recorded on the cleanup GitCommitRequest of each attempt
+	gitOpsCleanupAttemptAnnotation = "openchoreo.dev/gitops-cleanup-attempt"
+	// gitOpsCleanupBaseBackoff and gitOpsCleanupMaxBackoff bound the time waited before a failed cleanup
+	// GitCommitRequest is created again. The wait doubles with each attempt.
+	gitOpsCleanupBaseBackoff = 30 * time.Second
+	gitOpsCleanupMaxBackoff  = 10 * time.Minute
+)
+
+// releaseApplyMode returns who applies the resources of the Releases of the environment to the data plane
+func releaseApplyMode(environment *openchoreov1alpha1.Environment) openchoreov1alpha1.ReleaseApplyMode {
+	if environment.Spec.GitOps != nil && environment.Spec.GitOps.Mode == openchoreov1alpha1.GitOpsModeExternal {
+		return openchoreov1alpha1.ReleaseApplyModeExternal
+	}
+	return openchoreov1alpha1.ReleaseApplyModeDirect
+}
+
+// gitOpsPath returns the directory of the GitOps repository that holds the resources of the component
+// in the environment: <org>/<project>/<component>/<env>
+func gitOpsPath(releaseBinding *openchoreov1alpha1.ReleaseBinding) string {
+	return path.Join(releaseBinding.Namespace, releaseBinding.Spec.Owner.ProjectName,
+		releaseBinding.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+}
+
+// exportToGitOps writes the resources of the Releases of the ReleaseBinding into the GitOps repository of the
+// environment through a GitCommitRequest, and reports the outcome in the status. The GitCommitRequest is named
+// after the hash of what is committed, so an export is only committed once and a new GitCommitRequest is
+// created whenever the Releases change. Earlier GitCommitRequests are removed once the latest one succeeds.
+// When the Releases change back to an export that succeeded before another one was started, the repository
+// may hold the files of the other export, so the earlier GitCommitRequest is created again to commit them anew.
+func (r *Reconciler) exportToGitOps(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, componentRelease, stableRelease *openchoreov1alpha1.ComponentRelease,
+	stable, canary *openchoreov1alpha1.Release) error {
+	logger := log.FromContext(ctx)
+
+	gitOps := environment.Spec.GitOps
+	if gitOps == nil {
+		releaseBinding.Status.GitOps = nil
+		return nil
+	}
+
+	files, err := makeGitOpsFiles(releaseBinding, stable, canary)
+	if err != nil {
+		return fmt.Errorf("failed to render the GitOps files: %w", err)
+	}
+	desired, err := makeGitCommitRequest(releaseBinding, gitOps, componentRelease, stableRelease, files)
+	if err != nil {
+		return err
+	}
+
+	gcr := &openchoreov1alpha1.GitCommitRequest{}
+	err = r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, gcr)
+	switch {
+	case apierrors.IsNotFound(err):
+		gcr = nil
+	case err != nil:
+		return fmt.Errorf("failed to get GitCommitRequest %s: %w", desired.Name, err)
+	case gcr.Status.Phase == gitCommitSucceeded && releaseBinding.Status.GitOps != nil &&
+		releaseBinding.Status.GitOps.CommitRequest != gcr.Name:
+		// Another export was started after this one succeeded, so its commit is no longer the latest
+		if err := r.Delete(ctx, gcr); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete GitCommitRequest %s: %w", gcr.Name, err)
+		}
+		gcr = nil
+	}
+
+	if gcr == nil {
+		if err := controllerutil.SetControllerReference(releaseBinding, desired, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set owner of GitCommitRequest: %w", err)
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create GitCommitRequest %s: %w", desired.Name, err)
+		}
+		logger.Info("Exporting the Releases to the GitOps repository", "gitCommitRequest", desired.Name,
+			"repository", gitOps.RepoURL, "path", gitOpsPath(releaseBinding))
+		gcr = desired
+	}
+
+	syncGitOpsStatus(releaseBinding, gcr)
+
+	if gcr.Status.Phase != gitCommitSucceeded {
+		return nil
+	}
+	return r.deleteStaleGitCommitRequests(ctx, releaseBinding, gcr.Name)
+}
+
+// makeGitOpsFiles writes each Release into a YAML file named after the Release in the GitOps directory of the
+// ReleaseBinding. The file of the canary Release is deleted when no canary is rolled out.
+// The resources carry the tracking labels of the release controller, so that they can be observed in the
+// data plane when an external GitOps tool applies them.
+func makeGitOpsFiles(releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	stable, canary *openchoreov1alpha1.Release) ([]openchoreov1alpha1.FileEdit, error) {
+	dir := gitOpsPath(releaseBinding)
+
+	stableFile, err := makeReleaseManifest(stable)
+	if err != nil {
+		return nil, err
+	}
+	files := []openchoreov1alpha1.FileEdit{{Path: path.Join(dir, stable.Name+".yaml"), Content: stableFile}}
+
+	canaryPath := path.Join(dir, canaryReleaseName(releaseBinding)+".yaml")
+	if canary == nil {
+		return append(files, openchoreov1alpha1.FileEdit{Path: canaryPath, Delete: true}), nil
+	}
+	canaryFile, err := makeReleaseManifest(canary)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, openchoreov1alpha1.FileEdit{Path: canaryPath, Content: canaryFile}), nil
+}
+
+// makeReleaseManifest renders the resources of the Release as a multi-document YAML manifest
+func makeReleaseManifest(rel *openchoreov1alpha1.Release) (string, error) {
+	resources, err := release.MakeDesiredResources(rel)
+	if err != nil {
+		return "", err
+	}
+
+	documents := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		document, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s %s of Release %s: %w",
+				resource.GetKind(), resource.GetName(), rel.Name, err)
+		}
+		documents = append(documents, string(document))
+	}
+	return strings.Join(documents, "---\n"), nil
+}
+
+// makeGitCommitRequest builds the GitCommitRequest that commits the files into the GitOps repository.
+// The commit message references the bound ComponentRelease, and the stable one while a canary is rolled out.
+func makeGitCommitRequest(releaseBinding *openchoreov1alpha1.ReleaseBinding, gitOps *openchoreov1alpha1.EnvironmentGitOps,
+	componentRelease, stableRelease *openchoreov1alpha1.ComponentRelease,
+	files []openchoreov1alpha1.FileEdit) (*openchoreov1alpha1.GitCommitRequest, error) {
+	message := fmt.Sprintf("Deploy %s/%s to %s\n\nComponentRelease: %s\nReleaseBinding: %s/%s\n",
+		releaseBinding.Spec.Owner.ProjectName, releaseBinding.Spec.Owner.ComponentName, releaseBinding.Spec.Environment,
+		componentRelease.Name, releaseBinding.Namespace, releaseBinding.Name)
+	if stableRelease.Name != componentRelease.Name {
+		message += fmt.Sprintf("Stable ComponentRelease: %s\n", stableRelease.Name)
+	}
+
+	spec := openchoreov1alpha1.GitCommitRequestSpec{
+		RepoURL:       gitOps.RepoURL,
+		Branch:        gitOps.Branch,
+		Message:       message,
+		Author:        openchoreov1alpha1.GitCommitAuthor{Name: gitOpsAuthorName, Email: gitOpsAuthorEmail},
+		AuthSecretRef: gitOps.AuthSecretRef,
+		Files:         files,
+		PullRequest:   gitOps.PullRequest,
+	}
+
+	// The files and the repository identify the export; the message only changes with them
+	hashInput, err := json.Marshal(struct {
+		RepoURL string
+		Branch  string
+		Files   []openchoreov1alpha1.FileEdit
+	}{spec.RepoURL, spec.Branch, spec.Files})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the GitOps files: %w", err)
+	}
+	hash := sha256.Sum256(hashInput)
+
+	return &openchoreov1alpha1.GitCommitRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength,
+				releaseBinding.Name, "gitops", hex.EncodeToString(hash[:])[:12]),
+			Namespace: releaseBinding.Namespace,
+			Labels:    gitCommitRequestLabels(releaseBinding),
+		},
+		Spec: spec,
+	}, nil
+}
+
+// gitOpsCleanupRequestName returns the name of the GitCommitRequest that removes the directory of the ReleaseBinding
+func gitOpsCleanupRequestName(releaseBinding *openchoreov1alpha1.ReleaseBinding) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength, releaseBinding.Name, "gitops-cleanup")
+}
+
+// makeGitOpsCleanupRequest builds the GitCommitRequest that removes the directory of the ReleaseBinding from
+// the GitOps repository as the given attempt. It is not owned by the ReleaseBinding, so that it is not garbage
+// collected while the ReleaseBinding is deleted.
+func makeGitOpsCleanupRequest(releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	gitOps *openchoreov1alpha1.EnvironmentGitOps, attempt int) *openchoreov1alpha1.GitCommitRequest {
+	message := fmt.Sprintf("Remove %s/%s from %s\n\nComponentRelease: %s\nReleaseBinding: %s/%s\n",
+		releaseBinding.Spec.Owner.ProjectName, releaseBinding.Spec.Owner.ComponentName, releaseBinding.Spec.Environment,
+		releaseBinding.Spec.ReleaseName, releaseBinding.Namespace, releaseBinding.Name)
+
+	return &openchoreov1alpha1.GitCommitRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gitOpsCleanupRequestName(releaseBinding),
+			Namespace:   releaseBinding.Namespace,
+			Labels:      gitCommitRequestLabels(releaseBinding),
+			Annotations: map[string]string{gitOpsCleanupAttemptAnnotation: strconv.Itoa(attempt)},
+		},
+		Spec: openchoreov1alpha1.GitCommitRequestSpec{
+			RepoURL:       gitOps.RepoURL,
+			Branch:        gitOps.Branch,
+			Message:       message,
+			Author:        openchoreov1alpha1.GitCommitAuthor{Name: gitOpsAuthorName, Email: gitOpsAuthorEmail},
+			AuthSecretRef: gitOps.AuthSecretRef,
+			Files:         []openchoreov1alpha1.FileEdit{{Path: gitOpsPath(releaseBinding), Delete: true}},
+			PullRequest:   gitOps.PullRequest,
+		},
+	}
+}
+
+// gitCommitRequestLabels returns the labels of the GitCommitRequests of the ReleaseBinding
+func gitCommitRequestLabels(releaseBinding *openchoreov1alpha1.ReleaseBinding) map[string]string {
+	return map[string]string{
+		labels.LabelKeyOrganizationName:   releaseBinding.Namespace,
+		labels.LabelKeyProjectName:        releaseBinding.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName:      releaseBinding.Spec.Owner.ComponentName,
+		labels.LabelKeyEnvironmentName:    releaseBinding.Spec.Environment,
+		labels.LabelKeyReleaseBindingName: releaseBinding.Name,
+	}
+}
+
+// removeFromGitOps commits the removal of the directory of the ReleaseBinding from the GitOps repository.
+// Pending exports are removed first, so that they cannot write the files back. It reports whether the
+// removal was committed, after which the cleanup GitCommitRequest is deleted.
+// A failed removal is reported in the GitOpsCleanedUp condition and attempted again with a new GitCommitRequest
+// once a backoff elapsed, which is returned as the time to wait before reconciling again.
+func (r *Reconciler) removeFromGitOps(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	gitOps *openchoreov1alpha1.EnvironmentGitOps) (bool, time.Duration, error) {
+	logger := log.FromContext(ctx)
+	if err := r.deleteStaleGitCommitRequests(ctx, releaseBinding, ""); err != nil {
+		return false, 0, err
+	}
+
+	gcr := &openchoreov1alpha1.GitCommitRequest{}
+	err := r.Get(ctx, types.NamespacedName{Name: gitOpsCleanupRequestName(releaseBinding), Namespace: releaseBinding.Namespace}, gcr)
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, 0, r.createGitOpsCleanupRequest(ctx, releaseBinding, gitOps, 1)
+	case err != nil:
+		return false, 0, fmt.Errorf("failed to get GitCommitRequest %s: %w", gcr.Name, err)
+	}
+
+	switch gcr.Status.Phase {
+	case gitCommitSucceeded:
+		if err := r.Delete(ctx, gcr); client.IgnoreNotFound(err) != nil {
+			return false, 0, fmt.Errorf("failed to delete GitCommitRequest %s: %w", gcr.Name, err)
+		}
+		return true, 0, nil
+	case gitCommitFailed:
+		attempt, _ := strconv.Atoi(gcr.Annotations[gitOpsCleanupAttemptAnnotation])
+		attempt = max(attempt, 1)
+		retryAfter := time.Until(gcr.CreationTimestamp.Add(gitOpsCleanupBackoff(attempt)))
+		controller.MarkFalseCondition(releaseBinding, ConditionGitOpsCleanedUp, ReasonGitOpsCleanupFailed,
+			fmt.Sprintf("Failed to remove the resources from the GitOps repository (attempt %d): %s. "+
+				"The removal is retried; annotate the ReleaseBinding with %s=true to delete it without removing them.",
+				attempt, gcr.Status.Message, controller.AnnotationKeySkipGitOpsCleanup))
+		if retryAfter > 0 {
+			return false, retryAfter, nil
+		}
+
+		logger.Info("Retrying the removal of the Releases from the GitOps repository", "attempt", attempt+1,
+			"message", gcr.Status.Message)
+		if err := r.Delete(ctx, gcr); client.IgnoreNotFound(err) != nil {
+			return false, 0, fmt.Errorf("failed to delete GitCommitRequest %s: %w", gcr.Name, err)
+		}
+		return false, 0, r.createGitOpsCleanupRequest(ctx, releaseBinding, gitOps, attempt+1)
+	default:
+		return false, 0, nil
+	}
+}
+
+// createGitOpsCleanupRequest creates the GitCommitRequest that removes the directory of the ReleaseBinding
+func (r *Reconciler) createGitOpsCleanupRequest(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	gitOps *openchoreov1alpha1.EnvironmentGitOps, attempt int) error {
+	desired := makeGitOpsCleanupRequest(releaseBinding, gitOps, attempt)
+	if err := r.Create(ctx, desired); err != nil {
+		return fmt.Errorf("failed to create GitCommitRequest %s: %w", desired.Name, err)
+	}
+	log.FromContext(ctx).Info("Removing the Releases from the GitOps repository", "gitCommitRequest", desired.Name,
+		"repository", gitOps.RepoURL, "path", gitOpsPath(releaseBinding))
+	return nil
+}
+
+// gitOpsCleanupBackoff returns the time to wait after the given attempt to remove the exported resources
+// was started before starting the next one
+func gitOpsCleanupBackoff(attempt int) time.Duration {
+	backoff := gitOpsCleanupBaseBackoff
+	for i := 1; i < attempt && backoff < gitOpsCleanupMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, gitOpsCleanupMaxBackoff)
+}
+
+// syncGitOpsStatus reports the GitCommitRequest of the latest export in the status of the ReleaseBinding.
+// The commit of the previous export is kept until the latest export succeeds.
+func syncGitOpsStatus(releaseBinding *openchoreov1alpha1.ReleaseBinding, gcr *openchoreov1alpha1.GitCommitRequest) {
+	status := releaseBinding.Status.GitOps
+	if status == nil {
+		status = &openchoreov1alpha1.GitOpsExportStatus{}
+	}
+	status.Path = gitOpsPath(releaseBinding)
+	status.CommitRequest = gcr.Name
+	status.Phase = gcr.Status.Phase
+	status.Message = gcr.Status.Message
+	if gcr.Status.Phase == gitCommitSucceeded {
+		status.CommitSHA = gcr.Status.ObservedSHA
+		status.PullRequestURL = gcr.Status.PullRequestURL
+	}
+	releaseBinding.Status.GitOps = status
+}
+
+// deleteStaleGitCommitRequests removes the GitCommitRequests of earlier exports of the ReleaseBinding
+func (r *Reconciler) deleteStaleGitCommitRequests(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	current string) error {
+	gcrs := &openchoreov1alpha1.GitCommitRequestList{}
+	if err := r.List(ctx, gcrs, client.InNamespace(releaseBinding.Namespace),
+		client.MatchingLabels{labels.LabelKeyReleaseBindingName: releaseBinding.Name}); err != nil {
+		return fmt.Errorf("failed to list GitCommitRequests: %w", err)
+	}
+
+	for i := range gcrs.Items {
+		gcr := &gcrs.Items[i]
+		if gcr.Name == current || !metav1.IsControlledBy(gcr, releaseBinding) {
+			continue
+		}
+		if err := r.Delete(ctx, gcr); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete GitCommitRequest %s: %w", gcr.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,458 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func newGitOpsFixtures(t *testing.T) (*openchoreov1alpha1.ReleaseBinding, *openchoreov1alpha1.Environment,
+	*openchoreov1alpha1.ComponentRelease, *openchoreov1alpha1.Release) {
+	t.Helper()
+	releaseBinding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-prod", Namespace: "acme", UID: "binding-uid"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "warehouse", ComponentName: "inventory"},
+			Environment: "prod",
+			ReleaseName: "inventory-v2",
+		},
+	}
+	environment := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "acme"},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			GitOps: &openchoreov1alpha1.EnvironmentGitOps{
+				RepoURL:       "https://github.com/acme/gitops.git",
+				Branch:        "main",
+				AuthSecretRef: "gitops-credentials",
+			},
+		},
+	}
+	componentRelease := &openchoreov1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: "inventory-v2", Namespace: "acme"}}
+	release := &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-prod", Namespace: "acme", UID: "release-uid"},
+		Spec:       openchoreov1alpha1.ReleaseSpec{Resources: inventoryResources(t)},
+	}
+	return releaseBinding, environment, componentRelease, release
+}
+
+func TestReleaseApplyMode(t *testing.T) {
+	environment := &openchoreov1alpha1.Environment{}
+	if got := releaseApplyMode(environment); got != openchoreov1alpha1.ReleaseApplyModeDirect {
+		t.Errorf("releaseApplyMode() without GitOps = %s", got)
+	}
+	environment.Spec.GitOps = &openchoreov1alpha1.EnvironmentGitOps{Mode: openchoreov1alpha1.GitOpsModeMirror}
+	if got := releaseApplyMode(environment); got != openchoreov1alpha1.ReleaseApplyModeDirect {
+		t.Errorf("releaseApplyMode() with Mirror = %s", got)
+	}
+	environment.Spec.GitOps.Mode = openchoreov1alpha1.GitOpsModeExternal
+	if got := releaseApplyMode(environment); got != openchoreov1alpha1.ReleaseApplyModeExternal {
+		t.Errorf("releaseApplyMode() with External = %s", got)
+	}
+}
+
+func TestMakeGitOpsFiles(t *testing.T) {
+	releaseBinding, _, _, release := newGitOpsFixtures(t)
+
+	files, err := makeGitOpsFiles(releaseBinding, release, nil)
+	if err != nil {
+		t.Fatalf("makeGitOpsFiles() error = %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected the release file and the canary deletion, got %+v", files)
+	}
+
+	stable := files[0]
+	if stable.Path != "acme/warehouse/inventory/prod/inventory-prod.yaml" || stable.Delete {
+		t.Errorf("unexpected stable file %s (delete %v)", stable.Path, stable.Delete)
+	}
+	if strings.Count(stable.Content, "---\n") != 1 {
+		t.Errorf("expected two YAML documents, got\n%s", stable.Content)
+	}
+	for _, want := range []string{"kind: Service", "kind: HTTPRoute", labels.LabelKeyReleaseUID + ": release-uid",
+		labels.LabelKeyReleaseResourceID + ": service-inventory"} {
+		if !strings.Contains(stable.Content, want) {
+			t.Errorf("expected the manifest to contain %q, got\n%s", want, stable.Content)
+		}
+	}
+
+	canary := files[1]
+	if canary.Path != "acme/warehouse/inventory/prod/inventory-prod-canary.yaml" || !canary.Delete {
+		t.Errorf("unexpected canary file %+v", canary)
+	}
+
+	canaryRelease := release.DeepCopy()
+	canaryRelease.Name = canaryReleaseName(releaseBinding)
+	files, err = makeGitOpsFiles(releaseBinding, release, canaryRelease)
+	if err != nil {
+		t.Fatalf("makeGitOpsFiles() error = %v", err)
+	}
+	if files[1].Delete || files[1].Content == "" {
+		t.Errorf("expected the canary release to be written, got %+v", files[1])
+	}
+}
+
+func TestExportToGitOps(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	releaseBinding, environment, componentRelease, release := newGitOpsFixtures(t)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&openchoreov1alpha1.GitCommitRequest{}).
+		Build()
+	r := &Reconciler{Client: k8sClient, Scheme: scheme}
+
+	listGitCommitRequests := func() []openchoreov1alpha1.GitCommitRequest {
+		t.Helper()
+		gcrs := &openchoreov1alpha1.GitCommitRequestList{}
+		if err := k8sClient.List(ctx, gcrs, client.InNamespace("acme")); err != nil {
+			t.Fatalf("failed to list GitCommitRequests: %v", err)
+		}
+		return gcrs.Items
+	}
+
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+
+	gcrs := listGitCommitRequests()
+	if len(gcrs) != 1 {
+		t.Fatalf("expected one GitCommitRequest, got %d", len(gcrs))
+	}
+	first := gcrs[0]
+	if first.Spec.RepoURL != "https://github.com/acme/gitops.git" || first.Spec.Branch != "main" ||
+		first.Spec.AuthSecretRef != "gitops-credentials" || len(first.Spec.Files) != 2 {
+		t.Errorf("unexpected GitCommitRequest spec %+v", first.Spec)
+	}
+	if !strings.Contains(first.Spec.Message, "ComponentRelease: inventory-v2") {
+		t.Errorf("expected the commit message to reference the ComponentRelease, got %q", first.Spec.Message)
+	}
+	if !metav1.IsControlledBy(&first, releaseBinding) || first.Labels[labels.LabelKeyReleaseBindingName] != "inventory-prod" {
+		t.Errorf("unexpected owner or labels %v %v", first.OwnerReferences, first.Labels)
+	}
+	status := releaseBinding.Status.GitOps
+	if status == nil || status.CommitRequest != first.Name || status.Path != "acme/warehouse/inventory/prod" || status.CommitSHA != "" {
+		t.Fatalf("unexpected GitOps status %+v", status)
+	}
+
+	// Exporting the same Releases again does not create another commit
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+	if len(listGitCommitRequests()) != 1 {
+		t.Fatal("expected the GitCommitRequest to be reused")
+	}
+
+	// The commit SHA is recorded once the commit is pushed
+	first.Status = openchoreov1alpha1.GitCommitRequestStatus{Phase: "Succeeded", ObservedSHA: "abc123", Message: "commit pushed"}
+	if err := k8sClient.Status().Update(ctx, &first); err != nil {
+		t.Fatalf("failed to update GitCommitRequest status: %v", err)
+	}
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+	if releaseBinding.Status.GitOps.CommitSHA != "abc123" || releaseBinding.Status.GitOps.Phase != "Succeeded" {
+		t.Errorf("unexpected GitOps status %+v", releaseBinding.Status.GitOps)
+	}
+
+	// A changed Release is exported with a new GitCommitRequest, keeping the last commit until it is pushed
+	release.Spec.Resources = release.Spec.Resources[:1]
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+	gcrs = listGitCommitRequests()
+	if len(gcrs) != 2 {
+		t.Fatalf("expected a second GitCommitRequest, got %d", len(gcrs))
+	}
+	status = releaseBinding.Status.GitOps
+	if status.CommitRequest == first.Name || status.CommitSHA != "abc123" || status.Phase != "" {
+		t.Errorf("unexpected GitOps status %+v", status)
+	}
+
+	// Earlier GitCommitRequests are removed once the latest export succeeds
+	second := &openchoreov1alpha1.GitCommitRequest{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: status.CommitRequest, Namespace: "acme"}, second); err != nil {
+		t.Fatalf("failed to get GitCommitRequest: %v", err)
+	}
+	second.Status = openchoreov1alpha1.GitCommitRequestStatus{Phase: "Succeeded", ObservedSHA: "def456"}
+	if err := k8sClient.Status().Update(ctx, second); err != nil {
+		t.Fatalf("failed to update GitCommitRequest status: %v", err)
+	}
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+	gcrs = listGitCommitRequests()
+	if len(gcrs) != 1 || gcrs[0].Name != second.Name || releaseBinding.Status.GitOps.CommitSHA != "def456" {
+		t.Errorf("expected only the latest GitCommitRequest to remain, got %d, status %+v", len(gcrs), releaseBinding.Status.GitOps)
+	}
+
+	// Disabling the export clears the status
+	environment.Spec.GitOps = nil
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+	if releaseBinding.Status.GitOps != nil {
+		t.Errorf("expected the GitOps status to be cleared, got %+v", releaseBinding.Status.GitOps)
+	}
+}
+
+func TestExportToGitOpsRollback(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	releaseBinding, environment, componentRelease, releaseA := newGitOpsFixtures(t)
+	releaseB := releaseA.DeepCopy()
+	releaseB.Spec.Resources = releaseB.Spec.Resources[:1]
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&openchoreov1alpha1.GitCommitRequest{}).
+		Build()
+	r := &Reconciler{Client: k8sClient, Scheme: scheme}
+
+	export := func(release *openchoreov1alpha1.Release) *openchoreov1alpha1.GitCommitRequest {
+		t.Helper()
+		if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+			t.Fatalf("exportToGitOps() error = %v", err)
+		}
+		gcr := &openchoreov1alpha1.GitCommitRequest{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: releaseBinding.Status.GitOps.CommitRequest, Namespace: "acme"}, gcr); err != nil {
+			t.Fatalf("failed to get GitCommitRequest: %v", err)
+		}
+		return gcr
+	}
+	succeed := func(gcr *openchoreov1alpha1.GitCommitRequest, sha string) {
+		t.Helper()
+		gcr.Status = openchoreov1alpha1.GitCommitRequestStatus{Phase: "Succeeded", ObservedSHA: sha}
+		if err := k8sClient.Status().Update(ctx, gcr); err != nil {
+			t.Fatalf("failed to update GitCommitRequest status: %v", err)
+		}
+	}
+
+	// A is committed, then B is exported but not committed yet
+	first := export(releaseA)
+	succeed(first, "aaa111")
+	export(releaseA)
+	second := export(releaseB)
+
+	// Going back to A commits A again instead of reporting the earlier commit as the latest export
+	third := export(releaseA)
+	if third.Name != first.Name || third.Status.Phase != "" {
+		t.Fatalf("expected the export of A to be committed again, got %s in phase %q", third.Name, third.Status.Phase)
+	}
+	status := releaseBinding.Status.GitOps
+	if status.CommitRequest != first.Name || status.Phase != "" {
+		t.Errorf("unexpected GitOps status %+v", status)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(second), second); err != nil {
+		t.Errorf("expected the export of B to be kept until A is committed again, got %v", err)
+	}
+
+	succeed(third, "ccc333")
+	export(releaseA)
+	if status := releaseBinding.Status.GitOps; status.CommitSHA != "ccc333" || status.Phase != "Succeeded" {
+		t.Errorf("unexpected GitOps status %+v", status)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(second), second); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the export of B to be removed, got %v", err)
+	}
+
+	// The latest export is not committed again
+	if gcr := export(releaseA); gcr.Status.ObservedSHA != "ccc333" {
+		t.Errorf("expected the committed export to be reused, got %+v", gcr.Status)
+	}
+}
+
+func TestFinalizeRemovesGitOpsDirectory(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	releaseBinding, environment, componentRelease, release := newGitOpsFixtures(t)
+	now := metav1.Now()
+	releaseBinding.Finalizers = []string{GitOpsCleanupFinalizer}
+	releaseBinding.DeletionTimestamp = &now
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(releaseBinding, environment).
+		WithStatusSubresource(&openchoreov1alpha1.GitCommitRequest{}).
+		Build()
+	r := &Reconciler{Client: k8sClient, Scheme: scheme}
+
+	// A pending export must not write the files back after they are removed
+	if err := r.exportToGitOps(ctx, releaseBinding, environment, componentRelease, componentRelease, release, nil); err != nil {
+		t.Fatalf("exportToGitOps() error = %v", err)
+	}
+
+	if _, err := r.finalize(ctx, releaseBinding); err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	gcrs := &openchoreov1alpha1.GitCommitRequestList{}
+	if err := k8sClient.List(ctx, gcrs, client.InNamespace("acme")); err != nil {
+		t.Fatalf("failed to list GitCommitRequests: %v", err)
+	}
+	if len(gcrs.Items) != 1 {
+		t.Fatalf("expected only the cleanup GitCommitRequest, got %d", len(gcrs.Items))
+	}
+	cleanup := gcrs.Items[0]
+	if len(cleanup.Spec.Files) != 1 || cleanup.Spec.Files[0].Path != "acme/warehouse/inventory/prod" || !cleanup.Spec.Files[0].Delete {
+		t.Errorf("expected the cleanup to delete the GitOps directory, got %+v", cleanup.Spec.Files)
+	}
+	if !strings.Contains(cleanup.Spec.Message, "ComponentRelease: inventory-v2") || len(cleanup.OwnerReferences) != 0 {
+		t.Errorf("unexpected cleanup GitCommitRequest %q owned by %v", cleanup.Spec.Message, cleanup.OwnerReferences)
+	}
+	if cleanup.Labels[labels.LabelKeyReleaseBindingName] != "inventory-prod" {
+		t.Errorf("unexpected labels %v", cleanup.Labels)
+	}
+
+	// The finalizer is kept until the removal is committed
+	if _, err := r.finalize(ctx, releaseBinding); err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(releaseBinding), releaseBinding); err != nil {
+		t.Fatalf("expected the ReleaseBinding to be kept, got %v", err)
+	}
+
+	cleanup.Status = openchoreov1alpha1.GitCommitRequestStatus{Phase: "Succeeded", ObservedSHA: "abc123"}
+	if err := k8sClient.Status().Update(ctx, &cleanup); err != nil {
+		t.Fatalf("failed to update GitCommitRequest status: %v", err)
+	}
+	if _, err := r.finalize(ctx, releaseBinding); err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&cleanup), &cleanup); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the cleanup GitCommitRequest to be deleted, got %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(releaseBinding), releaseBinding); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the ReleaseBinding to be deleted once the finalizer is removed, got %v", err)
+	}
+}
+
+func TestFinalizeWithoutGitOps(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	// The environment is gone, or no longer exports to Git
+	releaseBinding, _, _, _ := newGitOpsFixtures(t)
+	now := metav1.Now()
+	releaseBinding.Finalizers = []string{GitOpsCleanupFinalizer}
+	releaseBinding.DeletionTimestamp = &now
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(releaseBinding).Build()
+	r := &Reconciler{Client: k8sClient, Scheme: scheme}
+
+	if _, err := r.finalize(ctx, releaseBinding); err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(releaseBinding), releaseBinding); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the ReleaseBinding to be deleted, got %v", err)
+	}
+}
+
+func TestFinalizeRetriesFailedGitOpsCleanup(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	releaseBinding, environment, _, _ := newGitOpsFixtures(t)
+	now := metav1.Now()
+	releaseBinding.Finalizers = []string{GitOpsCleanupFinalizer}
+	releaseBinding.DeletionTimestamp = &now
+	failed := makeGitOpsCleanupRequest(releaseBinding, environment.Spec.GitOps, 1)
+	failed.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	failed.Status = openchoreov1alpha1.GitCommitRequestStatus{Phase: "Failed", Message: "authentication required"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(releaseBinding, environment, failed).
+		WithStatusSubresource(&openchoreov1alpha1.GitCommitRequest{}, &openchoreov1alpha1.ReleaseBinding{}).
+		Build()
+	r := &Reconciler{Client: k8sClient, Scheme: scheme}
+
+	// The backoff of the failed attempt elapsed, so the removal is attempted again
+	if _, err := r.finalize(ctx, releaseBinding); err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	cleanup := &openchoreov1alpha1.GitCommitRequest{}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(failed), cleanup); err != nil {
+		t.Fatalf("expected the cleanup GitCommitRequest to be created again, got %v", err)
+	}
+	if cleanup.Annotations[gitOpsCleanupAttemptAnnotation] != "2" || cleanup.Status.Phase != "" {
+		t.Errorf("expected a second attempt, got %v with status %+v", cleanup.Annotations, cleanup.Status)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(releaseBinding), releaseBinding); err != nil {
+		t.Fatalf("expected the ReleaseBinding to be kept, got %v", err)
+	}
+	condition := meta.FindStatusCondition(releaseBinding.Status.Conditions, string(ConditionGitOpsCleanedUp))
+	if condition == nil || condition.Reason != string(ReasonGitOpsCleanupFailed) ||
+		!strings.Contains(condition.Message, "authentication required") {
+		t.Fatalf("expected the failed cleanup to be reported, got %+v", condition)
+	}
+
+	// A fresh failure waits for the backoff before the next attempt.
+	// The fake client does not set the creation timestamp of the attempt.
+	cleanup.CreationTimestamp = metav1.Now()
+	if err := k8sClient.Update(ctx, cleanup); err != nil {
+		t.Fatalf("failed to update GitCommitRequest: %v", err)
+	}
+	cleanup.Status = openchoreov1alpha1.GitCommitRequestStatus{Phase: "Failed", Message: "authentication required"}
+	if err := k8sClient.Status().Update(ctx, cleanup); err != nil {
+		t.Fatalf("failed to update GitCommitRequest status: %v", err)
+	}
+	result, err := r.finalize(ctx, releaseBinding)
+	if err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > gitOpsCleanupBackoff(2) {
+		t.Errorf("expected to wait for the backoff of the second attempt, got %v", result.RequeueAfter)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(failed), cleanup); err != nil ||
+		cleanup.Annotations[gitOpsCleanupAttemptAnnotation] != "2" {
+		t.Errorf("expected the failed attempt to be kept during the backoff, got %v", err)
+	}
+
+	// The operator lets go of the exported resources
+	releaseBinding.Annotations = map[string]string{controller.AnnotationKeySkipGitOpsCleanup: "true"}
+	if err := k8sClient.Update(ctx, releaseBinding); err != nil {
+		t.Fatalf("failed to annotate the ReleaseBinding: %v", err)
+	}
+	if _, err := r.finalize(ctx, releaseBinding); err != nil {
+		t.Fatalf("finalize() error = %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(failed), cleanup); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the cleanup GitCommitRequest to be deleted, got %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(releaseBinding), releaseBinding); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the ReleaseBinding to be deleted, got %v", err)
+	}
+}
+
+func TestGitOpsCleanupBackoff(t *testing.T) {
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute}
+	for i, backoff := range want {
+		if got := gitOpsCleanupBackoff(i + 1); got != backoff {
+			t.Errorf("gitOpsCleanupBackoff(%d) = %v, want %v", i+1, got, backoff)
+		}
+	}
+}
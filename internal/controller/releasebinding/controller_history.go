@@ -0,0 +1,62 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// maxReleaseHistory is the maximum number of entries kept in a ReleaseBinding's release history
+const maxReleaseHistory = 10
+
+// recordReleaseHistory appends the currently bound release to the release history when it differs
+// from the most recently recorded release.
+//
+// Moving to a release that is already present in the history is reported as a rollback through the
+// RolledBack condition. Moving to a release that has not been bound before clears the condition.
+func recordReleaseHistory(releaseBinding *openchoreov1alpha1.ReleaseBinding) {
+	releaseName := releaseBinding.Spec.ReleaseName
+	history := releaseBinding.Status.ReleaseHistory
+
+	if releaseName == "" {
+		return
+	}
+	if len(history) > 0 && history[len(history)-1].ReleaseName == releaseName {
+		return
+	}
+
+	rolledBack := false
+	for _, entry := range history {
+		if entry.ReleaseName == releaseName {
+			rolledBack = true
+			break
+		}
+	}
+
+	if rolledBack {
+		previous := history[len(history)-1].ReleaseName
+		msg := fmt.Sprintf("Rolled back from release %q to previously bound release %q", previous, releaseName)
+		controller.MarkTrueCondition(releaseBinding, ConditionRolledBack, ReasonRolledBack, msg)
+	} else {
+		conditions := releaseBinding.GetConditions()
+		if meta.RemoveStatusCondition(&conditions, string(ConditionRolledBack)) {
+			releaseBinding.SetConditions(conditions)
+		}
+	}
+
+	history = append(history, openchoreov1alpha1.ReleaseHistoryEntry{
+		ReleaseName: releaseName,
+		BoundAt:     metav1.Now(),
+	})
+	if len(history) > maxReleaseHistory {
+		history = history[len(history)-maxReleaseHistory:]
+	}
+	releaseBinding.Status.ReleaseHistory = history
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func historyNames(binding *openchoreov1alpha1.ReleaseBinding) []string {
+	names := make([]string, 0, len(binding.Status.ReleaseHistory))
+	for _, entry := range binding.Status.ReleaseHistory {
+		names = append(names, entry.ReleaseName)
+	}
+	return names
+}
+
+func TestRecordReleaseHistory(t *testing.T) {
+	binding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+	}
+
+	deploy := func(releaseName string) {
+		binding.Spec.ReleaseName = releaseName
+		binding.Generation++
+		recordReleaseHistory(binding)
+	}
+
+	deploy("app-v1")
+	deploy("app-v2")
+	recordReleaseHistory(binding)
+
+	if got := fmt.Sprint(historyNames(binding)); got != "[app-v1 app-v2]" {
+		t.Fatalf("history = %s, want [app-v1 app-v2]", got)
+	}
+	if meta.FindStatusCondition(binding.Status.Conditions, string(ConditionRolledBack)) != nil {
+		t.Fatalf("unexpected RolledBack condition after forward deploys")
+	}
+
+	deploy("app-v1")
+	cond := meta.FindStatusCondition(binding.Status.Conditions, string(ConditionRolledBack))
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != string(ReasonRolledBack) {
+		t.Fatalf("expected RolledBack condition after rolling back, got %+v", cond)
+	}
+	if got := fmt.Sprint(historyNames(binding)); got != "[app-v1 app-v2 app-v1]" {
+		t.Fatalf("history = %s, want [app-v1 app-v2 app-v1]", got)
+	}
+
+	deploy("app-v3")
+	if meta.FindStatusCondition(binding.Status.Conditions, string(ConditionRolledBack)) != nil {
+		t.Fatalf("RolledBack condition should be cleared when a new release is deployed")
+	}
+
+	for i := 4; i < 4+maxReleaseHistory; i++ {
+		deploy(fmt.Sprintf("app-v%d", i))
+	}
+	if len(binding.Status.ReleaseHistory) != maxReleaseHistory {
+		t.Fatalf("history length = %d, want %d", len(binding.Status.ReleaseHistory), maxReleaseHistory)
+	}
+	if last := binding.Status.ReleaseHistory[maxReleaseHistory-1].ReleaseName; last != binding.Spec.ReleaseName {
+		t.Fatalf("last history entry = %s, want %s", last, binding.Spec.ReleaseName)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/clients/observer"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+const (
+	// canarySuffix is appended to the names of the Release and the rendered resources of a canary
+	canarySuffix = "canary"
+
+	// httpRouteGroup is the API group of the Gateway API HTTPRoute
+	httpRouteGroup = "gateway.networking.k8s.io"
+)
+
+// syncRolloutTarget updates the rollout status when the bound release changes.
+//
+// Without a rollout strategy the rollout status is cleared and the bound release replaces the running
+// release in one go. With a canary strategy, binding a release other than the stable release starts a
+// canary at the first step. A release bound before the strategy was set is considered stable.
+func syncRolloutTarget(releaseBinding *openchoreov1alpha1.ReleaseBinding, now metav1.Time) {
+	strategy := releaseBinding.Spec.RolloutStrategy
+	if strategy == nil || strategy.Canary == nil {
+		releaseBinding.Status.Rollout = nil
+		return
+	}
+
+	target := releaseBinding.Spec.ReleaseName
+	rollout := releaseBinding.Status.Rollout
+	switch {
+	case rollout == nil, target == rollout.StableRelease && rollout.CanaryRelease != "":
+		// Binding the stable release again cancels the canary
+		releaseBinding.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+			Phase:         openchoreov1alpha1.RolloutPhaseSucceeded,
+			StableRelease: target,
+			Message:       fmt.Sprintf("Release %q is rolled out", target),
+		}
+	case target != rollout.StableRelease && target != rollout.CanaryRelease:
+		steps := strategy.Canary.Steps
+		releaseBinding.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+			Phase:         openchoreov1alpha1.RolloutPhaseProgressing,
+			StableRelease: rollout.StableRelease,
+			CanaryRelease: target,
+			CurrentStep:   0,
+			CurrentWeight: steps[0].Weight,
+			StepStartedAt: &now,
+			Message:       canaryStepMessage(0, len(steps), steps[0].Weight),
+		}
+	}
+}
+
+// isCanaryActive checks whether a canary is receiving traffic next to the stable release
+func isCanaryActive(rollout *openchoreov1alpha1.RolloutStatus) bool {
+	return rollout != nil && rollout.Phase == openchoreov1alpha1.RolloutPhaseProgressing && rollout.CanaryRelease != ""
+}
+
+// advanceRollout moves the canary to the next step, or promotes it to the stable release after the last step
+func advanceRollout(rollout *openchoreov1alpha1.RolloutStatus, strategy *openchoreov1alpha1.CanaryStrategy, now metav1.Time) {
+	next := int(rollout.CurrentStep) + 1
+	if next >= len(strategy.Steps) {
+		promoted := rollout.CanaryRelease
+		*rollout = openchoreov1alpha1.RolloutStatus{
+			Phase:         openchoreov1alpha1.RolloutPhaseSucceeded,
+			StableRelease: promoted,
+			ErrorRate:     rollout.ErrorRate,
+			Message:       fmt.Sprintf("Release %q is rolled out", promoted),
+		}
+		return
+	}
+
+	rollout.CurrentStep = int32(next) //nolint:gosec // the number of steps is small
+	rollout.CurrentWeight = strategy.Steps[next].Weight
+	rollout.StepStartedAt = &now
+	rollout.Message = canaryStepMessage(next, len(strategy.Steps), rollout.CurrentWeight)
+}
+
+// abortRollout stops routing traffic to the canary. The canary release stays recorded so that the
+// rollout is not retried until another release is bound.
+func abortRollout(rollout *openchoreov1alpha1.RolloutStatus, message string) {
+	rollout.Phase = openchoreov1alpha1.RolloutPhaseAborted
+	rollout.CurrentWeight = 0
+	rollout.Message = message
+}
+
+func canaryStepMessage(step, steps int, weight int32) string {
+	return fmt.Sprintf("Canary step %d/%d with %d%% of the traffic", step+1, steps, weight)
+}
+
+// progressRollout analyzes the canary once the pause of the current step has elapsed and moves the
+// rollout to the next step, promotes it or aborts it. Returns the time to wait for the current step.
+func (r *Reconciler) progressRollout(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	rollout := releaseBinding.Status.Rollout
+	if !isCanaryActive(rollout) {
+		return ctrl.Result{}, nil
+	}
+	strategy := releaseBinding.Spec.RolloutStrategy.Canary
+	now := metav1.Now()
+
+	// The steps may have been shortened while the canary was in progress
+	if int(rollout.CurrentStep) < len(strategy.Steps) && rollout.StepStartedAt != nil {
+		pause := strategy.Steps[rollout.CurrentStep].Pause.Duration
+		if remaining := pause - now.Sub(rollout.StepStartedAt.Time); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	if reason, err := r.analyzeCanary(ctx, releaseBinding, environment, dataPlane, component, project); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to analyze canary: %w", err)
+	} else if reason != "" {
+		logger.Info("Aborting canary rollout", "canaryRelease", rollout.CanaryRelease, "reason", reason)
+		abortRollout(rollout, fmt.Sprintf("Canary release %q was aborted: %s", rollout.CanaryRelease, reason))
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	advanceRollout(rollout, strategy, now)
+	logger.Info("Canary rollout advanced", "phase", rollout.Phase, "step", rollout.CurrentStep, "weight", rollout.CurrentWeight)
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// analyzeCanary checks the canary at the end of a step.
+// Returns the reason to abort the rollout, or an empty string if the canary passed the analysis.
+func (r *Reconciler) analyzeCanary(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (string, error) {
+	rollout := releaseBinding.Status.Rollout
+
+	// A canary with degraded resources fails regardless of its traffic
+	canaryRelease := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, client.ObjectKey{Name: canaryReleaseName(releaseBinding), Namespace: releaseBinding.Namespace}, canaryRelease); err != nil {
+		return "", fmt.Errorf("failed to get canary release: %w", err)
+	}
+	for _, resource := range canaryRelease.Status.Resources {
+		if resource.HealthStatus == openchoreov1alpha1.HealthStatusDegraded {
+			return fmt.Sprintf("resource %q is degraded", resource.ID), nil
+		}
+	}
+
+	analysis := releaseBinding.Spec.RolloutStrategy.Canary.Analysis
+	if analysis == nil {
+		return "", nil
+	}
+	if dataPlane.Spec.Observer.URL == "" {
+		return fmt.Sprintf("data plane %q has no observer configured to analyze the canary", dataPlane.Name), nil
+	}
+
+	metrics, err := observer.NewClient(dataPlane.Spec.Observer).GetComponentHTTPMetrics(ctx, observer.ComponentMetricsRequest{
+		ComponentID:   canaryComponentUID(string(component.UID)),
+		EnvironmentID: string(environment.UID),
+		ProjectID:     string(project.UID),
+		StartTime:     rollout.StepStartedAt.Time,
+		EndTime:       time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	errorRate, observed := metrics.ErrorRate()
+	if !observed {
+		// Without traffic there is nothing that indicates a faulty canary
+		rollout.ErrorRate = ""
+		return "", nil
+	}
+	rollout.ErrorRate = strconv.FormatFloat(errorRate, 'f', 2, 64)
+	if errorRate > float64(analysis.MaxErrorRate) {
+		return fmt.Sprintf("error rate %s%% exceeds the maximum of %d%%", rollout.ErrorRate, analysis.MaxErrorRate), nil
+	}
+	return "", nil
+}
+
+// canaryReleaseName returns the name of the Release holding the canary resources of a ReleaseBinding
+func canaryReleaseName(releaseBinding *openchoreov1alpha1.ReleaseBinding) string {
+	return fmt.Sprintf("%s-%s-%s", releaseBinding.Spec.Owner.ComponentName, releaseBinding.Spec.Environment, canarySuffix)
+}
+
+// canaryComponentUID returns the component UID carried by the pods of a canary.
+// The pods of the stable release select on the component UID, so the canary pods carry a different
+// value to keep them out of the stable services and to tell their metrics apart.
+func canaryComponentUID(componentUID string) string {
+	return componentUID + "-" + canarySuffix
+}
+
+// canaryMetadata derives the metadata for rendering the canary next to the stable release
+func canaryMetadata(metadata pipelinecontext.MetadataContext) pipelinecontext.MetadataContext {
+	canary := metadata
+	canary.Name = dpkubernetes.GenerateK8sName(metadata.ComponentName, metadata.EnvironmentName, canarySuffix)
+
+	canary.PodSelectors = make(map[string]string, len(metadata.PodSelectors))
+	for key, value := range metadata.PodSelectors {
+		canary.PodSelectors[key] = value
+	}
+	canary.PodSelectors[labels.LabelKeyComponentUID] = canaryComponentUID(metadata.ComponentUID)
+	return canary
+}
+
+// splitCanaryTraffic routes the given weight of the traffic to the canary through the HTTPRoutes of the
+// stable release. Every backend of a stable route that has a canary service counterpart is split by the
+// weight; canary services are matched by replacing the stable base name with the canary base name.
+//
+// Returns the canary resources that are applied next to the stable release. The canary HTTPRoutes and any
+// resource that is also rendered for the stable release are left out, since they would compete with the
+// stable resources.
+func splitCanaryTraffic(stableResources, canaryResources []map[string]any, stableName, canaryName string, weight int32) []map[string]any {
+	stableKeys := make(map[string]bool, len(stableResources))
+	for _, resource := range stableResources {
+		stableKeys[resourceKey(resource)] = true
+	}
+
+	canaryServices := make(map[string]bool)
+	filtered := make([]map[string]any, 0, len(canaryResources))
+	for _, resource := range canaryResources {
+		if isHTTPRoute(resource) || stableKeys[resourceKey(resource)] {
+			continue
+		}
+		if resource["apiVersion"] == "v1" && resource["kind"] == "Service" {
+			canaryServices[resourceName(resource)] = true
+		}
+		filtered = append(filtered, resource)
+	}
+
+	for _, resource := range stableResources {
+		if !isHTTPRoute(resource) {
+			continue
+		}
+		spec, _ := resource["spec"].(map[string]any)
+		rules, _ := spec["rules"].([]any)
+		for _, rawRule := range rules {
+			rule, ok := rawRule.(map[string]any)
+			if !ok {
+				continue
+			}
+			backendRefs, _ := rule["backendRefs"].([]any)
+			splitRefs := make([]any, 0, len(backendRefs)*2)
+			for _, rawRef := range backendRefs {
+				ref, ok := rawRef.(map[string]any)
+				if !ok || !isServiceBackendRef(ref) {
+					splitRefs = append(splitRefs, rawRef)
+					continue
+				}
+				name, _ := ref["name"].(string)
+				canaryService := ""
+				if strings.HasPrefix(name, stableName) {
+					canaryService = canaryName + strings.TrimPrefix(name, stableName)
+				}
+				if !canaryServices[canaryService] {
+					splitRefs = append(splitRefs, rawRef)
+					continue
+				}
+
+				// Backend weights are relative within a rule, so both backends are scaled by the
+				// original weight to keep the proportions of the other backends
+				originalWeight := backendRefWeight(ref)
+				canaryRef := make(map[string]any, len(ref))
+				for key, value := range ref {
+					canaryRef[key] = value
+				}
+				canaryRef["name"] = canaryService
+				canaryRef["weight"] = originalWeight * int64(weight)
+				ref["weight"] = originalWeight * int64(100-weight)
+				splitRefs = append(splitRefs, ref, canaryRef)
+			}
+			rule["backendRefs"] = splitRefs
+		}
+	}
+
+	return filtered
+}
+
+func isHTTPRoute(resource map[string]any) bool {
+	apiVersion, _ := resource["apiVersion"].(string)
+	return resource["kind"] == "HTTPRoute" && strings.HasPrefix(apiVersion, httpRouteGroup+"/")
+}
+
+func isServiceBackendRef(ref map[string]any) bool {
+	group, _ := ref["group"].(string)
+	kind, _ := ref["kind"].(string)
+	return group == "" && (kind == "" || kind == "Service")
+}
+
+// backendRefWeight returns the weight of a backend reference, which defaults to 1
+func backendRefWeight(ref map[string]any) int64 {
+	switch weight := ref["weight"].(type) {
+	case int64:
+		return weight
+	case int:
+		return int64(weight)
+	case float64:
+		return int64(weight)
+	}
+	return 1
+}
+
+func resourceName(resource map[string]any) string {
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	return name
+}
+
+func resourceKey(resource map[string]any) string {
+	metadata, _ := resource["metadata"].(map[string]any)
+	namespace, _ := metadata["namespace"].(string)
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	return strings.Join([]string{apiVersion, kind, namespace, resourceName(resource)}, "/")
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+func newCanaryBinding(releaseName string) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "proj", ComponentName: "app"},
+			Environment: "prod",
+			ReleaseName: releaseName,
+			RolloutStrategy: &openchoreov1alpha1.RolloutStrategy{
+				Canary: &openchoreov1alpha1.CanaryStrategy{
+					Steps: []openchoreov1alpha1.CanaryStep{
+						{Weight: 10, Pause: metav1.Duration{Duration: time.Minute}},
+						{Weight: 50, Pause: metav1.Duration{Duration: time.Minute}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSyncRolloutTarget(t *testing.T) {
+	now := metav1.Now()
+	binding := newCanaryBinding("app-v1")
+
+	// The first bound release is considered stable
+	syncRolloutTarget(binding, now)
+	rollout := binding.Status.Rollout
+	if rollout == nil || rollout.Phase != openchoreov1alpha1.RolloutPhaseSucceeded || rollout.StableRelease != "app-v1" {
+		t.Fatalf("unexpected rollout for the first release: %+v", rollout)
+	}
+
+	// Binding a new release starts a canary at the first step
+	binding.Spec.ReleaseName = "app-v2"
+	syncRolloutTarget(binding, now)
+	rollout = binding.Status.Rollout
+	if !isCanaryActive(rollout) || rollout.StableRelease != "app-v1" || rollout.CanaryRelease != "app-v2" ||
+		rollout.CurrentStep != 0 || rollout.CurrentWeight != 10 {
+		t.Fatalf("unexpected rollout after binding a new release: %+v", rollout)
+	}
+
+	// Reconciling the same release keeps the progress of the canary
+	rollout.CurrentStep = 1
+	syncRolloutTarget(binding, now)
+	if binding.Status.Rollout.CurrentStep != 1 {
+		t.Fatalf("expected the canary progress to be kept, got step %d", binding.Status.Rollout.CurrentStep)
+	}
+
+	// Binding the stable release again cancels the canary
+	binding.Spec.ReleaseName = "app-v1"
+	syncRolloutTarget(binding, now)
+	rollout = binding.Status.Rollout
+	if isCanaryActive(rollout) || rollout.StableRelease != "app-v1" || rollout.CanaryRelease != "" {
+		t.Fatalf("unexpected rollout after binding the stable release: %+v", rollout)
+	}
+
+	// Removing the strategy clears the rollout status
+	binding.Spec.RolloutStrategy = nil
+	syncRolloutTarget(binding, now)
+	if binding.Status.Rollout != nil {
+		t.Fatalf("expected rollout status to be cleared, got %+v", binding.Status.Rollout)
+	}
+}
+
+func TestSyncRolloutTarget_AbortedCanary(t *testing.T) {
+	now := metav1.Now()
+	binding := newCanaryBinding("app-v2")
+	binding.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+		Phase:         openchoreov1alpha1.RolloutPhaseAborted,
+		StableRelease: "app-v1",
+		CanaryRelease: "app-v2",
+	}
+
+	// An aborted canary is not retried for the same release
+	syncRolloutTarget(binding, now)
+	if binding.Status.Rollout.Phase != openchoreov1alpha1.RolloutPhaseAborted {
+		t.Fatalf("expected the rollout to stay aborted, got %s", binding.Status.Rollout.Phase)
+	}
+
+	// Binding another release starts a new canary
+	binding.Spec.ReleaseName = "app-v3"
+	syncRolloutTarget(binding, now)
+	rollout := binding.Status.Rollout
+	if !isCanaryActive(rollout) || rollout.StableRelease != "app-v1" || rollout.CanaryRelease != "app-v3" {
+		t.Fatalf("unexpected rollout after binding another release: %+v", rollout)
+	}
+}
+
+func TestAdvanceRollout(t *testing.T) {
+	binding := newCanaryBinding("app-v1")
+	syncRolloutTarget(binding, metav1.Now())
+	binding.Spec.ReleaseName = "app-v2"
+	syncRolloutTarget(binding, metav1.Now())
+
+	rollout := binding.Status.Rollout
+	strategy := binding.Spec.RolloutStrategy.Canary
+
+	advanceRollout(rollout, strategy, metav1.Now())
+	if rollout.Phase != openchoreov1alpha1.RolloutPhaseProgressing || rollout.CurrentStep != 1 || rollout.CurrentWeight != 50 {
+		t.Fatalf("unexpected rollout after the first step: %+v", rollout)
+	}
+
+	advanceRollout(rollout, strategy, metav1.Now())
+	if rollout.Phase != openchoreov1alpha1.RolloutPhaseSucceeded || rollout.StableRelease != "app-v2" || rollout.CanaryRelease != "" {
+		t.Fatalf("expected the canary to be promoted, got %+v", rollout)
+	}
+}
+
+func TestCanaryMetadata(t *testing.T) {
+	metadata := pipelinecontext.MetadataContext{
+		Name:            "app-prod-12345678",
+		ComponentName:   "app",
+		ComponentUID:    "component-uid",
+		EnvironmentName: "prod",
+		PodSelectors: map[string]string{
+			labels.LabelKeyComponentUID:   "component-uid",
+			labels.LabelKeyEnvironmentUID: "env-uid",
+		},
+	}
+
+	canary := canaryMetadata(metadata)
+	if canary.Name == metadata.Name {
+		t.Errorf("expected the canary to have its own base name, got %q", canary.Name)
+	}
+	if canary.PodSelectors[labels.LabelKeyComponentUID] != "component-uid-canary" {
+		t.Errorf("unexpected canary component UID selector %q", canary.PodSelectors[labels.LabelKeyComponentUID])
+	}
+	if canary.PodSelectors[labels.LabelKeyEnvironmentUID] != "env-uid" {
+		t.Errorf("expected the other pod selectors to be kept, got %v", canary.PodSelectors)
+	}
+	if metadata.PodSelectors[labels.LabelKeyComponentUID] != "component-uid" {
+		t.Error("expected the stable pod selectors to be left unchanged")
+	}
+}
+
+func TestSplitCanaryTraffic(t *testing.T) {
+	newResource := func(apiVersion, kind, name string) map[string]any {
+		return map[string]any{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   map[string]any{"name": name, "namespace": "dp-ns"},
+		}
+	}
+
+	route := newResource("gateway.networking.k8s.io/v1", "HTTPRoute", "app-prod")
+	route["spec"] = map[string]any{
+		"rules": []any{
+			map[string]any{
+				"backendRefs": []any{
+					map[string]any{"name": "app-prod", "port": int64(80)},
+					map[string]any{"name": "other", "port": int64(80), "weight": int64(2)},
+				},
+			},
+		},
+	}
+	stable := []map[string]any{
+		newResource("apps/v1", "Deployment", "app-prod"),
+		newResource("v1", "Service", "app-prod"),
+		newResource("v1", "ConfigMap", "shared"),
+		route,
+	}
+	canary := []map[string]any{
+		newResource("apps/v1", "Deployment", "app-canary"),
+		newResource("v1", "Service", "app-canary"),
+		newResource("v1", "ConfigMap", "shared"),
+		newResource("gateway.networking.k8s.io/v1", "HTTPRoute", "app-canary"),
+	}
+
+	filtered := splitCanaryTraffic(stable, canary, "app-prod", "app-canary", 20)
+
+	var names []string
+	for _, resource := range filtered {
+		names = append(names, resource["kind"].(string)+"/"+resourceName(resource))
+	}
+	if len(names) != 2 || names[0] != "Deployment/app-canary" || names[1] != "Service/app-canary" {
+		t.Fatalf("unexpected canary resources %v", names)
+	}
+
+	refs := route["spec"].(map[string]any)["rules"].([]any)[0].(map[string]any)["backendRefs"].([]any)
+	if len(refs) != 3 {
+		t.Fatalf("expected 3 backend refs, got %d", len(refs))
+	}
+	want := []struct {
+		name   string
+		weight any
+	}{
+		{"app-prod", int64(80)},
+		{"app-canary", int64(20)},
+		{"other", int64(2)},
+	}
+	for i, w := range want {
+		ref := refs[i].(map[string]any)
+		if ref["name"] != w.name || ref["weight"] != w.weight {
+			t.Errorf("backend ref %d = %v/%v, want %s/%v", i, ref["name"], ref["weight"], w.name, w.weight)
+		}
+	}
+}
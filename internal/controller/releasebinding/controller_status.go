@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -117,7 +118,7 @@ func (r *Reconciler) setResourcesReadyStatus(
 }
 
 // setReadyCondition sets the top-level Ready condition based on
-// ReleaseSynced and ResourcesReady conditions.
+// ReleaseSynced, ConnectionsResolved and ResourcesReady conditions.
 func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.ReleaseBinding) {
 	// Find ReleaseSynced condition
 	var releaseSynced *metav1.Condition
@@ -137,6 +138,15 @@ func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.Releas
 		}
 	}
 
+	// Pending connections keep the ReleaseBinding from being ready while its Release is synced without them
+	connectionsResolved := meta.FindStatusCondition(releaseBinding.Status.Conditions, string(ConditionConnectionsResolved))
+	if releaseSynced != nil && releaseSynced.Status == metav1.ConditionTrue &&
+		connectionsResolved != nil && connectionsResolved.Status == metav1.ConditionFalse {
+		controller.MarkFalseCondition(releaseBinding, ConditionReady,
+			controller.ConditionReason(connectionsResolved.Reason), connectionsResolved.Message)
+		return
+	}
+
 	// Both must be True for Ready to be True
 	if releaseSynced != nil && releaseSynced.Status == metav1.ConditionTrue &&
 		resourcesReady != nil && resourcesReady.Status == metav1.ConditionTrue {
@@ -0,0 +1,142 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// connectionTargetIndex is the field index name for the components that the connections of a ReleaseBinding point to
+	connectionTargetIndex = "status.connections.projectName/status.connections.componentName"
+	// environmentComponentIndex is the field index name for the environment and component of a ReleaseBinding
+	environmentComponentIndex = "spec.environment/spec.owner.projectName/spec.owner.componentName"
+)
+
+// makeComponentKey creates the index key for a component: projectName/componentName
+func makeComponentKey(projectName, componentName string) string {
+	return fmt.Sprintf("%s/%s", projectName, componentName)
+}
+
+// makeEnvironmentComponentKey creates the index key for a component in an environment: environment/projectName/componentName
+func makeEnvironmentComponentKey(environment, projectName, componentName string) string {
+	return fmt.Sprintf("%s/%s", environment, makeComponentKey(projectName, componentName))
+}
+
+// indexConnectionTargets returns the components that the connections of a ReleaseBinding point to
+func indexConnectionTargets(obj client.Object) []string {
+	binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+	keys := make([]string, 0, len(binding.Status.Connections))
+	for _, connection := range binding.Status.Connections {
+		key := makeComponentKey(connection.ProjectName, connection.ComponentName)
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// indexEnvironmentComponent returns the environment and component of a ReleaseBinding
+func indexEnvironmentComponent(obj client.Object) []string {
+	binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+	return []string{makeEnvironmentComponentKey(binding.Spec.Environment,
+		binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName)}
+}
+
+// SetupConnectionIndexes sets up the field indexes of ReleaseBindings that connections are resolved with.
+// FindConnectionDependents requires them on the cache it reads from.
+func SetupConnectionIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &openchoreov1alpha1.ReleaseBinding{},
+		connectionTargetIndex, indexConnectionTargets); err != nil {
+		return fmt.Errorf("failed to setup connection target index: %w", err)
+	}
+	if err := indexer.IndexField(ctx, &openchoreov1alpha1.ReleaseBinding{},
+		environmentComponentIndex, indexEnvironmentComponent); err != nil {
+		return fmt.Errorf("failed to setup environment component index: %w", err)
+	}
+	return nil
+}
+
+// FindConnectionDependents returns the ReleaseBindings with connections to the endpoints of a component.
+// When environment is empty, the dependents in all environments are returned.
+func FindConnectionDependents(ctx context.Context, c client.Reader, namespace, projectName, componentName,
+	environment string) ([]openchoreov1alpha1.ReleaseBinding, error) {
+	bindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := c.List(ctx, bindings, client.InNamespace(namespace),
+		client.MatchingFields{connectionTargetIndex: makeComponentKey(projectName, componentName)}); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	var dependents []openchoreov1alpha1.ReleaseBinding
+	for _, binding := range bindings.Items {
+		if environment != "" && binding.Spec.Environment != environment {
+			continue
+		}
+		// A component may connect to its own endpoints, which does not make it a dependent
+		if binding.Spec.Owner.ProjectName == projectName && binding.Spec.Owner.ComponentName == componentName {
+			continue
+		}
+		dependents = append(dependents, binding)
+	}
+	return dependents, nil
+}
+
+// findDependentsForReleaseBinding enqueues the ReleaseBindings that connect to the component of a
+// ReleaseBinding in its environment, so that their connections are resolved again when the target
+// is deployed, moves to another release or is removed.
+func (r *Reconciler) findDependentsForReleaseBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+	return r.dependentRequests(ctx, binding.Namespace, binding.Spec.Owner.ProjectName,
+		binding.Spec.Owner.ComponentName, binding.Spec.Environment)
+}
+
+// findDependentsForRelease enqueues the ReleaseBindings that connect to the component of a Release
+// in its environment, so that their connections follow the rendered address of the target endpoints.
+func (r *Reconciler) findDependentsForRelease(ctx context.Context, obj client.Object) []reconcile.Request {
+	release := obj.(*openchoreov1alpha1.Release)
+	objLabels := release.GetLabels()
+	projectName := objLabels[labels.LabelKeyProjectName]
+	componentName := objLabels[labels.LabelKeyComponentName]
+	environment := objLabels[labels.LabelKeyEnvironmentName]
+	if projectName == "" || componentName == "" || environment == "" {
+		return nil
+	}
+	return r.dependentRequests(ctx, release.Namespace, projectName, componentName, environment)
+}
+
+// findReleaseBindingForGitCommitRequest enqueues the ReleaseBinding that exports its Releases through a
+// GitCommitRequest, including the cleanup request that is not owned by the ReleaseBinding.
+func (r *Reconciler) findReleaseBindingForGitCommitRequest(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[labels.LabelKeyReleaseBindingName]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: obj.GetNamespace()}}}
+}
+
+func (r *Reconciler) dependentRequests(ctx context.Context, namespace, projectName, componentName,
+	environment string) []reconcile.Request {
+	dependents, err := FindConnectionDependents(ctx, r.Client, namespace, projectName, componentName, environment)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to find connection dependents",
+			"project", projectName, "component", componentName, "environment", environment)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(dependents))
+	for _, dependent := range dependents {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&dependent)})
+	}
+	return requests
+}
@@ -0,0 +1,316 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+// NewRenderInput builds the pipeline input for rendering a ComponentRelease into the
+// environment targeted by a ReleaseBinding.
+// SecretReferences are not resolved here; callers must collect them for the returned workload.
+// The endpoints of API connections are taken from the connections last resolved by the controller
+// (see ConnectionEndpointsFromStatus), which replaces them with freshly resolved ones when it renders.
+func NewRenderInput(
+	componentRelease *openchoreov1alpha1.ComponentRelease,
+	releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment,
+	dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component,
+	project *openchoreov1alpha1.Project,
+) *componentpipeline.RenderInput {
+	return &componentpipeline.RenderInput{
+		ComponentType:  BuildComponentTypeFromRelease(componentRelease),
+		Component:      BuildComponentFromRelease(componentRelease),
+		Traits:         BuildTraitsFromRelease(componentRelease),
+		Workload:       BuildWorkloadFromRelease(componentRelease),
+		Environment:    environment,
+		ReleaseBinding: releaseBinding,
+		DataPlane:      dataPlane,
+		Metadata:       BuildMetadataContext(component, project, dataPlane, environment),
+
+		ConnectionEndpoints: ConnectionEndpointsFromStatus(componentRelease, releaseBinding),
+	}
+}
+
+// ConnectionEndpointsFromStatus returns the endpoints of the API connections of a ComponentRelease that are
+// resolved in the status of the ReleaseBinding, keyed by connection name. A connection is only taken from the
+// status when it targets the same endpoint in the release, as the status may have been recorded for another
+// release. Connections that are not resolved are left out, as they are when the controller renders them.
+func ConnectionEndpointsFromStatus(componentRelease *openchoreov1alpha1.ComponentRelease,
+	releaseBinding *openchoreov1alpha1.ReleaseBinding) map[string]pipelinecontext.ConnectionEndpoint {
+	if releaseBinding == nil {
+		return nil
+	}
+	endpoints := make(map[string]pipelinecontext.ConnectionEndpoint)
+	for name, connection := range componentRelease.Spec.Workload.Connections {
+		if connection.Type != openchoreov1alpha1.ConnectionTypeAPI {
+			continue
+		}
+		projectName := connection.Params[connectionParamProject]
+		if projectName == "" {
+			projectName = releaseBinding.Spec.Owner.ProjectName
+		}
+		for _, status := range releaseBinding.Status.Connections {
+			if status.Name != name || status.URL == "" || status.ProjectName != projectName ||
+				status.ComponentName != connection.Params[connectionParamComponent] ||
+				status.Endpoint != connection.Params[connectionParamEndpoint] {
+				continue
+			}
+			if endpoint, err := pipelinecontext.ParseConnectionEndpoint(status.URL, status.Visibility); err == nil {
+				endpoints[name] = endpoint
+			}
+			break
+		}
+	}
+	return endpoints
+}
+
+// BuildMetadataContext creates the MetadataContext for rendering a component into an environment.
+// The organization is taken from the component namespace.
+func BuildMetadataContext(
+	component *openchoreov1alpha1.Component,
+	project *openchoreov1alpha1.Project,
+	dataPlane *openchoreov1alpha1.DataPlane,
+	environment *openchoreov1alpha1.Environment,
+) pipelinecontext.MetadataContext {
+	// Extract information
+	organizationName := component.Namespace
+	projectName := project.Name
+	componentName := component.Name
+	environmentName := environment.Name
+	componentUID := string(component.UID)
+	projectUID := string(project.UID)
+	dataPlaneName := dataPlane.Name
+	dataPlaneUID := string(dataPlane.UID)
+	environmentUID := string(environment.UID)
+
+	// Generate base name using platform naming conventions
+	// Format: {component}-{env}-{hash}
+	baseName := dpkubernetes.GenerateK8sName(componentName, environmentName)
+
+	namespace := DataPlaneNamespace(organizationName, projectName, environmentName)
+
+	// Build standard labels
+	standardLabels := map[string]string{
+		labels.LabelKeyOrganizationName: organizationName,
+		labels.LabelKeyProjectName:      projectName,
+		labels.LabelKeyComponentName:    componentName,
+		labels.LabelKeyEnvironmentName:  environmentName,
+	}
+
+	// Build pod selectors
+	podSelectors := map[string]string{
+		labels.LabelKeyComponentUID:   componentUID,
+		labels.LabelKeyEnvironmentUID: environmentUID,
+		labels.LabelKeyProjectUID:     projectUID,
+	}
+
+	return pipelinecontext.MetadataContext{
+		Name:            baseName,
+		Namespace:       namespace,
+		Labels:          standardLabels,
+		Annotations:     map[string]string{},
+		PodSelectors:    podSelectors,
+		ComponentName:   componentName,
+		ComponentUID:    componentUID,
+		ProjectName:     projectName,
+		ProjectUID:      projectUID,
+		DataPlaneName:   dataPlaneName,
+		DataPlaneUID:    dataPlaneUID,
+		EnvironmentName: environmentName,
+		EnvironmentUID:  environmentUID,
+	}
+}
+
+// DataPlaneNamespace returns the data plane namespace that the components of a project are deployed to
+// in an environment.
+func DataPlaneNamespace(organizationName, projectName, environmentName string) string {
+	// Generate namespace using platform naming conventions
+	// Format: dp-{org}-{project}-{env}-{hash}
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(
+		dpkubernetes.MaxNamespaceNameLength,
+		"dp", organizationName, projectName, environmentName,
+	)
+}
+
+// CollectSecretReferences collects all SecretReferences needed for rendering from workload and releaseBinding.
+// releaseBinding may be nil when rendering without environment-specific overrides.
+func CollectSecretReferences(ctx context.Context, c client.Reader, workload *openchoreov1alpha1.Workload, releaseBinding *openchoreov1alpha1.ReleaseBinding) (map[string]*openchoreov1alpha1.SecretReference, error) {
+	secretRefs := make(map[string]*openchoreov1alpha1.SecretReference)
+
+	// Helper function to collect secret reference
+	collectSecretRef := func(refName string, namespace string) error {
+		if _, exists := secretRefs[refName]; !exists {
+			secretRef := &openchoreov1alpha1.SecretReference{}
+			if err := c.Get(ctx, client.ObjectKey{
+				Name:      refName,
+				Namespace: namespace,
+			}, secretRef); err != nil {
+				return fmt.Errorf("failed to get SecretReference %s: %w", refName, err)
+			}
+			secretRefs[refName] = secretRef
+		}
+		return nil
+	}
+
+	if err := forEachSecretReference(workload, releaseBinding, collectSecretRef); err != nil {
+		return nil, err
+	}
+	return secretRefs, nil
+}
+
+// SecretReferenceNames returns the names of the SecretReferences used by a workload and the overrides of a
+// releaseBinding, without duplicates. Either may be nil.
+func SecretReferenceNames(workload *openchoreov1alpha1.Workload, releaseBinding *openchoreov1alpha1.ReleaseBinding) []string {
+	var names []string
+	seen := make(map[string]bool)
+	_ = forEachSecretReference(workload, releaseBinding, func(refName string, _ string) error {
+		if !seen[refName] {
+			seen[refName] = true
+			names = append(names, refName)
+		}
+		return nil
+	})
+	return names
+}
+
+// forEachSecretReference calls visit with the name and namespace of each SecretReference used by
+// the workload and the workload overrides of the releaseBinding, stopping at the first error.
+func forEachSecretReference(workload *openchoreov1alpha1.Workload, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	visit func(refName string, namespace string) error) error {
+	collectSecretRef := func(refName string, namespace string) error {
+		if refName == "" {
+			return nil
+		}
+		return visit(refName, namespace)
+	}
+
+	if workload != nil {
+		for _, container := range workload.Spec.Containers {
+			for _, env := range container.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, file := range container.Files {
+				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		for _, conn := range workload.Spec.Connections {
+			if refName := pipelinecontext.ConnectionCredentialsRef(conn); refName != "" {
+				if err := collectSecretRef(refName, workload.Namespace); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Collect from releaseBinding workload overrides if present
+	if releaseBinding != nil && releaseBinding.Spec.WorkloadOverrides != nil {
+		for _, container := range releaseBinding.Spec.WorkloadOverrides.Containers {
+			for _, env := range container.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, file := range container.Files {
+				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Helper functions to build snapshot structures from ComponentRelease
+
+// BuildComponentFromRelease reconstructs the Component snapshotted in a ComponentRelease.
+func BuildComponentFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Component {
+	return &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      componentRelease.Spec.Owner.ComponentName,
+			Namespace: componentRelease.Namespace,
+		},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner: openchoreov1alpha1.ComponentOwner{
+				ProjectName: componentRelease.Spec.Owner.ProjectName,
+			},
+			Parameters: componentRelease.Spec.ComponentProfile.Parameters,
+			Traits:     componentRelease.Spec.ComponentProfile.Traits,
+		},
+	}
+}
+
+// BuildComponentTypeFromRelease reconstructs the ComponentType snapshotted in a ComponentRelease.
+func BuildComponentTypeFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.ComponentType {
+	return &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "from-release", // Name doesn't matter for rendering
+			Namespace: componentRelease.Namespace,
+		},
+		Spec: componentRelease.Spec.ComponentType,
+	}
+}
+
+// BuildTraitsFromRelease reconstructs the Traits snapshotted in a ComponentRelease.
+func BuildTraitsFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) []openchoreov1alpha1.Trait {
+	if len(componentRelease.Spec.Traits) == 0 {
+		return nil
+	}
+
+	traits := make([]openchoreov1alpha1.Trait, 0, len(componentRelease.Spec.Traits))
+	for name, spec := range componentRelease.Spec.Traits {
+		traits = append(traits, openchoreov1alpha1.Trait{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: componentRelease.Namespace,
+			},
+			Spec: spec,
+		})
+	}
+	return traits
+}
+
+// BuildWorkloadFromRelease reconstructs the Workload snapshotted in a ComponentRelease.
+func BuildWorkloadFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Workload {
+	return &openchoreov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "from-release", // Name doesn't matter for rendering
+			Namespace: componentRelease.Namespace,
+		},
+		Spec: openchoreov1alpha1.WorkloadSpec{
+			Owner: openchoreov1alpha1.WorkloadOwner{
+				ProjectName:   componentRelease.Spec.Owner.ProjectName,
+				ComponentName: componentRelease.Spec.Owner.ComponentName,
+			},
+			WorkloadTemplateSpec: componentRelease.Spec.Workload,
+		},
+	}
+}
@@ -5,46 +5,309 @@ package secretreference
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+)
+
+const (
+	// ControllerName is the name of the controller managing the ExternalSecrets of SecretReferences
+	ControllerName = "secretreference-controller"
+
+	// defaultRefreshInterval is how often the status is refreshed when the SecretReference sets no refresh interval
+	defaultRefreshInterval = time.Hour
+
+	// pendingRequeueInterval is how often the status is refreshed while some ExternalSecrets are not ready
+	pendingRequeueInterval = 30 * time.Second
 )
 
 // Reconciler reconciles a SecretReference object
 type Reconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
+	Scheme       *runtime.Scheme
+
+	// dataPlaneClient replaces the clients of the dataplane clusters in tests
+	dataPlaneClient func(ctx context.Context, dataPlane *openchoreodevv1alpha1.DataPlane) (client.Client, error)
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings;componentreleases;environments;dataplanes,verbs=get;list;watch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SecretReference object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile resolves the secret store of every data plane namespace where the SecretReference is used and
+// reports it in the status. The ComponentType templates render the ExternalSecrets that consume the secret,
+// so the SecretReference is only materialized as an ExternalSecret of its own when it is annotated with
+// openchoreo.dev/materialize-secret. ExternalSecrets that are no longer needed are deleted.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	secretRef := &openchoreodevv1alpha1.SecretReference{}
+	if err := r.Get(ctx, req.NamespacedName, secretRef); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("SecretReference resource not found. Ignoring since it must be deleted.")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get SecretReference")
+		return ctrl.Result{}, err
+	}
+
+	if !secretRef.DeletionTimestamp.IsZero() {
+		logger.Info("Finalizing SecretReference")
+		return r.finalize(ctx, secretRef)
+	}
+
+	if finalizerAdded, err := r.ensureFinalizer(ctx, secretRef); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	targets, err := r.findTargets(ctx, secretRef)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	materialize := isMaterialized(secretRef)
+	var failures []string
+	var stores []openchoreodevv1alpha1.SecretStoreReference
+	var resolveFailures []string
+	if materialize {
+		stores, resolveFailures = r.applyExternalSecrets(ctx, secretRef, targets)
+	} else {
+		stores, resolveFailures = r.resolveSecretStores(ctx, secretRef, targets)
+	}
+	failures = append(failures, resolveFailures...)
+	retained, cleanupFailures := r.cleanupExternalSecrets(ctx, secretRef, targets, stores, materialize)
+	failures = append(failures, cleanupFailures...)
+	stores = append(stores, retained...)
+
+	now := metav1.Now()
+	secretRef.Status.SecretStores = stores
+	secretRef.Status.LastRefreshTime = &now
+	requeueAfter := r.setReadyCondition(secretRef, targets, failures, materialize)
 
-	// TODO(user): your logic here
+	// The refresh time always changes, so the status is updated on every reconcile
+	if err := r.Status().Update(ctx, secretRef); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update SecretReference status: %w", err)
+	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// isMaterialized checks whether the SecretReference asks the controller to create its own ExternalSecrets
+func isMaterialized(secretRef *openchoreodevv1alpha1.SecretReference) bool {
+	return secretRef.Annotations[controller.AnnotationKeyMaterializeSecret] == "true"
+}
+
+// resolveSecretStores returns the secret store of each target without touching the data planes, along with
+// the reasons for the targets whose secret store could not be resolved.
+func (r *Reconciler) resolveSecretStores(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference,
+	targets []secretTarget) ([]openchoreodevv1alpha1.SecretStoreReference, []string) {
+	var stores []openchoreodevv1alpha1.SecretStoreReference
+	var failures []string
+
+	for _, target := range targets {
+		dataPlane, err := r.getSecretStoreDataPlane(ctx, secretRef.Namespace, target.Environment)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+		stores = append(stores, openchoreodevv1alpha1.SecretStoreReference{
+			Name:        dataPlane.Spec.SecretStoreRef.Name,
+			Namespace:   target.Namespace,
+			Kind:        esov1.ExtSecretKind,
+			Environment: target.Environment,
+			DataPlane:   dataPlane.Name,
+		})
+	}
+
+	return stores, failures
+}
+
+// applyExternalSecrets creates or updates the ExternalSecret of each target, and returns where they were applied
+// along with the reasons for the targets where they could not be.
+func (r *Reconciler) applyExternalSecrets(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference,
+	targets []secretTarget) ([]openchoreodevv1alpha1.SecretStoreReference, []string) {
+	var stores []openchoreodevv1alpha1.SecretStoreReference
+	var failures []string
+
+	for _, target := range targets {
+		dataPlane, err := r.getSecretStoreDataPlane(ctx, secretRef.Namespace, target.Environment)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+		dpClient, err := r.getDPClient(ctx, dataPlane)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+
+		externalSecret, err := r.applyExternalSecret(ctx, dpClient,
+			makeExternalSecret(secretRef, dataPlane, target.Environment, target.Namespace), secretRef)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("environment %s: %v", target.Environment, err))
+			continue
+		}
+
+		ready, _, refreshTime := externalSecretStatus(externalSecret)
+		stores = append(stores, openchoreodevv1alpha1.SecretStoreReference{
+			Name:            dataPlane.Spec.SecretStoreRef.Name,
+			Namespace:       target.Namespace,
+			Kind:            esov1.ExtSecretKind,
+			Environment:     target.Environment,
+			DataPlane:       dataPlane.Name,
+			Ready:           ready,
+			LastRefreshTime: refreshTime,
+			Materialized:    true,
+		})
+	}
+
+	return stores, failures
+}
+
+// applyExternalSecret creates the ExternalSecret in the data plane, or updates it when it already exists,
+// and returns the ExternalSecret as it is in the data plane.
+func (r *Reconciler) applyExternalSecret(ctx context.Context, dpClient client.Client, desired *esov1.ExternalSecret,
+	secretRef *openchoreodevv1alpha1.SecretReference) (*esov1.ExternalSecret, error) {
+	existing := &esov1.ExternalSecret{}
+	err := dpClient.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	if apierrors.IsNotFound(err) {
+		if err := dpClient.Create(ctx, desired); err != nil {
+			return nil, fmt.Errorf("failed to create ExternalSecret %s/%s: %w", desired.Namespace, desired.Name, err)
+		}
+		return desired, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ExternalSecret %s/%s: %w", desired.Namespace, desired.Name, err)
+	}
+
+	if !isManagedExternalSecret(existing, secretRef) {
+		return nil, fmt.Errorf("ExternalSecret %s/%s already exists and is not managed by OpenChoreo",
+			desired.Namespace, desired.Name)
+	}
+
+	existing.Labels = desired.Labels
+	existing.Spec = desired.Spec
+	if err := dpClient.Update(ctx, existing); err != nil {
+		return nil, fmt.Errorf("failed to update ExternalSecret %s/%s: %w", desired.Namespace, desired.Name, err)
+	}
+	return existing, nil
+}
+
+// setReadyCondition sets the Ready condition from the outcome of the reconcile and returns when the status
+// should be refreshed next.
+func (r *Reconciler) setReadyCondition(secretRef *openchoreodevv1alpha1.SecretReference, targets []secretTarget,
+	failures []string, materialize bool) time.Duration {
+	if len(failures) > 0 {
+		controller.MarkFalseCondition(secretRef, ConditionReady, ReasonExternalSecretsFailed, strings.Join(failures, "; "))
+		return pendingRequeueInterval
+	}
+
+	if len(targets) == 0 {
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonNotInUse,
+			"SecretReference is not used in any environment")
+		return refreshInterval(secretRef)
+	}
+
+	if !materialize {
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonSecretStoresResolved,
+			fmt.Sprintf("Secret stores are resolved in %d namespace(s)", len(secretRef.Status.SecretStores)))
+		return refreshInterval(secretRef)
+	}
+
+	var pending []string
+	for _, store := range secretRef.Status.SecretStores {
+		if !store.Ready {
+			pending = append(pending, fmt.Sprintf("%s (%s)", store.Namespace, store.Environment))
+		}
+	}
+	if len(pending) > 0 {
+		controller.MarkFalseCondition(secretRef, ConditionReady, ReasonExternalSecretsPending,
+			"Secret is not synced yet in "+strings.Join(pending, ", "))
+		return pendingRequeueInterval
+	}
+
+	controller.MarkTrueCondition(secretRef, ConditionReady, ReasonExternalSecretsReady,
+		fmt.Sprintf("Secret is synced in %d namespace(s)", len(secretRef.Status.SecretStores)))
+	return refreshInterval(secretRef)
+}
+
+func refreshInterval(secretRef *openchoreodevv1alpha1.SecretReference) time.Duration {
+	if secretRef.Spec.RefreshInterval != nil && secretRef.Spec.RefreshInterval.Duration > 0 {
+		return secretRef.Spec.RefreshInterval.Duration
+	}
+	return defaultRefreshInterval
+}
+
+// getDataPlaneOfEnvironment gets the dataplane of the specified environment
+func (r *Reconciler) getDataPlaneOfEnvironment(ctx context.Context, orgName, environmentName string) (*openchoreodevv1alpha1.DataPlane, error) {
+	env := &openchoreodevv1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Name: environmentName, Namespace: orgName}, env); err != nil {
+		return nil, fmt.Errorf("failed to get environment %s: %w", environmentName, err)
+	}
+
+	dataPlane := &openchoreodevv1alpha1.DataPlane{}
+	if err := r.Get(ctx, client.ObjectKey{Name: env.Spec.DataPlaneRef, Namespace: orgName}, dataPlane); err != nil {
+		return nil, fmt.Errorf("failed to get dataplane %s for environment %s: %w", env.Spec.DataPlaneRef, environmentName, err)
+	}
+	return dataPlane, nil
+}
+
+// getSecretStoreDataPlane gets the dataplane of the specified environment and checks that it has a secret store
+func (r *Reconciler) getSecretStoreDataPlane(ctx context.Context, orgName, environmentName string) (*openchoreodevv1alpha1.DataPlane, error) {
+	dataPlane, err := r.getDataPlaneOfEnvironment(ctx, orgName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	if dataPlane.Spec.SecretStoreRef == nil {
+		return nil, fmt.Errorf("dataplane %s of environment %s has no secret store", dataPlane.Name, environmentName)
+	}
+	return dataPlane, nil
+}
+
+// getDPClient gets the client of the dataplane cluster
+func (r *Reconciler) getDPClient(ctx context.Context, dataPlane *openchoreodevv1alpha1.DataPlane) (client.Client, error) {
+	if r.dataPlaneClient != nil {
+		return r.dataPlaneClient(ctx, dataPlane)
+	}
+	dpClient, err := kubernetesClient.GetK8sClient(ctx, r.K8sClientMgr, r.Client, dataPlane, dataPlane.Spec.KubernetesCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dataplane client for %s: %w", dataPlane.Name, err)
+	}
+	return dpClient, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreodevv1alpha1.SecretReference{}).
 		Named("secretreference").
+		// Watch for ReleaseBinding changes as they start or stop using SecretReferences
+		Watches(
+			&openchoreodevv1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findSecretReferencesForReleaseBinding),
+		).
 		Complete(r)
 }
@@ -0,0 +1,36 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady represents whether the secret can be materialized in every environment where the
+	// SecretReference is used
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonNotInUse is the reason used when no environment uses the SecretReference
+	ReasonNotInUse controller.ConditionReason = "NotInUse"
+
+	// ReasonSecretStoresResolved is the reason used when the secret stores of all the environments where the
+	// SecretReference is used are resolved, and the ComponentType templates materialize the secret
+	ReasonSecretStoresResolved controller.ConditionReason = "SecretStoresResolved"
+
+	// ReasonExternalSecretsReady is the reason used when all the ExternalSecrets have synced the secret
+	ReasonExternalSecretsReady controller.ConditionReason = "ExternalSecretsReady"
+
+	// ReasonExternalSecretsPending is the reason used when some ExternalSecrets have not synced the secret yet
+	ReasonExternalSecretsPending controller.ConditionReason = "ExternalSecretsPending"
+
+	// ReasonExternalSecretsFailed is the reason used when some ExternalSecrets could not be applied or deleted
+	ReasonExternalSecretsFailed controller.ConditionReason = "ExternalSecretsFailed"
+)
@@ -0,0 +1,152 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+)
+
+const (
+	// SecretReferenceCleanupFinalizer is the finalizer that is used to clean up the ExternalSecrets of the
+	// SecretReference in the data planes.
+	SecretReferenceCleanupFinalizer = "openchoreo.dev/secretreference-cleanup"
+)
+
+// ensureFinalizer ensures that the finalizer is added to the SecretReference.
+// The first return value indicates whether the finalizer was added to the SecretReference.
+func (r *Reconciler) ensureFinalizer(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference) (bool, error) {
+	if !secretRef.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+
+	if controllerutil.AddFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		return true, r.Update(ctx, secretRef)
+	}
+
+	return false, nil
+}
+
+// finalize deletes the ExternalSecrets that were materialized for the SecretReference from the data planes
+// before the SecretReference is removed.
+func (r *Reconciler) finalize(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("secretReference", secretRef.Name)
+	if !controllerutil.ContainsFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		// Nothing to do if the finalizer is not present
+		return ctrl.Result{}, nil
+	}
+
+	deleted := 0
+	for _, store := range secretRef.Status.SecretStores {
+		if !store.Materialized {
+			continue
+		}
+		if err := r.deleteExternalSecret(ctx, secretRef, store); err != nil {
+			return ctrl.Result{}, err
+		}
+		deleted++
+	}
+	logger.Info("Deleted the ExternalSecrets of the SecretReference", "count", deleted)
+
+	if controllerutil.RemoveFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		if err := r.Update(ctx, secretRef); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// cleanupExternalSecrets deletes the ExternalSecrets of the namespaces that no longer use the SecretReference,
+// and all of them when the SecretReference is no longer materialized by the controller. The secret stores that
+// are still used or whose ExternalSecrets could not be deleted, and are not in the resolved stores, are returned
+// to be kept in the status, along with the reasons for the ExternalSecrets that could not be deleted.
+func (r *Reconciler) cleanupExternalSecrets(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference,
+	targets []secretTarget, resolved []openchoreodevv1alpha1.SecretStoreReference,
+	materialize bool) ([]openchoreodevv1alpha1.SecretStoreReference, []string) {
+	var retained []openchoreodevv1alpha1.SecretStoreReference
+	var failures []string
+
+	for _, store := range secretRef.Status.SecretStores {
+		resolvedIndex := slices.IndexFunc(resolved, func(s openchoreodevv1alpha1.SecretStoreReference) bool {
+			return s.DataPlane == store.DataPlane && s.Namespace == store.Namespace
+		})
+		isResolved := resolvedIndex >= 0
+		isUsed := slices.Contains(targets, secretTarget{Environment: store.Environment, Namespace: store.Namespace})
+		if materialize {
+			if isResolved {
+				continue
+			}
+			// Keep the ExternalSecret of a namespace that still uses the SecretReference but could not be
+			// updated, as the secret may still be in use there
+			if isUsed {
+				retained = append(retained, store)
+				continue
+			}
+		} else if !store.Materialized {
+			// There is no ExternalSecret to delete, only keep tracking a namespace that still uses the
+			// SecretReference but whose secret store could not be resolved
+			if !isResolved && isUsed {
+				retained = append(retained, store)
+			}
+			continue
+		}
+		if err := r.deleteExternalSecret(ctx, secretRef, store); err != nil {
+			if isResolved {
+				// Keep track of the ExternalSecret until it is deleted
+				resolved[resolvedIndex].Materialized = true
+			} else {
+				retained = append(retained, store)
+			}
+			failures = append(failures, err.Error())
+		}
+	}
+
+	return retained, failures
+}
+
+// deleteExternalSecret deletes the ExternalSecret of the SecretReference recorded in the status.
+// ExternalSecrets that no longer exist, or that were not created for the SecretReference, are left alone.
+func (r *Reconciler) deleteExternalSecret(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference,
+	store openchoreodevv1alpha1.SecretStoreReference) error {
+	dataPlane := &openchoreodevv1alpha1.DataPlane{}
+	if err := r.Get(ctx, client.ObjectKey{Name: store.DataPlane, Namespace: secretRef.Namespace}, dataPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The data plane is gone along with its ExternalSecrets
+			return nil
+		}
+		return fmt.Errorf("failed to get dataplane %s: %w", store.DataPlane, err)
+	}
+
+	dpClient, err := r.getDPClient(ctx, dataPlane)
+	if err != nil {
+		return err
+	}
+
+	externalSecret := &esov1.ExternalSecret{}
+	if err := dpClient.Get(ctx, client.ObjectKey{Name: secretRef.Name, Namespace: store.Namespace}, externalSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ExternalSecret %s/%s: %w", store.Namespace, secretRef.Name, err)
+	}
+	if !isManagedExternalSecret(externalSecret, secretRef) {
+		return nil
+	}
+
+	if err := dpClient.Delete(ctx, externalSecret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete ExternalSecret %s/%s: %w", store.Namespace, secretRef.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+)
+
+// secretTarget is a data plane namespace of an environment where a SecretReference must be materialized
+type secretTarget struct {
+	Environment string
+	Namespace   string
+}
+
+// findTargets returns the data plane namespaces where the SecretReference is used, sorted by environment and
+// namespace. A SecretReference is used by the ReleaseBindings whose workload or workload overrides refer to it,
+// and is materialized in the namespace that the component of the ReleaseBinding is deployed to.
+func (r *Reconciler) findTargets(ctx context.Context, secretRef *openchoreov1alpha1.SecretReference) ([]secretTarget, error) {
+	releaseBindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, releaseBindings, client.InNamespace(secretRef.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	var targets []secretTarget
+	for i := range releaseBindings.Items {
+		releaseBinding := &releaseBindings.Items[i]
+		if !releaseBinding.DeletionTimestamp.IsZero() {
+			continue
+		}
+		names, err := r.secretReferenceNames(ctx, releaseBinding)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(names, secretRef.Name) {
+			continue
+		}
+		target := secretTarget{
+			Environment: releaseBinding.Spec.Environment,
+			Namespace: releasebinding.DataPlaneNamespace(releaseBinding.Namespace,
+				releaseBinding.Spec.Owner.ProjectName, releaseBinding.Spec.Environment),
+		}
+		if !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+
+	slices.SortFunc(targets, func(a, b secretTarget) int {
+		return cmp.Or(cmp.Compare(a.Environment, b.Environment), cmp.Compare(a.Namespace, b.Namespace))
+	})
+	return targets, nil
+}
+
+// secretReferenceNames returns the names of the SecretReferences used by the ReleaseBinding, taking the
+// workload from the ComponentRelease it binds. A ReleaseBinding whose ComponentRelease does not exist yet
+// only uses the SecretReferences of its overrides.
+func (r *Reconciler) secretReferenceNames(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) ([]string, error) {
+	var workload *openchoreov1alpha1.Workload
+	if releaseBinding.Spec.ReleaseName != "" {
+		componentRelease := &openchoreov1alpha1.ComponentRelease{}
+		err := r.Get(ctx, client.ObjectKey{Name: releaseBinding.Spec.ReleaseName, Namespace: releaseBinding.Namespace}, componentRelease)
+		switch {
+		case err == nil:
+			workload = releasebinding.BuildWorkloadFromRelease(componentRelease)
+		case !apierrors.IsNotFound(err):
+			return nil, fmt.Errorf("failed to get component release %s: %w", releaseBinding.Spec.ReleaseName, err)
+		}
+	}
+	return releasebinding.SecretReferenceNames(workload, releaseBinding), nil
+}
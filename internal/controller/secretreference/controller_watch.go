@@ -0,0 +1,50 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// findSecretReferencesForReleaseBinding maps a ReleaseBinding to the SecretReferences it uses, and to the
+// SecretReferences tracked in its environment, which may no longer be used after the change.
+func (r *Reconciler) findSecretReferencesForReleaseBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	releaseBinding, ok := obj.(*openchoreodevv1alpha1.ReleaseBinding)
+	if !ok {
+		// Ideally, this should not happen as obj is always expected to be a ReleaseBinding from the Watch
+		return nil
+	}
+	logger := log.FromContext(ctx).WithValues("releaseBinding", releaseBinding.Name)
+
+	names, err := r.secretReferenceNames(ctx, releaseBinding)
+	if err != nil {
+		logger.Error(err, "Failed to find the SecretReferences used by the release binding")
+	}
+
+	secretRefs := &openchoreodevv1alpha1.SecretReferenceList{}
+	if err := r.List(ctx, secretRefs, client.InNamespace(releaseBinding.Namespace)); err != nil {
+		logger.Error(err, "Failed to list SecretReferences")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, secretRef := range secretRefs.Items {
+		usedInEnvironment := slices.ContainsFunc(secretRef.Status.SecretStores, func(s openchoreodevv1alpha1.SecretStoreReference) bool {
+			return s.Environment == releaseBinding.Spec.Environment
+		})
+		if slices.Contains(names, secretRef.Name) || usedInEnvironment {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&secretRef),
+			})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// makeExternalSecret builds the ExternalSecret that materializes a SecretReference in a data plane namespace.
+// The ExternalSecret and the Secret it creates are both named after the SecretReference, so that components
+// in the namespace can refer to the secret by the name of the SecretReference.
+func makeExternalSecret(secretRef *openchoreov1alpha1.SecretReference, dataPlane *openchoreov1alpha1.DataPlane,
+	environmentName, namespace string) *esov1.ExternalSecret {
+	template := &esov1.ExternalSecretTemplate{
+		Type: secretRef.Spec.Template.Type,
+	}
+	if secretRef.Spec.Template.Metadata != nil {
+		template.Metadata = esov1.ExternalSecretTemplateMetadata{
+			Labels:      secretRef.Spec.Template.Metadata.Labels,
+			Annotations: secretRef.Spec.Template.Metadata.Annotations,
+		}
+	}
+
+	// Use refresh interval from SecretReference if specified, otherwise let ESO use its default
+	var refreshInterval *metav1.Duration
+	if secretRef.Spec.RefreshInterval != nil {
+		refreshInterval = &metav1.Duration{Duration: secretRef.Spec.RefreshInterval.Duration}
+	}
+
+	externalSecret := &esov1.ExternalSecret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: esov1.SchemeGroupVersion.String(),
+			Kind:       esov1.ExtSecretKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretRef.Name,
+			Namespace: namespace,
+			Labels:    makeExternalSecretLabels(secretRef, environmentName),
+		},
+		Spec: esov1.ExternalSecretSpec{
+			SecretStoreRef: esov1.SecretStoreRef{
+				Name: dataPlane.Spec.SecretStoreRef.Name,
+				Kind: "ClusterSecretStore", // Always use ClusterSecretStore
+			},
+			Target: esov1.ExternalSecretTarget{
+				Name:           secretRef.Name,
+				Template:       template,
+				CreationPolicy: esov1.CreatePolicyOwner,
+				DeletionPolicy: esov1.DeletionPolicyDelete,
+			},
+			RefreshInterval: refreshInterval,
+		},
+	}
+
+	// Map data sources from SecretReference to ExternalSecret
+	for _, dataSource := range secretRef.Spec.Data {
+		externalSecret.Spec.Data = append(externalSecret.Spec.Data, esov1.ExternalSecretData{
+			SecretKey: dataSource.SecretKey,
+			RemoteRef: esov1.ExternalSecretDataRemoteRef{
+				Key:      dataSource.RemoteRef.Key,
+				Property: dataSource.RemoteRef.Property,
+				Version:  dataSource.RemoteRef.Version,
+			},
+		})
+	}
+
+	return externalSecret
+}
+
+func makeExternalSecretLabels(secretRef *openchoreov1alpha1.SecretReference, environmentName string) map[string]string {
+	return map[string]string{
+		labels.LabelKeyManagedBy:           ControllerName,
+		labels.LabelKeyOrganizationName:    secretRef.Namespace,
+		labels.LabelKeyEnvironmentName:     environmentName,
+		labels.LabelKeySecretReferenceName: secretRef.Name,
+	}
+}
+
+// isManagedExternalSecret checks whether an ExternalSecret in the data plane was created for the SecretReference,
+// so that ExternalSecrets created by others with the same name are never overwritten or deleted.
+func isManagedExternalSecret(externalSecret *esov1.ExternalSecret, secretRef *openchoreov1alpha1.SecretReference) bool {
+	return externalSecret.Labels[labels.LabelKeyManagedBy] == ControllerName &&
+		externalSecret.Labels[labels.LabelKeyOrganizationName] == secretRef.Namespace &&
+		externalSecret.Labels[labels.LabelKeySecretReferenceName] == secretRef.Name
+}
+
+// externalSecretStatus returns whether the ExternalSecret has synced the secret from the secret store, the
+// reason if it has not, and when it last synced the secret.
+func externalSecretStatus(externalSecret *esov1.ExternalSecret) (bool, string, *metav1.Time) {
+	var refreshTime *metav1.Time
+	if !externalSecret.Status.RefreshTime.IsZero() {
+		refreshTime = externalSecret.Status.RefreshTime.DeepCopy()
+	}
+
+	for _, condition := range externalSecret.Status.Conditions {
+		if condition.Type != esov1.ExternalSecretReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return true, "", refreshTime
+		}
+		return false, condition.Message, refreshTime
+	}
+	return false, "waiting for the secret to be synced", refreshTime
+}
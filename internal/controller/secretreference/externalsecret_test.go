@@ -0,0 +1,281 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func newSecretReference() *openchoreov1alpha1.SecretReference {
+	return &openchoreov1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "acme"},
+		Spec: openchoreov1alpha1.SecretReferenceSpec{
+			Template: openchoreov1alpha1.SecretTemplate{Type: corev1.SecretTypeOpaque},
+			Data: []openchoreov1alpha1.SecretDataSource{
+				{SecretKey: "password", RemoteRef: openchoreov1alpha1.RemoteReference{Key: "db/prod", Property: "password"}},
+			},
+			RefreshInterval: &metav1.Duration{Duration: 15 * time.Minute},
+		},
+	}
+}
+
+func TestMakeExternalSecret(t *testing.T) {
+	secretRef := newSecretReference()
+	dataPlane := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "acme"},
+		Spec: openchoreov1alpha1.DataPlaneSpec{
+			SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "vault"},
+		},
+	}
+
+	externalSecret := makeExternalSecret(secretRef, dataPlane, "dev", "dp-acme-shop-dev-1a2b3c4d")
+
+	if externalSecret.Name != "db-credentials" || externalSecret.Namespace != "dp-acme-shop-dev-1a2b3c4d" {
+		t.Errorf("unexpected ExternalSecret %s/%s", externalSecret.Namespace, externalSecret.Name)
+	}
+	if externalSecret.Spec.SecretStoreRef.Name != "vault" || externalSecret.Spec.SecretStoreRef.Kind != "ClusterSecretStore" {
+		t.Errorf("unexpected secret store %+v", externalSecret.Spec.SecretStoreRef)
+	}
+	if externalSecret.Spec.Target.Name != "db-credentials" || externalSecret.Spec.Target.Template.Type != corev1.SecretTypeOpaque {
+		t.Errorf("unexpected target %+v", externalSecret.Spec.Target)
+	}
+	if externalSecret.Spec.RefreshInterval == nil || externalSecret.Spec.RefreshInterval.Duration != 15*time.Minute {
+		t.Errorf("unexpected refresh interval %v", externalSecret.Spec.RefreshInterval)
+	}
+	if len(externalSecret.Spec.Data) != 1 || externalSecret.Spec.Data[0].RemoteRef.Property != "password" {
+		t.Errorf("unexpected data %+v", externalSecret.Spec.Data)
+	}
+	if !isManagedExternalSecret(externalSecret, secretRef) {
+		t.Error("expected the ExternalSecret to be managed for the SecretReference")
+	}
+	if externalSecret.Labels[labels.LabelKeyEnvironmentName] != "dev" {
+		t.Errorf("unexpected labels %v", externalSecret.Labels)
+	}
+}
+
+func TestExternalSecretStatus(t *testing.T) {
+	refreshTime := metav1.NewTime(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name        string
+		status      esov1.ExternalSecretStatus
+		wantReady   bool
+		wantMessage string
+		wantRefresh bool
+	}{
+		{
+			name:        "not synced yet",
+			wantMessage: "waiting for the secret to be synced",
+		},
+		{
+			name: "synced",
+			status: esov1.ExternalSecretStatus{
+				RefreshTime: refreshTime,
+				Conditions:  []esov1.ExternalSecretStatusCondition{{Type: esov1.ExternalSecretReady, Status: corev1.ConditionTrue}},
+			},
+			wantReady:   true,
+			wantRefresh: true,
+		},
+		{
+			name: "sync failed",
+			status: esov1.ExternalSecretStatus{
+				Conditions: []esov1.ExternalSecretStatusCondition{
+					{Type: esov1.ExternalSecretReady, Status: corev1.ConditionFalse, Message: "secret not found"},
+				},
+			},
+			wantMessage: "secret not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, message, refresh := externalSecretStatus(&esov1.ExternalSecret{Status: tt.status})
+			if ready != tt.wantReady || message != tt.wantMessage || (refresh != nil) != tt.wantRefresh {
+				t.Errorf("externalSecretStatus() = %v, %q, %v", ready, message, refresh)
+			}
+		})
+	}
+}
+
+func TestReconcileExternalSecrets(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	dpScheme := runtime.NewScheme()
+	if err := esov1.AddToScheme(dpScheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	secretRef := newSecretReference()
+	environment := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "acme"},
+		Spec:       openchoreov1alpha1.EnvironmentSpec{DataPlaneRef: "default"},
+	}
+	dataPlane := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "acme"},
+		Spec: openchoreov1alpha1.DataPlaneSpec{
+			SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "vault"},
+		},
+	}
+	componentRelease := &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders-v1", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+				Containers: map[string]openchoreov1alpha1.Container{
+					"main": {Env: []openchoreov1alpha1.EnvVar{{
+						Key: "DB_PASSWORD",
+						ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+							SecretRef: &openchoreov1alpha1.SecretKeyRef{Name: "db-credentials", Key: "password"},
+						},
+					}}},
+				},
+			},
+		},
+	}
+	releaseBinding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "orders"},
+			Environment: "dev",
+			ReleaseName: "orders-v1",
+		},
+	}
+
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(secretRef, environment, dataPlane, componentRelease, releaseBinding).
+		WithStatusSubresource(&openchoreov1alpha1.SecretReference{}).
+		Build()
+	dpClient := fake.NewClientBuilder().WithScheme(dpScheme).Build()
+	r := &Reconciler{
+		Client: cpClient,
+		Scheme: scheme,
+		dataPlaneClient: func(context.Context, *openchoreov1alpha1.DataPlane) (client.Client, error) {
+			return dpClient, nil
+		},
+	}
+
+	reconcileSecretRef := func() (reconcile.Result, *openchoreov1alpha1.SecretReference) {
+		t.Helper()
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secretRef)})
+		if err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		current := &openchoreov1alpha1.SecretReference{}
+		if err := cpClient.Get(ctx, client.ObjectKeyFromObject(secretRef), current); client.IgnoreNotFound(err) != nil {
+			t.Fatalf("failed to get SecretReference: %v", err)
+		}
+		return result, current
+	}
+
+	// The first reconcile adds the finalizer, the second only resolves the secret store, as the
+	// ComponentType templates render the ExternalSecrets by default
+	reconcileSecretRef()
+	result, current := reconcileSecretRef()
+
+	if len(current.Status.SecretStores) != 1 {
+		t.Fatalf("expected one secret store, got %+v", current.Status.SecretStores)
+	}
+	store := current.Status.SecretStores[0]
+	if store.Environment != "dev" || store.DataPlane != "default" || store.Name != "vault" || store.Materialized {
+		t.Errorf("unexpected secret store %+v", store)
+	}
+	ready := meta.FindStatusCondition(current.Status.Conditions, string(ConditionReady))
+	if ready == nil || ready.Status != metav1.ConditionTrue || ready.Reason != string(ReasonSecretStoresResolved) {
+		t.Errorf("unexpected Ready condition %+v", ready)
+	}
+	if result.RequeueAfter != 15*time.Minute {
+		t.Errorf("RequeueAfter = %v, want the refresh interval", result.RequeueAfter)
+	}
+
+	externalSecret := &esov1.ExternalSecret{}
+	err := dpClient.Get(ctx, client.ObjectKey{Name: "db-credentials", Namespace: store.Namespace}, externalSecret)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no ExternalSecret in the data plane, got %v", err)
+	}
+
+	// The annotation makes the controller materialize the secret
+	current.Annotations = map[string]string{controller.AnnotationKeyMaterializeSecret: "true"}
+	if err := cpClient.Update(ctx, current); err != nil {
+		t.Fatalf("failed to annotate SecretReference: %v", err)
+	}
+	result, current = reconcileSecretRef()
+
+	if len(current.Status.SecretStores) != 1 {
+		t.Fatalf("expected one secret store, got %+v", current.Status.SecretStores)
+	}
+	store = current.Status.SecretStores[0]
+	if store.Environment != "dev" || store.DataPlane != "default" || store.Name != "vault" || !store.Materialized || store.Ready {
+		t.Errorf("unexpected secret store %+v", store)
+	}
+	ready = meta.FindStatusCondition(current.Status.Conditions, string(ConditionReady))
+	if ready == nil || ready.Reason != string(ReasonExternalSecretsPending) {
+		t.Errorf("unexpected Ready condition %+v", ready)
+	}
+	if result.RequeueAfter != pendingRequeueInterval {
+		t.Errorf("RequeueAfter = %v, want %v", result.RequeueAfter, pendingRequeueInterval)
+	}
+	if err := dpClient.Get(ctx, client.ObjectKey{Name: "db-credentials", Namespace: store.Namespace}, externalSecret); err != nil {
+		t.Fatalf("expected the ExternalSecret in the data plane: %v", err)
+	}
+
+	// Removing the annotation deletes the ExternalSecret but keeps tracking the secret store
+	delete(current.Annotations, controller.AnnotationKeyMaterializeSecret)
+	if err := cpClient.Update(ctx, current); err != nil {
+		t.Fatalf("failed to update SecretReference: %v", err)
+	}
+	_, current = reconcileSecretRef()
+
+	if len(current.Status.SecretStores) != 1 || current.Status.SecretStores[0].Materialized {
+		t.Errorf("expected one secret store that is not materialized, got %+v", current.Status.SecretStores)
+	}
+	err = dpClient.Get(ctx, client.ObjectKey{Name: "db-credentials", Namespace: store.Namespace}, externalSecret)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected the ExternalSecret to be deleted, got %v", err)
+	}
+
+	// Materialize the secret again to check the cleanup once it is no longer used
+	current.Annotations = map[string]string{controller.AnnotationKeyMaterializeSecret: "true"}
+	if err := cpClient.Update(ctx, current); err != nil {
+		t.Fatalf("failed to annotate SecretReference: %v", err)
+	}
+	reconcileSecretRef()
+
+	// The ExternalSecret is deleted once no ReleaseBinding uses the SecretReference
+	if err := cpClient.Delete(ctx, releaseBinding); err != nil {
+		t.Fatalf("failed to delete release binding: %v", err)
+	}
+	result, current = reconcileSecretRef()
+
+	if len(current.Status.SecretStores) != 0 {
+		t.Errorf("expected no secret stores, got %+v", current.Status.SecretStores)
+	}
+	ready = meta.FindStatusCondition(current.Status.Conditions, string(ConditionReady))
+	if ready == nil || ready.Status != metav1.ConditionTrue || ready.Reason != string(ReasonNotInUse) {
+		t.Errorf("unexpected Ready condition %+v", ready)
+	}
+	if result.RequeueAfter != 15*time.Minute {
+		t.Errorf("RequeueAfter = %v, want the refresh interval", result.RequeueAfter)
+	}
+	err = dpClient.Get(ctx, client.ObjectKey{Name: "db-credentials", Namespace: store.Namespace}, externalSecret)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the ExternalSecret to be deleted, got %v", err)
+	}
+}
@@ -6,12 +6,15 @@ package trait
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component"
 )
 
 // Reconciler reconciles a Trait object
@@ -24,10 +27,37 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=traits/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=traits/finalizers,verbs=update
 
-// Reconcile is part of the main kubernetes reconciliation loop
+// Reconcile validates the templates of a Trait and reports the result in its status
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
 
+	trait := &openchoreov1alpha1.Trait{}
+	if err := r.Get(ctx, req.NamespacedName, trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if trait.Status.ObservedGeneration == trait.Generation && meta.FindStatusCondition(trait.Status.Conditions, string(ConditionTemplatesValid)) != nil {
+		return ctrl.Result{}, nil
+	}
+
+	templateErrs, err := component.ValidateTrait(trait)
+	switch {
+	case err != nil:
+		logger.Info("Trait schema is invalid", "error", err)
+		controller.MarkFalseCondition(trait, ConditionTemplatesValid, ReasonInvalidSchema, err.Error())
+	case len(templateErrs) > 0:
+		logger.Info("Trait has invalid templates", "errors", len(templateErrs))
+		controller.MarkFalseCondition(trait, ConditionTemplatesValid, ReasonInvalidTemplates,
+			component.FormatTemplateErrors(templateErrs))
+	default:
+		controller.MarkTrueCondition(trait, ConditionTemplatesValid, ReasonTemplatesValid, "All template expressions are valid")
+	}
+	trait.Status.ObservedGeneration = trait.Generation
+
+	if err := r.Status().Update(ctx, trait); err != nil {
+		logger.Error(err, "Failed to update Trait status")
+		return ctrl.Result{}, err
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -0,0 +1,24 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trait
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// ConditionTemplatesValid indicates whether all expressions of the Trait pass static checking
+	ConditionTemplatesValid controller.ConditionType = "TemplatesValid"
+)
+
+const (
+	// ReasonTemplatesValid indicates that all expressions are valid for the declared schema
+	ReasonTemplatesValid controller.ConditionReason = "TemplatesValid"
+
+	// ReasonInvalidTemplates indicates that one or more expressions failed static checking
+	ReasonInvalidTemplates controller.ConditionReason = "InvalidTemplates"
+
+	// ReasonInvalidSchema indicates that the schema is invalid and the expressions could not be checked
+	ReasonInvalidSchema controller.ConditionReason = "InvalidSchema"
+)
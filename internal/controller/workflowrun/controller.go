@@ -28,8 +28,9 @@ import (
 
 type Reconciler struct {
 	client.Client
-	Scheme       *runtime.Scheme
-	k8sClientMgr *kubernetesClient.KubeMultiClientManager
+	Scheme *runtime.Scheme
+	// K8sClientMgr caches the clients of the plane clusters, a manager of its own is used if unset
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
 
 	// Pipeline is the workflow rendering pipeline, shared across all reconciliations.
 	// This enables CEL environment caching across different workflow runs and reconciliations.
@@ -80,7 +81,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
 	}
 
-	bpClient, err := r.getBuildPlaneClient(buildPlane)
+	bpClient, err := r.getBuildPlaneClient(ctx, buildPlane)
 	if err != nil {
 		logger.Error(err, "failed to get build plane client")
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
@@ -150,7 +151,7 @@ func (r *Reconciler) handleWorkloadCreation(
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
 	}
 
-	bpClient, err := r.getBuildPlaneClient(buildPlane)
+	bpClient, err := r.getBuildPlaneClient(ctx, buildPlane)
 	if err != nil {
 		logger.Error(err, "failed to get build plane client for workload creation")
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
@@ -340,8 +341,8 @@ func (r *Reconciler) createWorkloadFromWorkflowRun(
 	return false, nil
 }
 
-func (r *Reconciler) getBuildPlaneClient(buildPlane *openchoreodevv1alpha1.BuildPlane) (client.Client, error) {
-	bpClient, err := kubernetesClient.GetK8sClient(r.k8sClientMgr, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
+func (r *Reconciler) getBuildPlaneClient(ctx context.Context, buildPlane *openchoreodevv1alpha1.BuildPlane) (client.Client, error) {
+	bpClient, err := kubernetesClient.GetK8sClient(ctx, r.K8sClientMgr, r.Client, buildPlane, buildPlane.Spec.KubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build plane client: %w", err)
 	}
@@ -361,8 +362,8 @@ func (r *Reconciler) updateStatusAndRequeueAfter(ctx context.Context, oldWorkflo
 }
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -40,7 +40,7 @@ var _ = Describe("WorkflowRun Controller", func() {
 			reconciler := &Reconciler{
 				Client:       k8sClient,
 				Scheme:       k8sClient.Scheme(),
-				k8sClientMgr: kubernetesClient.NewManager(),
+				K8sClientMgr: kubernetesClient.NewManager(),
 			}
 
 			result, err := reconciler.Reconcile(testCtx, reconcile.Request{
@@ -138,7 +138,7 @@ var _ = Describe("WorkflowRun Controller", func() {
 			reconciler := &Reconciler{
 				Client:       k8sClient,
 				Scheme:       k8sClient.Scheme(),
-				k8sClientMgr: kubernetesClient.NewManager(),
+				K8sClientMgr: kubernetesClient.NewManager(),
 			}
 
 			_, err := reconciler.Reconcile(testCtx, reconcile.Request{
@@ -43,5 +43,11 @@ const (
 	// LabelKeyReleaseNamespace tracks the namespace of the release that manages a resource.
 	LabelKeyReleaseNamespace = "openchoreo.dev/release-namespace"
 
+	// LabelKeySecretReferenceName tracks the name of the SecretReference that an ExternalSecret materializes.
+	LabelKeySecretReferenceName = "openchoreo.dev/secret-reference"
+
+	// LabelKeyReleaseBindingName tracks the name of the ReleaseBinding that a resource was created for.
+	LabelKeyReleaseBindingName = "openchoreo.dev/release-binding"
+
 	LabelValueManagedBy = "openchoreo-control-plane"
 )
@@ -62,6 +62,13 @@ type LoggingConfig struct {
 	DefaultLogLimit      int `koanf:"default.log.limit"`
 	DefaultBuildLogLimit int `koanf:"default.build.log.limit"`
 	MaxLogLinesPerFile   int `koanf:"max.log.lines.per.file"`
+
+	// Live log streaming settings
+	StreamPollInterval      time.Duration `koanf:"stream.poll.interval"`
+	StreamHeartbeatInterval time.Duration `koanf:"stream.heartbeat.interval"`
+	// StreamIngestionDelay holds back logs newer than this delay so that logs indexed late are not skipped
+	StreamIngestionDelay time.Duration `koanf:"stream.ingestion.delay"`
+	StreamBatchLimit     int           `koanf:"stream.batch.limit"`
 }
 
 // Load loads configuration from environment variables and defaults
@@ -78,32 +85,36 @@ func Load() (*Config, error) {
 
 	// Define environment variable mappings
 	envMappings := map[string]string{
-		"SERVER_PORT":                     "server.port",
-		"SERVER_READ_TIMEOUT":             "server.read.timeout",
-		"SERVER_WRITE_TIMEOUT":            "server.write.timeout",
-		"SERVER_SHUTDOWN_TIMEOUT":         "server.shutdown.timeout",
-		"OPENSEARCH_ADDRESS":              "opensearch.address",
-		"OPENSEARCH_USERNAME":             "opensearch.username",
-		"OPENSEARCH_PASSWORD":             "opensearch.password",
-		"OPENSEARCH_TIMEOUT":              "opensearch.timeout",
-		"OPENSEARCH_MAX_RETRIES":          "opensearch.max.retries",
-		"OPENSEARCH_INDEX_PREFIX":         "opensearch.index.prefix",
-		"OPENSEARCH_INDEX_PATTERN":        "opensearch.index.pattern",
-		"OPENSEARCH_LEGACY_PATTERN":       "opensearch.legacy.pattern",
-		"PROMETHEUS_ADDRESS":              "prometheus.address",
-		"PROMETHEUS_TIMEOUT":              "prometheus.timeout",
-		"AUTH_JWT_SECRET":                 "auth.jwt.secret",
-		"AUTH_ENABLE_AUTH":                "auth.enable.auth",
-		"AUTH_REQUIRED_ROLE":              "auth.required.role",
-		"LOGGING_MAX_LOG_LIMIT":           "logging.max.log.limit",
-		"LOGGING_DEFAULT_LOG_LIMIT":       "logging.default.log.limit",
-		"LOGGING_DEFAULT_BUILD_LOG_LIMIT": "logging.default.build.log.limit",
-		"LOGGING_MAX_LOG_LINES_PER_FILE":  "logging.max.log.lines.per.file",
-		"LOG_LEVEL":                       "loglevel",
-		"PORT":                            "server.port",           // Common alias
-		"JWT_SECRET":                      "auth.jwt.secret",       // Common alias
-		"ENABLE_AUTH":                     "auth.enable.auth",      // Common alias
-		"MAX_LOG_LIMIT":                   "logging.max.log.limit", // Common alias
+		"SERVER_PORT":                       "server.port",
+		"SERVER_READ_TIMEOUT":               "server.read.timeout",
+		"SERVER_WRITE_TIMEOUT":              "server.write.timeout",
+		"SERVER_SHUTDOWN_TIMEOUT":           "server.shutdown.timeout",
+		"OPENSEARCH_ADDRESS":                "opensearch.address",
+		"OPENSEARCH_USERNAME":               "opensearch.username",
+		"OPENSEARCH_PASSWORD":               "opensearch.password",
+		"OPENSEARCH_TIMEOUT":                "opensearch.timeout",
+		"OPENSEARCH_MAX_RETRIES":            "opensearch.max.retries",
+		"OPENSEARCH_INDEX_PREFIX":           "opensearch.index.prefix",
+		"OPENSEARCH_INDEX_PATTERN":          "opensearch.index.pattern",
+		"OPENSEARCH_LEGACY_PATTERN":         "opensearch.legacy.pattern",
+		"PROMETHEUS_ADDRESS":                "prometheus.address",
+		"PROMETHEUS_TIMEOUT":                "prometheus.timeout",
+		"AUTH_JWT_SECRET":                   "auth.jwt.secret",
+		"AUTH_ENABLE_AUTH":                  "auth.enable.auth",
+		"AUTH_REQUIRED_ROLE":                "auth.required.role",
+		"LOGGING_MAX_LOG_LIMIT":             "logging.max.log.limit",
+		"LOGGING_DEFAULT_LOG_LIMIT":         "logging.default.log.limit",
+		"LOGGING_DEFAULT_BUILD_LOG_LIMIT":   "logging.default.build.log.limit",
+		"LOGGING_MAX_LOG_LINES_PER_FILE":    "logging.max.log.lines.per.file",
+		"LOGGING_STREAM_POLL_INTERVAL":      "logging.stream.poll.interval",
+		"LOGGING_STREAM_HEARTBEAT_INTERVAL": "logging.stream.heartbeat.interval",
+		"LOGGING_STREAM_INGESTION_DELAY":    "logging.stream.ingestion.delay",
+		"LOGGING_STREAM_BATCH_LIMIT":        "logging.stream.batch.limit",
+		"LOG_LEVEL":                         "loglevel",
+		"PORT":                              "server.port",           // Common alias
+		"JWT_SECRET":                        "auth.jwt.secret",       // Common alias
+		"ENABLE_AUTH":                       "auth.enable.auth",      // Common alias
+		"MAX_LOG_LIMIT":                     "logging.max.log.limit", // Common alias
 	}
 
 	// Check for environment variables and map them to nested structure
@@ -183,10 +194,14 @@ func getDefaults() map[string]interface{} {
 			"required.role": "user",
 		},
 		"logging": map[string]interface{}{
-			"max.log.limit":           10000,
-			"default.log.limit":       100,
-			"default.build.log.limit": 3000,
-			"max.log.lines.per.file":  600000,
+			"max.log.limit":             10000,
+			"default.log.limit":         100,
+			"default.build.log.limit":   3000,
+			"max.log.lines.per.file":    600000,
+			"stream.poll.interval":      "2s",
+			"stream.heartbeat.interval": "15s",
+			"stream.ingestion.delay":    "2s",
+			"stream.batch.limit":        500,
 		},
 		"loglevel": "info",
 	}
@@ -217,5 +232,21 @@ func (c *Config) validate() error {
 		return fmt.Errorf("max log limit must be positive")
 	}
 
+	if c.Logging.StreamPollInterval <= 0 {
+		return fmt.Errorf("log stream poll interval must be positive")
+	}
+
+	if c.Logging.StreamHeartbeatInterval <= 0 {
+		return fmt.Errorf("log stream heartbeat interval must be positive")
+	}
+
+	if c.Logging.StreamIngestionDelay < 0 {
+		return fmt.Errorf("log stream ingestion delay must not be negative")
+	}
+
+	if c.Logging.StreamBatchLimit <= 0 || c.Logging.StreamBatchLimit > c.Logging.MaxLogLimit {
+		return fmt.Errorf("log stream batch limit must be between 1 and the max log limit")
+	}
+
 	return nil
 }
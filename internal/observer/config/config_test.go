@@ -52,6 +52,18 @@ func TestLoad_WithDefaults(t *testing.T) {
 	if cfg.Logging.MaxLogLimit != 10000 {
 		t.Errorf("Expected max log limit 10000, got %d", cfg.Logging.MaxLogLimit)
 	}
+
+	if cfg.Logging.StreamPollInterval != 2*time.Second {
+		t.Errorf("Expected log stream poll interval 2s, got %v", cfg.Logging.StreamPollInterval)
+	}
+
+	if cfg.Logging.StreamHeartbeatInterval != 15*time.Second {
+		t.Errorf("Expected log stream heartbeat interval 15s, got %v", cfg.Logging.StreamHeartbeatInterval)
+	}
+
+	if cfg.Logging.StreamBatchLimit != 500 {
+		t.Errorf("Expected log stream batch limit 500, got %d", cfg.Logging.StreamBatchLimit)
+	}
 }
 
 func TestLoad_WithEnvironmentVariables(t *testing.T) {
@@ -63,13 +75,14 @@ func TestLoad_WithEnvironmentVariables(t *testing.T) {
 	os.Setenv("OPENSEARCH_PASSWORD", "testpass")
 	os.Setenv("AUTH_ENABLE_AUTH", "true")
 	os.Setenv("LOGGING_MAX_LOG_LIMIT", "5000")
+	os.Setenv("LOGGING_STREAM_POLL_INTERVAL", "5s")
 
 	defer func() {
 		// Clean up environment variables
 		envVars := []string{
 			"SERVER_PORT", "LOG_LEVEL", "OPENSEARCH_ADDRESS",
 			"OPENSEARCH_USERNAME", "OPENSEARCH_PASSWORD",
-			"AUTH_ENABLE_AUTH", "LOGGING_MAX_LOG_LIMIT",
+			"AUTH_ENABLE_AUTH", "LOGGING_MAX_LOG_LIMIT", "LOGGING_STREAM_POLL_INTERVAL",
 		}
 		for _, env := range envVars {
 			os.Unsetenv(env)
@@ -109,6 +122,10 @@ func TestLoad_WithEnvironmentVariables(t *testing.T) {
 	if cfg.Logging.MaxLogLimit != 5000 {
 		t.Errorf("Expected max log limit 5000 from env, got %d", cfg.Logging.MaxLogLimit)
 	}
+
+	if cfg.Logging.StreamPollInterval != 5*time.Second {
+		t.Errorf("Expected log stream poll interval 5s from env, got %v", cfg.Logging.StreamPollInterval)
+	}
 }
 
 func TestValidate(t *testing.T) {
@@ -132,11 +149,37 @@ func TestValidate(t *testing.T) {
 					Timeout: 30 * time.Second,
 				},
 				Logging: LoggingConfig{
-					MaxLogLimit: 1000,
+					MaxLogLimit:             1000,
+					StreamPollInterval:      2 * time.Second,
+					StreamHeartbeatInterval: 15 * time.Second,
+					StreamBatchLimit:        500,
 				},
 			},
 			expectErr: false,
 		},
+		{
+			name: "invalid log stream batch limit - above max log limit",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Prometheus: PrometheusConfig{
+					Address: "http://localhost:9090",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					MaxLogLimit:             1000,
+					StreamPollInterval:      2 * time.Second,
+					StreamHeartbeatInterval: 15 * time.Second,
+					StreamBatchLimit:        2000,
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "invalid port - too low",
 			config: Config{
@@ -33,10 +33,12 @@ const (
 	ErrorMsgComponentIDRequired     = "Component ID is required"
 	ErrorMsgProjectIDRequired       = "Project ID is required"
 	ErrorMsgOrganizationIDRequired  = "Organization ID is required"
+	ErrorMsgEnvironmentIDRequired   = "Environment ID is required"
 	ErrorMsgInvalidRequestFormat    = "Invalid request format"
 	ErrorMsgFailedToRetrieveLogs    = "Failed to retrieve logs"
 	ErrorMsgFailedToRetrieveMetrics = "Failed to retrieve metrics"
 	ErrorMsgInvalidTimeFormat       = "Invalid time format"
+	ErrorMsgInvalidCursor           = "Invalid cursor"
 )
 
 // Handler contains the HTTP handlers for the logging API
@@ -0,0 +1,218 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+const (
+	defaultTailLines = 100
+
+	// Server-Sent Events sent by the log stream
+	logStreamEventLog   = "log"
+	logStreamEventError = "error"
+)
+
+// StreamComponentLogs handles GET /api/logs/component/{componentId}/stream
+//
+// The logs of the component are streamed as Server-Sent Events as they are written, starting with the
+// latest tailLines logs. Every log event carries a cursor as its event ID, which resumes the stream after
+// that log when sent back in the Last-Event-ID header or the cursor query parameter.
+func (h *Handler) StreamComponentLogs(w http.ResponseWriter, r *http.Request) {
+	componentID := httputil.GetPathParam(r, "componentId")
+	if componentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgComponentIDRequired)
+		return
+	}
+
+	query := r.URL.Query()
+	environmentID := query.Get("environmentId")
+	if environmentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgEnvironmentIDRequired)
+		return
+	}
+
+	tailLines := defaultTailLines
+	if value := query.Get("tailLines"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err == nil && parsed != 0 {
+			err = validateLimit(parsed)
+		}
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest,
+				fmt.Sprintf("Invalid tailLines: %s", value))
+			return
+		}
+		tailLines = parsed
+	}
+
+	var cursor *opensearch.LogCursor
+	// Browsers send the ID of the last received event when an EventSource reconnects
+	rawCursor := r.Header.Get("Last-Event-ID")
+	if rawCursor == "" {
+		rawCursor = query.Get("cursor")
+	}
+	if rawCursor != "" {
+		parsed, err := opensearch.ParseLogCursor(rawCursor)
+		if err != nil {
+			h.logger.Error("Failed to parse log cursor", "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidCursor)
+			return
+		}
+		cursor = parsed
+	}
+
+	params := opensearch.ComponentQueryParams{
+		QueryParams: opensearch.QueryParams{
+			SearchPhrase:  query.Get("searchPhrase"),
+			LogLevels:     queryValues(query["logLevels"]),
+			Limit:         tailLines,
+			ComponentID:   componentID,
+			EnvironmentID: environmentID,
+			Namespace:     query.Get("namespace"),
+			Versions:      queryValues(query["versions"]),
+			VersionIDs:    queryValues(query["versionIds"]),
+			LogType:       labels.QueryParamLogTypeRuntime,
+		},
+	}
+
+	rc := http.NewResponseController(w)
+	// The stream outlives the write timeout of the server, which would otherwise close it
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.logger.Error("Failed to clear write deadline", "error", err)
+	}
+
+	pollInterval, heartbeatInterval := h.service.LogStreamIntervals()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Keep reverse proxies such as NGINX from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	stream := &eventStream{w: w, rc: rc}
+	if err := stream.retry(pollInterval); err != nil {
+		h.logger.Debug("Log stream closed", "error", err)
+		return
+	}
+
+	ctx := r.Context()
+	poll := func() error {
+		for {
+			batch, err := h.service.TailComponentLogs(ctx, params, cursor)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				h.logger.Error("Failed to poll component logs", "error", err)
+				// Report the failure and keep the stream open, the next poll retries from the same cursor
+				return stream.send(logStreamEventError, "", ErrorResponse{
+					Error:   ErrorTypeInternalError,
+					Code:    ErrorCodeInternalError,
+					Message: ErrorMsgFailedToRetrieveLogs,
+				})
+			}
+			for _, entry := range batch.Logs {
+				if err := stream.send(logStreamEventLog, entry.Cursor, entry); err != nil {
+					return err
+				}
+			}
+			cursor = batch.Cursor
+			if !batch.HasMore {
+				return nil
+			}
+		}
+	}
+
+	if err := poll(); err != nil {
+		h.logger.Debug("Log stream closed", "error", err)
+		return
+	}
+
+	pollTicker := time.NewTicker(pollInterval)
+	defer pollTicker.Stop()
+	heartbeatTicker := time.NewTicker(heartbeatInterval)
+	defer heartbeatTicker.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case <-pollTicker.C:
+			err = poll()
+		case <-heartbeatTicker.C:
+			err = stream.comment("heartbeat")
+		}
+		if err != nil {
+			h.logger.Debug("Log stream closed", "error", err)
+			return
+		}
+	}
+}
+
+// queryValues returns the values of a repeated query parameter, also splitting comma separated values
+func queryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
+
+// eventStream writes Server-Sent Events, flushing each of them to the client
+type eventStream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// send writes an event with JSON data
+func (s *eventStream) send(event, id string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	// JSON escapes line breaks, so the payload always fits on a single data line
+	var b strings.Builder
+	fmt.Fprintf(&b, "event: %s\n", event)
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	fmt.Fprintf(&b, "data: %s\n\n", payload)
+	return s.write(b.String())
+}
+
+// comment writes a comment, which clients ignore but which keeps idle connections alive
+func (s *eventStream) comment(text string) error {
+	return s.write(": " + text + "\n\n")
+}
+
+// retry tells the client how long to wait before reconnecting when the connection drops
+func (s *eventStream) retry(interval time.Duration) error {
+	return s.write(fmt.Sprintf("retry: %d\n\n", interval.Milliseconds()))
+}
+
+func (s *eventStream) write(data string) error {
+	if _, err := fmt.Fprint(s.w, data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
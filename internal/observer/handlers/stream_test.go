@@ -0,0 +1,341 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+)
+
+// fakeSearchResult is the outcome of a single search of fakeOpenSearchClient
+type fakeSearchResult struct {
+	hits []opensearch.Hit
+	err  error
+}
+
+// fakeOpenSearchClient returns the given results in order, and no logs once they are used up
+type fakeOpenSearchClient struct {
+	mu      sync.Mutex
+	results []fakeSearchResult
+	queries []map[string]interface{}
+}
+
+func (c *fakeOpenSearchClient) Search(_ context.Context, _ []string, query map[string]interface{}) (*opensearch.SearchResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queries = append(c.queries, query)
+	response := &opensearch.SearchResponse{}
+	if len(c.results) == 0 {
+		return response, nil
+	}
+	result := c.results[0]
+	c.results = c.results[1:]
+	if result.err != nil {
+		return nil, result.err
+	}
+	response.Hits.Total.Value = len(result.hits)
+	response.Hits.Hits = result.hits
+	return response, nil
+}
+
+func (c *fakeOpenSearchClient) GetIndexMapping(context.Context, string) (*opensearch.MappingResponse, error) {
+	return &opensearch.MappingResponse{}, nil
+}
+
+func (c *fakeOpenSearchClient) HealthCheck(context.Context) error {
+	return nil
+}
+
+func (c *fakeOpenSearchClient) firstQuery(t *testing.T) map[string]interface{} {
+	t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.queries) == 0 {
+		t.Fatal("Expected the logs to be searched")
+	}
+	return c.queries[0]
+}
+
+func newStreamHit(id string, timestamp time.Time, log string) opensearch.Hit {
+	return opensearch.Hit{
+		ID: id,
+		Source: map[string]interface{}{
+			"@timestamp": timestamp.Format(time.RFC3339Nano),
+			"log":        log,
+		},
+		Sort: []interface{}{float64(timestamp.UnixMilli()), id},
+	}
+}
+
+// sseEvent is an event or a comment read from a Server-Sent Events stream
+type sseEvent struct {
+	event   string
+	id      string
+	data    string
+	comment string
+}
+
+// startLogStream serves the log stream of a handler backed by the client and opens a stream of the component
+func startLogStream(t *testing.T, osClient *fakeOpenSearchClient, pollInterval, heartbeatInterval time.Duration,
+	header http.Header) (*http.Response, <-chan sseEvent) {
+	t.Helper()
+	cfg := &config.Config{
+		OpenSearch: config.OpenSearchConfig{IndexPrefix: "container-logs-"},
+		Logging: config.LoggingConfig{
+			MaxLogLimit:             10000,
+			DefaultLogLimit:         100,
+			StreamPollInterval:      pollInterval,
+			StreamHeartbeatInterval: heartbeatInterval,
+			StreamBatchLimit:        500,
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := NewHandler(service.NewLoggingService(osClient, nil, cfg, logger), logger)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/logs/component/{componentId}/stream", handler.StreamComponentLogs)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		server.URL+"/api/logs/component/comp-123/stream?environmentId=env-456&tailLines=2", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("Failed to open log stream: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	events := make(chan sseEvent)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(resp.Body)
+		var event sseEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+				event = sseEvent{}
+			case strings.HasPrefix(line, ": "):
+				event.comment = strings.TrimPrefix(line, ": ")
+			case strings.HasPrefix(line, "event: "):
+				event.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "id: "):
+				event.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return resp, events
+}
+
+// nextEvent returns the next event of the stream, skipping the retry interval and heartbeats unless wanted
+func nextEvent(t *testing.T, events <-chan sseEvent, skipHeartbeats bool) sseEvent {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatal("Expected the log stream to stay open")
+			}
+			if event.event == "" && (event.comment == "" || skipHeartbeats) {
+				continue
+			}
+			return event
+		case <-timeout:
+			t.Fatal("Timed out waiting for an event of the log stream")
+		}
+	}
+}
+
+func TestStreamComponentLogs(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("streams the latest logs with their cursors", func(t *testing.T) {
+		osClient := &fakeOpenSearchClient{results: []fakeSearchResult{{hits: []opensearch.Hit{
+			newStreamHit("doc-2", now.Add(-time.Minute), "ERROR: second"),
+			newStreamHit("doc-1", now.Add(-2*time.Minute), "INFO: first"),
+		}}}}
+		resp, events := startLogStream(t, osClient, time.Hour, time.Hour, nil)
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+		}
+		if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+			t.Errorf("Expected an event stream, got content type %q", contentType)
+		}
+
+		for _, want := range []struct{ id, log string }{{"doc-1", "INFO: first"}, {"doc-2", "ERROR: second"}} {
+			event := nextEvent(t, events, true)
+			if event.event != logStreamEventLog {
+				t.Fatalf("Expected a log event, got %+v", event)
+			}
+			var entry service.StreamedLog
+			if err := json.Unmarshal([]byte(event.data), &entry); err != nil {
+				t.Fatalf("Failed to decode log event: %v", err)
+			}
+			if entry.Log != want.log {
+				t.Errorf("Expected log %q, got %q", want.log, entry.Log)
+			}
+			cursor, err := opensearch.ParseLogCursor(event.id)
+			if err != nil || cursor.ID != want.id {
+				t.Errorf("Expected the event ID to be the cursor of %s, got %q", want.id, event.id)
+			}
+			if entry.Cursor != event.id {
+				t.Errorf("Expected the cursor of the log to match the event ID")
+			}
+		}
+
+		query := osClient.firstQuery(t)
+		if _, ok := query["search_after"]; ok {
+			t.Error("Expected no search_after without a cursor")
+		}
+		if query["size"] != 2 {
+			t.Errorf("Expected the latest tailLines logs, got size %v", query["size"])
+		}
+	})
+
+	t.Run("resumes after the Last-Event-ID", func(t *testing.T) {
+		cursor := &opensearch.LogCursor{Timestamp: now.Add(-time.Minute).UnixMilli(), ID: "doc-2"}
+		osClient := &fakeOpenSearchClient{results: []fakeSearchResult{{hits: []opensearch.Hit{
+			newStreamHit("doc-3", now.Add(-30*time.Second), "INFO: third"),
+		}}}}
+		_, events := startLogStream(t, osClient, time.Hour, time.Hour, http.Header{"Last-Event-ID": {cursor.Encode()}})
+
+		event := nextEvent(t, events, true)
+		if event.event != logStreamEventLog || !strings.Contains(event.data, "INFO: third") {
+			t.Fatalf("Expected the log after the cursor, got %+v", event)
+		}
+
+		query := osClient.firstQuery(t)
+		if !reflect.DeepEqual(query["search_after"], cursor.SearchAfter()) {
+			t.Errorf("Expected search_after %v, got %v", cursor.SearchAfter(), query["search_after"])
+		}
+	})
+
+	t.Run("sends heartbeats while no logs are written", func(t *testing.T) {
+		osClient := &fakeOpenSearchClient{}
+		_, events := startLogStream(t, osClient, time.Hour, 10*time.Millisecond, nil)
+
+		event := nextEvent(t, events, false)
+		if event.comment != "heartbeat" {
+			t.Errorf("Expected a heartbeat, got %+v", event)
+		}
+	})
+
+	t.Run("reports failed polls and keeps streaming", func(t *testing.T) {
+		osClient := &fakeOpenSearchClient{results: []fakeSearchResult{
+			{err: errors.New("opensearch unavailable")},
+			{hits: []opensearch.Hit{newStreamHit("doc-1", now.Add(-time.Minute), "INFO: first")}},
+		}}
+		_, events := startLogStream(t, osClient, 10*time.Millisecond, time.Hour, nil)
+
+		event := nextEvent(t, events, true)
+		if event.event != logStreamEventError {
+			t.Fatalf("Expected an error event, got %+v", event)
+		}
+		var errorResponse ErrorResponse
+		if err := json.Unmarshal([]byte(event.data), &errorResponse); err != nil {
+			t.Fatalf("Failed to decode error event: %v", err)
+		}
+		if errorResponse.Code != ErrorCodeInternalError || errorResponse.Message != ErrorMsgFailedToRetrieveLogs {
+			t.Errorf("Unexpected error event %+v", errorResponse)
+		}
+		if strings.Contains(event.data, "opensearch unavailable") {
+			t.Error("Expected the error event not to expose the cause of the failure")
+		}
+
+		event = nextEvent(t, events, true)
+		if event.event != logStreamEventLog || !strings.Contains(event.data, "INFO: first") {
+			t.Errorf("Expected the logs of the next poll, got %+v", event)
+		}
+	})
+}
+
+func TestStreamComponentLogsInvalidRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		header  http.Header
+		wantMsg string
+	}{
+		{
+			name:    "missing environment",
+			target:  "/api/logs/component/comp-123/stream",
+			wantMsg: ErrorMsgEnvironmentIDRequired,
+		},
+		{
+			name:    "invalid tailLines",
+			target:  "/api/logs/component/comp-123/stream?environmentId=env-456&tailLines=-1",
+			wantMsg: "Invalid tailLines: -1",
+		},
+		{
+			name:    "invalid Last-Event-ID",
+			target:  "/api/logs/component/comp-123/stream?environmentId=env-456",
+			header:  http.Header{"Last-Event-ID": {"not-a-cursor"}},
+			wantMsg: ErrorMsgInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			handler := NewHandler(service.NewLoggingService(&fakeOpenSearchClient{}, nil, &config.Config{}, logger), logger)
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/logs/component/{componentId}/stream", handler.StreamComponentLogs)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			for key, values := range tt.header {
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("Expected status 400, got %d", rec.Code)
+			}
+			var errorResponse ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &errorResponse); err != nil {
+				t.Fatalf("Failed to decode error response: %v", err)
+			}
+			if errorResponse.Message != tt.wantMsg {
+				t.Errorf("Expected message %q, got %q", tt.wantMsg, errorResponse.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearch
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LogCursor marks the position of a log in a stream of logs sorted by BuildComponentLogsTailQuery.
+// Clients receive it as an opaque string and send it back to resume the stream after that log.
+type LogCursor struct {
+	// Timestamp is the @timestamp sort value of the log in epoch milliseconds
+	Timestamp int64 `json:"t"`
+	// ID is the document ID of the log, which orders logs with the same timestamp
+	ID string `json:"id"`
+}
+
+// NewLogCursor creates a cursor from the sort values of a hit of a tail query.
+func NewLogCursor(hit Hit) (*LogCursor, error) {
+	if len(hit.Sort) != 2 {
+		return nil, fmt.Errorf("expected 2 sort values for hit %q, got %d", hit.ID, len(hit.Sort))
+	}
+
+	var timestamp int64
+	switch v := hit.Sort[0].(type) {
+	case float64:
+		timestamp = int64(v)
+	case int64:
+		timestamp = v
+	case int:
+		timestamp = int64(v)
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp sort value for hit %q: %w", hit.ID, err)
+		}
+		timestamp = n
+	default:
+		return nil, fmt.Errorf("invalid timestamp sort value for hit %q: %v", hit.ID, hit.Sort[0])
+	}
+
+	id, ok := hit.Sort[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid ID sort value for hit %q: %v", hit.ID, hit.Sort[1])
+	}
+
+	return &LogCursor{Timestamp: timestamp, ID: id}, nil
+}
+
+// NewLogCursorAt creates a cursor positioned before every log written after the given time.
+func NewLogCursorAt(t time.Time) *LogCursor {
+	return &LogCursor{Timestamp: t.UnixMilli()}
+}
+
+// ParseLogCursor decodes a cursor previously encoded with Encode.
+func ParseLogCursor(s string) (*LogCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding: %w", err)
+	}
+
+	cursor := &LogCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if cursor.Timestamp <= 0 {
+		return nil, fmt.Errorf("invalid cursor timestamp: %d", cursor.Timestamp)
+	}
+
+	return cursor, nil
+}
+
+// Encode returns the opaque string form of the cursor.
+func (c *LogCursor) Encode() string {
+	// Marshalling a struct of an integer and a string cannot fail
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Time returns the timestamp of the cursor.
+func (c *LogCursor) Time() time.Time {
+	return time.UnixMilli(c.Timestamp).UTC()
+}
+
+// SearchAfter returns the search_after values that page past the cursor.
+func (c *LogCursor) SearchAfter() []interface{} {
+	return []interface{}{c.Timestamp, c.ID}
+}
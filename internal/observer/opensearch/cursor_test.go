@@ -0,0 +1,91 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNewLogCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		sort      []interface{}
+		want      LogCursor
+		expectErr bool
+	}{
+		{
+			name: "float timestamp",
+			sort: []interface{}{float64(1704067200123), "doc-1"},
+			want: LogCursor{Timestamp: 1704067200123, ID: "doc-1"},
+		},
+		{
+			name: "json number timestamp",
+			sort: []interface{}{json.Number("1704067200123"), "doc-2"},
+			want: LogCursor{Timestamp: 1704067200123, ID: "doc-2"},
+		},
+		{
+			name:      "missing tiebreaker",
+			sort:      []interface{}{float64(1704067200123)},
+			expectErr: true,
+		},
+		{
+			name:      "invalid timestamp",
+			sort:      []interface{}{"2024-01-01", "doc-1"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid tiebreaker",
+			sort:      []interface{}{float64(1704067200123), 42},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := NewLogCursor(Hit{ID: "doc", Sort: tt.sort})
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected error but got cursor %v", cursor)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *cursor != tt.want {
+				t.Errorf("Expected cursor %v, got %v", tt.want, *cursor)
+			}
+		})
+	}
+}
+
+func TestLogCursor_EncodeAndParse(t *testing.T) {
+	cursor := &LogCursor{Timestamp: 1704067200123, ID: "doc-1"}
+
+	parsed, err := ParseLogCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *parsed != *cursor {
+		t.Errorf("Expected cursor %v, got %v", *cursor, *parsed)
+	}
+
+	expectedTime := time.Date(2024, 1, 1, 0, 0, 0, 123*int(time.Millisecond), time.UTC)
+	if !parsed.Time().Equal(expectedTime) {
+		t.Errorf("Expected time %v, got %v", expectedTime, parsed.Time())
+	}
+
+	searchAfter := parsed.SearchAfter()
+	if len(searchAfter) != 2 || searchAfter[0] != int64(1704067200123) || searchAfter[1] != "doc-1" {
+		t.Errorf("Unexpected search_after values %v", searchAfter)
+	}
+
+	for _, invalid := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+		if _, err := ParseLogCursor(invalid); err == nil {
+			t.Errorf("Expected error parsing cursor %q", invalid)
+		}
+	}
+}
@@ -193,6 +193,30 @@ func (qb *QueryBuilder) BuildComponentLogsQuery(params ComponentQueryParams) map
 	return query
 }
 
+// BuildComponentLogsTailQuery builds a component logs query that can be paged through with search_after.
+// Hits are sorted on the timestamp with the document ID as a tiebreaker, so that the sort values of the
+// last hit can be passed as searchAfter to fetch the logs after it without gaps or duplicates, even when
+// several logs share the same timestamp.
+func (qb *QueryBuilder) BuildComponentLogsTailQuery(params ComponentQueryParams, searchAfter []interface{}) map[string]interface{} {
+	query := qb.BuildComponentLogsQuery(params)
+	query["sort"] = []map[string]interface{}{
+		{
+			"@timestamp": map[string]interface{}{
+				"order": params.SortOrder,
+			},
+		},
+		{
+			"_id": map[string]interface{}{
+				"order": params.SortOrder,
+			},
+		},
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+	return query
+}
+
 // BuildProjectLogsQuery builds a query for project logs with wildcard search
 func (qb *QueryBuilder) BuildProjectLogsQuery(params QueryParams, componentIDs []string) map[string]interface{} {
 	mustConditions := []map[string]interface{}{
@@ -505,3 +505,58 @@ func TestQueryBuilder_BuildComponentTracesQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBuilder_BuildComponentLogsTailQuery(t *testing.T) {
+	qb := NewQueryBuilder("container-logs-")
+
+	params := ComponentQueryParams{
+		QueryParams: QueryParams{
+			StartTime:     "2024-01-01T00:00:00.000Z",
+			EndTime:       "2024-01-01T00:05:00.000Z",
+			ComponentID:   "component-123",
+			EnvironmentID: "env-456",
+			LogLevels:     []string{"ERROR"},
+			Limit:         500,
+			SortOrder:     "asc",
+			LogType:       labels.QueryParamLogTypeRuntime,
+		},
+	}
+	searchAfter := []interface{}{int64(1704067200000), "doc-1"}
+
+	query := qb.BuildComponentLogsTailQuery(params, searchAfter)
+
+	sort, ok := query["sort"].([]map[string]interface{})
+	if !ok || len(sort) != 2 {
+		t.Fatalf("Expected 2 sort fields, got %v", query["sort"])
+	}
+	if _, ok := sort[0]["@timestamp"]; !ok {
+		t.Errorf("Expected the first sort field to be @timestamp, got %v", sort[0])
+	}
+	if _, ok := sort[1]["_id"]; !ok {
+		t.Errorf("Expected the tiebreaker sort field to be _id, got %v", sort[1])
+	}
+	for _, field := range sort {
+		for name, value := range field {
+			if order := value.(map[string]interface{})["order"]; order != "asc" {
+				t.Errorf("Expected %s to be sorted asc, got %v", name, order)
+			}
+		}
+	}
+
+	after, ok := query["search_after"].([]interface{})
+	if !ok || len(after) != 2 || after[0] != int64(1704067200000) || after[1] != "doc-1" {
+		t.Errorf("Expected search_after %v, got %v", searchAfter, query["search_after"])
+	}
+
+	// Filters are shared with the component logs query
+	mustConditions := query["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"].([]map[string]interface{})
+	if len(mustConditions) != 4 {
+		t.Errorf("Expected 4 must conditions, got %d", len(mustConditions))
+	}
+
+	// The first page of a stream has no search_after
+	query = qb.BuildComponentLogsTailQuery(params, nil)
+	if _, ok := query["search_after"]; ok {
+		t.Error("Expected no search_after without a cursor")
+	}
+}
@@ -27,8 +27,11 @@ type SearchResponse struct {
 
 // Hit represents a single search result hit
 type Hit struct {
+	ID     string                 `json:"_id"`
 	Source map[string]interface{} `json:"_source"`
 	Score  *float64               `json:"_score"`
+	// Sort holds the sort values of the hit, which are used as search_after values to page past it
+	Sort []interface{} `json:"sort,omitempty"`
 }
 
 // MappingResponse represents the response from an index mapping query
@@ -21,9 +21,11 @@ type MockOpenSearchClient struct {
 	searchResponse *opensearch.SearchResponse
 	searchError    error
 	healthError    error
+	lastQuery      map[string]interface{}
 }
 
 func (m *MockOpenSearchClient) Search(ctx context.Context, indices []string, query map[string]interface{}) (*opensearch.SearchResponse, error) {
+	m.lastQuery = query
 	if m.searchError != nil {
 		return nil, m.searchError
 	}
@@ -44,8 +46,9 @@ func newMockLoggingService() *LoggingService {
 			IndexPrefix: "container-logs-",
 		},
 		Logging: config.LoggingConfig{
-			MaxLogLimit:     10000,
-			DefaultLogLimit: 100,
+			MaxLogLimit:      10000,
+			DefaultLogLimit:  100,
+			StreamBatchLimit: 500,
 		},
 	}
 
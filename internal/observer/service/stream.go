@@ -0,0 +1,114 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+const (
+	// logTailLookback bounds how far back the latest logs are searched when a stream starts without a cursor
+	logTailLookback = 24 * time.Hour
+	// logStreamTimeFormat keeps the millisecond precision of the cursors in the time range of the queries
+	logStreamTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// StreamedLog is a log entry of a live log stream along with the cursor to resume the stream after it
+type StreamedLog struct {
+	opensearch.LogEntry
+	Cursor string `json:"cursor"`
+}
+
+// LogStreamBatch is the set of logs returned by a single poll of a live log stream
+type LogStreamBatch struct {
+	Logs []StreamedLog
+	// Cursor is the position to continue the stream from with the next poll
+	Cursor *opensearch.LogCursor
+	// HasMore is set when the batch was cut off by the batch limit and more logs are already available
+	HasMore bool
+}
+
+// LogStreamIntervals returns how often a live log stream polls for new logs and sends heartbeats
+func (s *LoggingService) LogStreamIntervals() (pollInterval, heartbeatInterval time.Duration) {
+	return s.config.Logging.StreamPollInterval, s.config.Logging.StreamHeartbeatInterval
+}
+
+// TailComponentLogs polls the logs of a component that were written after the cursor, oldest first.
+// Without a cursor, the stream starts with the latest params.Limit logs. Logs newer than the configured
+// ingestion delay are left for a later poll, as logs are not always indexed in the order they are written.
+func (s *LoggingService) TailComponentLogs(ctx context.Context, params opensearch.ComponentQueryParams,
+	cursor *opensearch.LogCursor) (*LogStreamBatch, error) {
+	end := time.Now().UTC().Add(-s.config.Logging.StreamIngestionDelay)
+
+	var searchAfter []interface{}
+	if cursor == nil {
+		if params.Limit <= 0 {
+			return &LogStreamBatch{Cursor: opensearch.NewLogCursorAt(end)}, nil
+		}
+		// Search backwards for the latest logs, which are reversed into stream order below
+		params.SortOrder = "desc"
+		if params.StartTime == "" {
+			params.StartTime = end.Add(-logTailLookback).Format(logStreamTimeFormat)
+		}
+	} else {
+		if !end.After(cursor.Time()) {
+			return &LogStreamBatch{Cursor: cursor}, nil
+		}
+		params.SortOrder = "asc"
+		params.Limit = s.config.Logging.StreamBatchLimit
+		// The time range only narrows down the searched documents, search_after skips the ones already sent
+		params.StartTime = cursor.Time().Add(-time.Millisecond).Format(logStreamTimeFormat)
+		searchAfter = cursor.SearchAfter()
+	}
+	params.EndTime = end.Format(logStreamTimeFormat)
+
+	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	query := s.queryBuilder.BuildComponentLogsTailQuery(params, searchAfter)
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	hits := response.Hits.Hits
+	if cursor == nil {
+		hits = slices.Clone(hits)
+		slices.Reverse(hits)
+	}
+
+	batch := &LogStreamBatch{
+		Logs:    make([]StreamedLog, 0, len(hits)),
+		Cursor:  cursor,
+		HasMore: cursor != nil && len(hits) >= params.Limit,
+	}
+	for _, hit := range hits {
+		hitCursor, err := opensearch.NewLogCursor(hit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cursor: %w", err)
+		}
+		batch.Logs = append(batch.Logs, StreamedLog{
+			LogEntry: opensearch.ParseLogEntry(hit),
+			Cursor:   hitCursor.Encode(),
+		})
+		batch.Cursor = hitCursor
+	}
+	if batch.Cursor == nil {
+		batch.Cursor = opensearch.NewLogCursorAt(end)
+	}
+
+	s.logger.Debug("Component logs polled",
+		"component_id", params.ComponentID,
+		"environment_id", params.EnvironmentID,
+		"count", len(batch.Logs))
+
+	return batch, nil
+}
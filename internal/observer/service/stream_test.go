@@ -0,0 +1,147 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+func newStreamSearchResponse(hits ...opensearch.Hit) *opensearch.SearchResponse {
+	response := &opensearch.SearchResponse{}
+	response.Hits.Total.Value = len(hits)
+	response.Hits.Hits = hits
+	return response
+}
+
+func newStreamHit(id string, timestamp time.Time, log string) opensearch.Hit {
+	return opensearch.Hit{
+		ID: id,
+		Source: map[string]interface{}{
+			"@timestamp": timestamp.Format(time.RFC3339Nano),
+			"log":        log,
+		},
+		Sort: []interface{}{float64(timestamp.UnixMilli()), id},
+	}
+}
+
+func TestLoggingService_TailComponentLogs(t *testing.T) {
+	params := opensearch.ComponentQueryParams{
+		QueryParams: opensearch.QueryParams{
+			ComponentID:   "comp-123",
+			EnvironmentID: "env-456",
+			Limit:         2,
+			LogType:       labels.QueryParamLogTypeRuntime,
+		},
+	}
+	now := time.Now().UTC()
+
+	t.Run("starts with the latest logs in stream order", func(t *testing.T) {
+		service := newMockLoggingService()
+		mockClient := &MockOpenSearchClient{
+			searchResponse: newStreamSearchResponse(
+				newStreamHit("doc-2", now.Add(-time.Minute), "ERROR: second"),
+				newStreamHit("doc-1", now.Add(-2*time.Minute), "INFO: first"),
+			),
+		}
+		service.osClient = mockClient
+
+		batch, err := service.TailComponentLogs(context.Background(), params, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(batch.Logs) != 2 || batch.Logs[0].Log != "INFO: first" || batch.Logs[1].Log != "ERROR: second" {
+			t.Fatalf("Expected the latest logs oldest first, got %+v", batch.Logs)
+		}
+		if batch.Cursor.ID != "doc-2" {
+			t.Errorf("Expected the cursor to point at the latest log, got %+v", batch.Cursor)
+		}
+		if batch.Logs[1].Cursor != batch.Cursor.Encode() {
+			t.Errorf("Expected the cursor of the last log to match the batch cursor")
+		}
+		if batch.HasMore {
+			t.Error("Expected no more logs after the initial batch")
+		}
+		if _, ok := mockClient.lastQuery["search_after"]; ok {
+			t.Error("Expected no search_after without a cursor")
+		}
+		if mockClient.lastQuery["size"] != 2 {
+			t.Errorf("Expected size 2, got %v", mockClient.lastQuery["size"])
+		}
+	})
+
+	t.Run("continues after the cursor", func(t *testing.T) {
+		service := newMockLoggingService()
+		service.config.Logging.StreamBatchLimit = 1
+		mockClient := &MockOpenSearchClient{
+			searchResponse: newStreamSearchResponse(
+				newStreamHit("doc-3", now.Add(-30*time.Second), "INFO: third"),
+			),
+		}
+		service.osClient = mockClient
+		cursor := &opensearch.LogCursor{Timestamp: now.Add(-time.Minute).UnixMilli(), ID: "doc-2"}
+
+		batch, err := service.TailComponentLogs(context.Background(), params, cursor)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(batch.Logs) != 1 || batch.Cursor.ID != "doc-3" {
+			t.Fatalf("Expected the log after the cursor, got %+v", batch)
+		}
+		searchAfter, ok := mockClient.lastQuery["search_after"].([]interface{})
+		if !ok || searchAfter[1] != "doc-2" {
+			t.Errorf("Expected search_after the cursor, got %v", mockClient.lastQuery["search_after"])
+		}
+		if mockClient.lastQuery["size"] != service.config.Logging.StreamBatchLimit {
+			t.Errorf("Expected the batch limit as size, got %v", mockClient.lastQuery["size"])
+		}
+		if !batch.HasMore {
+			t.Error("Expected more logs when the batch limit is reached")
+		}
+	})
+
+	t.Run("keeps the cursor without new logs", func(t *testing.T) {
+		service := newMockLoggingService()
+		service.osClient = &MockOpenSearchClient{searchResponse: newStreamSearchResponse()}
+		cursor := &opensearch.LogCursor{Timestamp: now.Add(-time.Minute).UnixMilli(), ID: "doc-2"}
+
+		batch, err := service.TailComponentLogs(context.Background(), params, cursor)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(batch.Logs) != 0 || batch.Cursor != cursor {
+			t.Errorf("Expected an empty batch at the same cursor, got %+v", batch)
+		}
+	})
+
+	t.Run("waits for the ingestion delay", func(t *testing.T) {
+		service := newMockLoggingService()
+		service.config.Logging.StreamIngestionDelay = time.Minute
+		service.osClient = &MockOpenSearchClient{searchError: &mockError{message: "unexpected search"}}
+		cursor := &opensearch.LogCursor{Timestamp: now.Add(-30 * time.Second).UnixMilli(), ID: "doc-2"}
+
+		batch, err := service.TailComponentLogs(context.Background(), params, cursor)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(batch.Logs) != 0 || batch.Cursor != cursor {
+			t.Errorf("Expected an empty batch at the same cursor, got %+v", batch)
+		}
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		service := newMockLoggingService()
+		service.osClient = &MockOpenSearchClient{searchError: &mockError{message: "search failed"}}
+
+		if _, err := service.TailComponentLogs(context.Background(), params, nil); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -6,6 +6,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strings"
 
@@ -332,6 +333,11 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusBadRequest, "Invalid promotion path", services.CodeInvalidPromotionPath)
 			return
 		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Promotion requires approval", "source", req.SourceEnvironment, "target", req.TargetEnvironment)
+			writeErrorResponse(w, http.StatusForbidden, "Promotion to the target environment requires approval; create a promotion request instead", services.CodePromotionApprovalRequired)
+			return
+		}
 		if errors.Is(err, services.ErrReleaseBindingNotFound) {
 			logger.Warn("Source release binding not found", "org", orgName, "project", projectName, "component", componentName, "environment", req.SourceEnvironment)
 			writeErrorResponse(w, http.StatusNotFound, "Source release binding not found", services.CodeReleaseBindingNotFound)
@@ -664,6 +670,70 @@ func (h *Handler) GetComponentReleaseSchema(w http.ResponseWriter, r *http.Reque
 	writeSuccessResponse(w, http.StatusOK, schema)
 }
 
+func (h *Handler) DiffComponentReleases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DiffComponentReleases handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	releaseName := r.PathValue("releaseName")
+	if orgName == "" || projectName == "" || componentName == "" || releaseName == "" {
+		logger.Warn("Organization name, project name, component name, and release name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and release name are required", services.CodeInvalidInput)
+		return
+	}
+
+	againstRelease := r.URL.Query().Get("against")
+	environmentName := r.URL.Query().Get("environment")
+	if againstRelease == "" || environmentName == "" {
+		logger.Warn("Query parameters 'against' and 'environment' are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Query parameters 'against' and 'environment' are required", services.CodeInvalidInput)
+		return
+	}
+
+	diff, err := h.services.ComponentService.DiffComponentReleases(ctx, orgName, projectName, componentName, releaseName, againstRelease, environmentName)
+	if err != nil {
+		if errors.Is(err, services.ErrProjectNotFound) {
+			logger.Warn("Project not found", "org", orgName, "project", projectName)
+			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentNotFound) {
+			logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "project", projectName, "component", componentName, "release", releaseName, "against", againstRelease)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			logger.Warn("Environment not found", "org", orgName, "environment", environmentName)
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrDataPlaneNotFound) {
+			logger.Warn("DataPlane not found", "org", orgName, "environment", environmentName)
+			writeErrorResponse(w, http.StatusNotFound, "DataPlane not found", services.CodeDataPlaneNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseRenderFailed) {
+			logger.Warn("Failed to render component release", "error", err)
+			writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error(), services.CodeReleaseRenderFailed)
+			return
+		}
+		logger.Error("Failed to diff component releases", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Diffed component releases successfully", "org", orgName, "project", projectName, "component", componentName, "release", releaseName, "against", againstRelease, "environment", environmentName)
+	writeSuccessResponse(w, http.StatusOK, diff)
+}
+
 func (h *Handler) GetEnvironmentRelease(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.GetLogger(ctx)
@@ -729,6 +799,12 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid release binding patch", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	binding, err := h.services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req)
 	if err != nil {
 		if errors.Is(err, services.ErrProjectNotFound) {
@@ -746,6 +822,16 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrInvalidParameters) {
+			logger.Warn("Invalid release binding overrides", "error", err)
+			writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidParameters)
+			return
+		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Release requires approval for the environment", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusForbidden, "The release has not been approved for the environment; create a promotion request instead", services.CodePromotionApprovalRequired)
+			return
+		}
 		logger.Error("Failed to patch release binding", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -755,6 +841,63 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 	writeSuccessResponse(w, http.StatusOK, binding)
 }
 
+// TraceReleaseBinding renders a ReleaseBinding with template tracing enabled to debug its rendered resources
+func (h *Handler) TraceReleaseBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("TraceReleaseBinding handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	bindingName := r.PathValue("bindingName")
+	if orgName == "" || projectName == "" || componentName == "" || bindingName == "" {
+		logger.Warn("Organization name, project name, component name, and binding name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and binding name are required", services.CodeInvalidInput)
+		return
+	}
+
+	trace, err := h.services.ComponentService.TraceReleaseBinding(ctx, orgName, projectName, componentName, bindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrProjectNotFound) {
+			logger.Warn("Project not found", "org", orgName, "project", projectName)
+			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentNotFound) {
+			logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingNotFound) {
+			logger.Warn("Release binding not found", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			logger.Warn("Environment not found", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrDataPlaneNotFound) {
+			logger.Warn("DataPlane not found", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "DataPlane not found", services.CodeDataPlaneNotFound)
+			return
+		}
+		logger.Error("Failed to trace release binding", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Traced release binding successfully", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+	writeSuccessResponse(w, http.StatusOK, trace)
+}
+
 func (h *Handler) ListReleaseBindings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.GetLogger(ctx)
@@ -846,3 +989,75 @@ func (h *Handler) DeployRelease(w http.ResponseWriter, r *http.Request) {
 	logger.Debug("Deployed release successfully", "org", orgName, "project", projectName, "component", componentName, "release", req.ReleaseName, "environment", binding.Environment)
 	writeSuccessResponse(w, http.StatusCreated, binding)
 }
+
+func (h *Handler) RollbackReleaseBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("RollbackReleaseBinding handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	environmentName := r.PathValue("environmentName")
+	if orgName == "" || projectName == "" || componentName == "" || environmentName == "" {
+		logger.Warn("Organization name, project name, component name, and environment name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and environment name are required", "INVALID_PARAMS")
+		return
+	}
+
+	// The request body is optional; without a release name the environment is rolled back to the previous release
+	var req models.RollbackReleaseBindingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+
+	binding, err := h.services.ComponentService.RollbackReleaseBinding(ctx, orgName, projectName, componentName, environmentName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrProjectNotFound) {
+			logger.Warn("Project not found", "org", orgName, "project", projectName)
+			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentNotFound) {
+			logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingNotFound) {
+			logger.Warn("Release binding not found", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName)
+			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "project", projectName, "component", componentName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrNoPreviousRelease) {
+			logger.Warn("No previous release to roll back to", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName)
+			writeErrorResponse(w, http.StatusConflict, "No previous release to roll back to", services.CodeNoPreviousRelease)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseAlreadyBound) {
+			logger.Warn("Release is already bound", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusConflict, "Release is already bound to the environment", services.CodeReleaseAlreadyBound)
+			return
+		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Release requires approval for the environment", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusForbidden, "The release has not been approved for the environment; create a promotion request instead", services.CodePromotionApprovalRequired)
+			return
+		}
+		logger.Error("Failed to roll back release binding", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Rolled back release binding successfully", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName, "release", binding.ReleaseName)
+	writeSuccessResponse(w, http.StatusOK, binding)
+}
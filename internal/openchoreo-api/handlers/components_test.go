@@ -601,3 +601,173 @@ func TestGetComponentReleaseSchema_MissingPathParameters(t *testing.T) {
 		})
 	}
 }
+
+// TestDiffComponentReleases_MissingParameters tests that DiffComponentReleases rejects requests
+// without the required path and query parameters before calling the service
+func TestDiffComponentReleases_MissingParameters(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		releaseName string
+	}{
+		{
+			name:        "Missing against query parameter",
+			url:         "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/component-releases/myrelease-v2/diff?environment=production",
+			releaseName: "myrelease-v2",
+		},
+		{
+			name:        "Missing environment query parameter",
+			url:         "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/component-releases/myrelease-v2/diff?against=myrelease-v1",
+			releaseName: "myrelease-v2",
+		},
+		{
+			name:        "Missing release name",
+			url:         "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/component-releases//diff?against=myrelease-v1&environment=production",
+			releaseName: "",
+		},
+	}
+
+	h := &Handler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req.SetPathValue("orgName", "myorg")
+			req.SetPathValue("projectName", "myproject")
+			req.SetPathValue("componentName", "mycomponent")
+			req.SetPathValue("releaseName", tt.releaseName)
+			rec := httptest.NewRecorder()
+
+			h.DiffComponentReleases(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+// TestTraceReleaseBinding_MissingBindingName tests that TraceReleaseBinding rejects requests
+// without a binding name before calling the service
+func TestTraceReleaseBinding_MissingBindingName(t *testing.T) {
+	h := &Handler{}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/release-bindings//trace", nil)
+	req.SetPathValue("orgName", "myorg")
+	req.SetPathValue("projectName", "myproject")
+	req.SetPathValue("componentName", "mycomponent")
+	req.SetPathValue("bindingName", "")
+	rec := httptest.NewRecorder()
+
+	h.TraceReleaseBinding(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+// TestRollbackReleaseBinding_InvalidRequests tests that RollbackReleaseBinding rejects requests
+// with missing path parameters or malformed bodies before calling the service
+func TestRollbackReleaseBinding_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name            string
+		environmentName string
+		body            string
+	}{
+		{
+			name:            "Missing environment name",
+			environmentName: "",
+			body:            `{}`,
+		},
+		{
+			name:            "Malformed body",
+			environmentName: "production",
+			body:            `{"releaseName":`,
+		},
+	}
+
+	h := &Handler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/environments/" + tt.environmentName + "/rollback"
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(tt.body))
+			req.SetPathValue("orgName", "myorg")
+			req.SetPathValue("projectName", "myproject")
+			req.SetPathValue("componentName", "mycomponent")
+			req.SetPathValue("environmentName", tt.environmentName)
+			rec := httptest.NewRecorder()
+
+			h.RollbackReleaseBinding(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+// TestCreatePromotionRequest_InvalidRequests tests that CreatePromotionRequest rejects requests
+// without source and target environments before calling the service
+func TestCreatePromotionRequest_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "Missing target environment",
+			body: `{"sourceEnv":"development"}`,
+		},
+		{
+			name: "Blank source environment",
+			body: `{"sourceEnv":"  ","targetEnv":"production"}`,
+		},
+		{
+			name: "Malformed body",
+			body: `{"sourceEnv":`,
+		},
+	}
+
+	h := &Handler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/promotion-requests",
+				bytes.NewBufferString(tt.body))
+			req.SetPathValue("orgName", "myorg")
+			req.SetPathValue("projectName", "myproject")
+			req.SetPathValue("componentName", "mycomponent")
+			rec := httptest.NewRecorder()
+
+			h.CreatePromotionRequest(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+// TestDecidePromotionRequest_RequiresSubject tests that promotion requests cannot be approved or
+// rejected without an authenticated subject
+func TestDecidePromotionRequest_RequiresSubject(t *testing.T) {
+	h := &Handler{}
+	handlers := map[string]http.HandlerFunc{
+		"approve": h.ApprovePromotionRequest,
+		"reject":  h.RejectPromotionRequest,
+	}
+
+	for action, handler := range handlers {
+		t.Run(action, func(t *testing.T) {
+			url := "/api/v1/orgs/myorg/projects/myproject/components/mycomponent/promotion-requests/mycomponent-production-abcde/" + action
+			req := httptest.NewRequest(http.MethodPost, url, nil)
+			req.SetPathValue("orgName", "myorg")
+			req.SetPathValue("projectName", "myproject")
+			req.SetPathValue("componentName", "mycomponent")
+			req.SetPathValue("requestName", "mycomponent-production-abcde")
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
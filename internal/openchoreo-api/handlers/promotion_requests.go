@@ -0,0 +1,215 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+// CreatePromotionRequest requests approval to promote a component into an environment that requires approval
+func (h *Handler) CreatePromotionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("CreatePromotionRequest handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization name, project name, and component name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, and component name are required", "INVALID_PARAMS")
+		return
+	}
+
+	var req models.CreatePromotionRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+
+	if req.SourceEnvironment == "" || req.TargetEnvironment == "" {
+		logger.Warn("Source and target environments are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Source and target environments are required", services.CodeInvalidInput)
+		return
+	}
+
+	// The requester is recorded for the audit trail; it is not available when authentication is disabled
+	requestedBy, _ := jwt.GetSubject(r)
+
+	promotionRequest, err := h.services.ComponentService.CreatePromotionRequest(ctx, orgName, projectName, componentName, requestedBy, &req)
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to create promotion request")
+		return
+	}
+
+	logger.Debug("Created promotion request successfully", "org", orgName, "project", projectName, "component", componentName,
+		"name", promotionRequest.Name)
+	writeSuccessResponse(w, http.StatusCreated, promotionRequest)
+}
+
+// ListPromotionRequests lists the promotion requests of a component
+func (h *Handler) ListPromotionRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListPromotionRequests handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization name, project name, and component name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, and component name are required", "INVALID_PARAMS")
+		return
+	}
+
+	requests, err := h.services.ComponentService.ListPromotionRequests(ctx, orgName, projectName, componentName)
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to list promotion requests")
+		return
+	}
+
+	logger.Debug("Listed promotion requests successfully", "org", orgName, "project", projectName, "component", componentName, "count", len(requests))
+	writeListResponse(w, requests, len(requests), 1, len(requests))
+}
+
+// GetPromotionRequest retrieves a promotion request of a component
+func (h *Handler) GetPromotionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetPromotionRequest handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	requestName := r.PathValue("requestName")
+	if orgName == "" || projectName == "" || componentName == "" || requestName == "" {
+		logger.Warn("Organization name, project name, component name, and promotion request name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and promotion request name are required", "INVALID_PARAMS")
+		return
+	}
+
+	promotionRequest, err := h.services.ComponentService.GetPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to get promotion request")
+		return
+	}
+
+	writeSuccessResponse(w, http.StatusOK, promotionRequest)
+}
+
+// ApprovePromotionRequest approves a pending promotion request. The JWT subject of the caller must be
+// an approver of the target environment.
+func (h *Handler) ApprovePromotionRequest(w http.ResponseWriter, r *http.Request) {
+	h.decidePromotionRequest(w, r, true)
+}
+
+// RejectPromotionRequest rejects a pending promotion request. The JWT subject of the caller must be
+// an approver of the target environment.
+func (h *Handler) RejectPromotionRequest(w http.ResponseWriter, r *http.Request) {
+	h.decidePromotionRequest(w, r, false)
+}
+
+func (h *Handler) decidePromotionRequest(w http.ResponseWriter, r *http.Request, approve bool) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("Promotion decision handler called", "approve", approve)
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	requestName := r.PathValue("requestName")
+	if orgName == "" || projectName == "" || componentName == "" || requestName == "" {
+		logger.Warn("Organization name, project name, component name, and promotion request name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and promotion request name are required", "INVALID_PARAMS")
+		return
+	}
+
+	approver, ok := jwt.GetSubject(r)
+	if !ok || approver == "" {
+		logger.Warn("Authenticated subject is required to decide on a promotion request")
+		writeErrorResponse(w, http.StatusUnauthorized, "Authenticated subject is required to decide on a promotion request", "UNAUTHORIZED")
+		return
+	}
+
+	// The request body is optional; it only carries the approver's comment
+	var req models.PromotionDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+
+	var (
+		promotionRequest *models.PromotionRequestResponse
+		err              error
+	)
+	if approve {
+		promotionRequest, err = h.services.ComponentService.ApprovePromotionRequest(ctx, orgName, projectName, componentName, requestName, approver, &req)
+	} else {
+		promotionRequest, err = h.services.ComponentService.RejectPromotionRequest(ctx, orgName, projectName, componentName, requestName, approver, &req)
+	}
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to decide on promotion request")
+		return
+	}
+
+	logger.Debug("Decided on promotion request successfully", "org", orgName, "project", projectName, "component", componentName,
+		"name", requestName, "phase", promotionRequest.Phase, "approver", approver)
+	writeSuccessResponse(w, http.StatusOK, promotionRequest)
+}
+
+// writePromotionRequestError maps promotion request service errors to API error responses
+func writePromotionRequestError(w http.ResponseWriter, logger *slog.Logger, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrProjectNotFound):
+		logger.Warn("Project not found", "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+	case errors.Is(err, services.ErrComponentNotFound):
+		logger.Warn("Component not found", "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+	case errors.Is(err, services.ErrDeploymentPipelineNotFound):
+		logger.Warn("Deployment pipeline not found", "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Deployment pipeline not found", services.CodeDeploymentPipelineNotFound)
+	case errors.Is(err, services.ErrInvalidPromotionPath):
+		logger.Warn("Invalid promotion path", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid promotion path", services.CodeInvalidPromotionPath)
+	case errors.Is(err, services.ErrApprovalNotRequired):
+		logger.Warn("Promotion does not require approval", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Promotion to the target environment does not require approval", services.CodeApprovalNotRequired)
+	case errors.Is(err, services.ErrReleaseBindingNotFound):
+		logger.Warn("Source release binding not found", "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Source release binding not found", services.CodeReleaseBindingNotFound)
+	case errors.Is(err, services.ErrComponentReleaseNotFound):
+		logger.Warn("Component release not found", "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+	case errors.Is(err, services.ErrPromotionRequestNotFound):
+		logger.Warn("Promotion request not found", "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Promotion request not found", services.CodePromotionRequestNotFound)
+	case errors.Is(err, services.ErrPromotionRequestNotPending):
+		logger.Warn("Promotion request is not pending", "error", err)
+		writeErrorResponse(w, http.StatusConflict, "Promotion request has already been decided", services.CodePromotionRequestNotPending)
+	case errors.Is(err, services.ErrApproverNotAllowed):
+		logger.Warn("Subject is not allowed to approve the promotion", "error", err)
+		writeErrorResponse(w, http.StatusForbidden, "Not allowed to approve promotions to the target environment", services.CodeApproverNotAllowed)
+	default:
+		logger.Error(message, "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+	}
+}
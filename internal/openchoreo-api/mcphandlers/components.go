@@ -80,6 +80,10 @@ func (h *MCPHandler) GetComponentRelease(ctx context.Context, orgName, projectNa
 	return h.Services.ComponentService.GetComponentRelease(ctx, orgName, projectName, componentName, releaseName)
 }
 
+func (h *MCPHandler) DiffComponentReleases(ctx context.Context, orgName, projectName, componentName, releaseName, againstRelease, environment string) (any, error) {
+	return h.Services.ComponentService.DiffComponentReleases(ctx, orgName, projectName, componentName, releaseName, againstRelease, environment)
+}
+
 func (h *MCPHandler) ListReleaseBindings(ctx context.Context, orgName, projectName, componentName string, environments []string) (any, error) {
 	bindings, err := h.Services.ComponentService.ListReleaseBindings(ctx, orgName, projectName, componentName, environments)
 	if err != nil {
@@ -108,6 +112,10 @@ func (h *MCPHandler) PromoteComponent(ctx context.Context, orgName, projectName,
 	return binding, err
 }
 
+func (h *MCPHandler) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, environmentName string, req *models.RollbackReleaseBindingRequest) (any, error) {
+	return h.Services.ComponentService.RollbackReleaseBinding(ctx, orgName, projectName, componentName, environmentName, req)
+}
+
 func (h *MCPHandler) CreateWorkload(ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{}) (any, error) {
 	// Convert interface{} to WorkloadSpec
 	workloadSpecBytes, err := json.Marshal(workloadSpec)
@@ -5,7 +5,9 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -73,6 +75,39 @@ func (req *DeployReleaseRequest) Validate() error {
 	return nil
 }
 
+// RollbackReleaseBindingRequest represents the request to roll back the release bound to an environment.
+// When ReleaseName is empty, the environment is rolled back to the previously bound release.
+type RollbackReleaseBindingRequest struct {
+	ReleaseName string `json:"releaseName,omitempty"`
+}
+
+// Sanitize sanitizes the RollbackReleaseBindingRequest by trimming whitespace
+func (req *RollbackReleaseBindingRequest) Sanitize() {
+	req.ReleaseName = strings.TrimSpace(req.ReleaseName)
+}
+
+// CreatePromotionRequestRequest represents the request to ask for approval of a promotion
+type CreatePromotionRequestRequest struct {
+	SourceEnvironment string `json:"sourceEnv"`
+	TargetEnvironment string `json:"targetEnv"`
+}
+
+// Sanitize sanitizes the CreatePromotionRequestRequest by trimming whitespace
+func (req *CreatePromotionRequestRequest) Sanitize() {
+	req.SourceEnvironment = strings.TrimSpace(req.SourceEnvironment)
+	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
+}
+
+// PromotionDecisionRequest represents the request to approve or reject a promotion request
+type PromotionDecisionRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// Sanitize sanitizes the PromotionDecisionRequest by trimming whitespace
+func (req *PromotionDecisionRequest) Sanitize() {
+	req.Comment = strings.TrimSpace(req.Comment)
+}
+
 // CreateEnvironmentRequest represents the request to create a new environment
 type CreateEnvironmentRequest struct {
 	Name         string `json:"name"`
@@ -235,6 +270,57 @@ type PatchReleaseBindingRequest struct {
 	// These values override the workload specification for this specific environment
 	// +optional
 	WorkloadOverrides *WorkloadOverrides `json:"workloadOverrides,omitempty"`
+
+	// RolloutStrategy controls how a newly bound release replaces the running release.
+	// An empty strategy removes the rollout strategy from the binding.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// Validate validates the PatchReleaseBindingRequest
+func (req *PatchReleaseBindingRequest) Validate() error {
+	if req.RolloutStrategy == nil || req.RolloutStrategy.Canary == nil {
+		return nil
+	}
+
+	canary := req.RolloutStrategy.Canary
+	if len(canary.Steps) == 0 {
+		return errors.New("canary rollout requires at least one step")
+	}
+	for i, step := range canary.Steps {
+		if step.Weight < 1 || step.Weight > 100 {
+			return fmt.Errorf("canary step %d: weight must be between 1 and 100", i+1)
+		}
+		if _, err := time.ParseDuration(step.Pause); err != nil {
+			return fmt.Errorf("canary step %d: invalid pause %q", i+1, step.Pause)
+		}
+	}
+	if canary.Analysis != nil && (canary.Analysis.MaxErrorRate < 0 || canary.Analysis.MaxErrorRate > 100) {
+		return errors.New("canary analysis: maxErrorRate must be between 0 and 100")
+	}
+	return nil
+}
+
+// RolloutStrategy defines how a newly bound release is rolled out to the environment
+type RolloutStrategy struct {
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+}
+
+// CanaryStrategy defines a progressive canary rollout
+type CanaryStrategy struct {
+	Steps    []CanaryStep    `json:"steps"`
+	Analysis *CanaryAnalysis `json:"analysis,omitempty"`
+}
+
+// CanaryStep defines the traffic weight of the canary and how long it is kept, e.g. "5m"
+type CanaryStep struct {
+	Weight int32  `json:"weight"`
+	Pause  string `json:"pause"`
+}
+
+// CanaryAnalysis defines the thresholds the canary is analyzed against at the end of each step
+type CanaryAnalysis struct {
+	MaxErrorRate int32 `json:"maxErrorRate"`
 }
 
 // WorkloadOverrides represents environment-specific workload overrides
@@ -314,3 +314,76 @@ func TestPatchReleaseBindingRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchReleaseBindingRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		canary  *CanaryStrategy
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "Valid canary",
+			canary: &CanaryStrategy{
+				Steps:    []CanaryStep{{Weight: 10, Pause: "5m"}, {Weight: 50, Pause: "10m"}},
+				Analysis: &CanaryAnalysis{MaxErrorRate: 5},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "No canary",
+			canary:  nil,
+			wantErr: false,
+		},
+		{
+			name:    "No steps",
+			canary:  &CanaryStrategy{},
+			wantErr: true,
+			errMsg:  "canary rollout requires at least one step",
+		},
+		{
+			name:    "Invalid weight",
+			canary:  &CanaryStrategy{Steps: []CanaryStep{{Weight: 0, Pause: "5m"}}},
+			wantErr: true,
+			errMsg:  "canary step 1: weight must be between 1 and 100",
+		},
+		{
+			name:    "Invalid pause",
+			canary:  &CanaryStrategy{Steps: []CanaryStep{{Weight: 10, Pause: "5m"}, {Weight: 20, Pause: "soon"}}},
+			wantErr: true,
+			errMsg:  `canary step 2: invalid pause "soon"`,
+		},
+		{
+			name: "Invalid max error rate",
+			canary: &CanaryStrategy{
+				Steps:    []CanaryStep{{Weight: 10, Pause: "5m"}},
+				Analysis: &CanaryAnalysis{MaxErrorRate: 101},
+			},
+			wantErr: true,
+			errMsg:  "canary analysis: maxErrorRate must be between 0 and 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &PatchReleaseBindingRequest{
+				RolloutStrategy: &RolloutStrategy{Canary: tt.canary},
+			}
+			err := req.Validate()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Validate() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("Validate() error = %v, want %v", err.Error(), tt.errMsg)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+			}
+		})
+	}
+}
@@ -139,9 +139,10 @@ type PromotionPath struct {
 
 // TargetEnvironmentRef represents a target environment reference with approval settings
 type TargetEnvironmentRef struct {
-	Name                     string `json:"name"`
-	RequiresApproval         bool   `json:"requiresApproval,omitempty"`
-	IsManualApprovalRequired bool   `json:"isManualApprovalRequired,omitempty"`
+	Name                     string   `json:"name"`
+	RequiresApproval         bool     `json:"requiresApproval,omitempty"`
+	IsManualApprovalRequired bool     `json:"isManualApprovalRequired,omitempty"`
+	Approvers                []string `json:"approvers,omitempty"`
 }
 
 // OrganizationResponse represents an organization in API responses
@@ -170,34 +171,61 @@ type EnvironmentResponse struct {
 
 // DataPlaneResponse represents a dataplane in API responses
 type DataPlaneResponse struct {
-	Name                    string    `json:"name"`
-	Namespace               string    `json:"namespace"`
-	DisplayName             string    `json:"displayName,omitempty"`
-	Description             string    `json:"description,omitempty"`
-	ImagePullSecretRefs     []string  `json:"imagePullSecretRefs,omitempty"`
-	SecretStoreRef          string    `json:"secretStoreRef,omitempty"`
-	KubernetesClusterName   string    `json:"kubernetesClusterName"`
-	APIServerURL            string    `json:"apiServerURL"`
-	PublicVirtualHost       string    `json:"publicVirtualHost"`
-	OrganizationVirtualHost string    `json:"organizationVirtualHost"`
-	ObserverURL             string    `json:"observerURL,omitempty"`
-	ObserverUsername        string    `json:"observerUsername,omitempty"`
-	CreatedAt               time.Time `json:"createdAt"`
-	Status                  string    `json:"status,omitempty"`
+	Name                    string                 `json:"name"`
+	Namespace               string                 `json:"namespace"`
+	DisplayName             string                 `json:"displayName,omitempty"`
+	Description             string                 `json:"description,omitempty"`
+	ImagePullSecretRefs     []string               `json:"imagePullSecretRefs,omitempty"`
+	SecretStoreRef          string                 `json:"secretStoreRef,omitempty"`
+	KubernetesClusterName   string                 `json:"kubernetesClusterName"`
+	APIServerURL            string                 `json:"apiServerURL"`
+	PublicVirtualHost       string                 `json:"publicVirtualHost"`
+	OrganizationVirtualHost string                 `json:"organizationVirtualHost"`
+	ObserverURL             string                 `json:"observerURL,omitempty"`
+	ObserverUsername        string                 `json:"observerUsername,omitempty"`
+	CreatedAt               time.Time              `json:"createdAt"`
+	Status                  string                 `json:"status,omitempty"`
+	Cluster                 *ClusterStatusResponse `json:"cluster,omitempty"`
+	Conditions              []ConditionResponse    `json:"conditions,omitempty"`
 }
 
 // BuildPlaneResponse represents a buildplane in API responses
 type BuildPlaneResponse struct {
-	Name                  string    `json:"name"`
-	Namespace             string    `json:"namespace"`
-	DisplayName           string    `json:"displayName,omitempty"`
-	Description           string    `json:"description,omitempty"`
-	KubernetesClusterName string    `json:"kubernetesClusterName"`
-	APIServerURL          string    `json:"apiServerURL"`
-	ObserverURL           string    `json:"observerURL,omitempty"`
-	ObserverUsername      string    `json:"observerUsername,omitempty"`
-	CreatedAt             time.Time `json:"createdAt"`
-	Status                string    `json:"status,omitempty"`
+	Name                  string                 `json:"name"`
+	Namespace             string                 `json:"namespace"`
+	DisplayName           string                 `json:"displayName,omitempty"`
+	Description           string                 `json:"description,omitempty"`
+	KubernetesClusterName string                 `json:"kubernetesClusterName"`
+	APIServerURL          string                 `json:"apiServerURL"`
+	ObserverURL           string                 `json:"observerURL,omitempty"`
+	ObserverUsername      string                 `json:"observerUsername,omitempty"`
+	CreatedAt             time.Time              `json:"createdAt"`
+	Status                string                 `json:"status,omitempty"`
+	Cluster               *ClusterStatusResponse `json:"cluster,omitempty"`
+	Conditions            []ConditionResponse    `json:"conditions,omitempty"`
+}
+
+// ClusterStatusResponse represents the probed state of a dataplane or buildplane cluster
+type ClusterStatusResponse struct {
+	ServerVersion string                      `json:"serverVersion,omitempty"`
+	Capabilities  []ClusterCapabilityResponse `json:"capabilities,omitempty"`
+	LastProbeTime *time.Time                  `json:"lastProbeTime,omitempty"`
+}
+
+// ClusterCapabilityResponse represents whether a capability is installed in a cluster
+type ClusterCapabilityResponse struct {
+	Name             string   `json:"name"`
+	Available        bool     `json:"available"`
+	MissingResources []string `json:"missingResources,omitempty"`
+}
+
+// ConditionResponse represents a status condition of a resource
+type ConditionResponse struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
 }
 
 // BuildResponse represents a build in API responses
@@ -289,6 +317,71 @@ type ComponentReleaseResponse struct {
 	Status        string    `json:"status,omitempty"`
 }
 
+// Resource change types used in ComponentReleaseDiffResponse
+const (
+	ResourceChangeAdded   = "added"
+	ResourceChangeRemoved = "removed"
+	ResourceChangeChanged = "changed"
+)
+
+// ComponentReleaseDiffResponse represents the differences between two ComponentReleases
+// rendered for the same environment
+type ComponentReleaseDiffResponse struct {
+	ReleaseName    string              `json:"releaseName"`
+	AgainstRelease string              `json:"againstRelease"`
+	Environment    string              `json:"environment"`
+	Summary        ResourceDiffSummary `json:"summary"`
+	Resources      []ResourceDiff      `json:"resources"`
+}
+
+// ResourceDiffSummary counts the rendered resources by change type
+type ResourceDiffSummary struct {
+	Added     int `json:"added"`
+	Removed   int `json:"removed"`
+	Changed   int `json:"changed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// ResourceDiff represents the difference of a single rendered resource
+type ResourceDiff struct {
+	APIVersion   string        `json:"apiVersion"`
+	Kind         string        `json:"kind"`
+	Name         string        `json:"name"`
+	Namespace    string        `json:"namespace,omitempty"`
+	Change       string        `json:"change"`
+	FieldChanges []FieldChange `json:"fieldChanges,omitempty"`
+}
+
+// FieldChange represents a changed field within a rendered resource.
+// Old is omitted for added fields and New is omitted for removed fields.
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// ReleaseBindingTraceResponse represents the template evaluation trace of rendering a ReleaseBinding.
+// Error is set when rendering failed; the trace then ends at the failing expression.
+type ReleaseBindingTraceResponse struct {
+	BindingName string                   `json:"bindingName"`
+	ReleaseName string                   `json:"releaseName"`
+	Environment string                   `json:"environment"`
+	Resources   []map[string]interface{} `json:"resources,omitempty"`
+	Trace       []TemplateTraceEntry     `json:"trace"`
+	Error       string                   `json:"error,omitempty"`
+}
+
+// TemplateTraceEntry represents the evaluation of a single template expression
+type TemplateTraceEntry struct {
+	Path       string      `json:"path"`
+	Expression string      `json:"expression"`
+	Inputs     []string    `json:"inputs,omitempty"`
+	Result     interface{} `json:"result,omitempty"`
+	Omitted    bool        `json:"omitted,omitempty"`
+	Excluded   bool        `json:"excluded,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
 // ReleaseBindingResponse represents a ReleaseBinding in API responses
 type ReleaseBindingResponse struct {
 	Name                      string                 `json:"name"`
@@ -302,6 +395,57 @@ type ReleaseBindingResponse struct {
 	WorkloadOverrides         *WorkloadOverrides     `json:"workloadOverrides,omitempty"`
 	CreatedAt                 time.Time              `json:"createdAt"`
 	Status                    string                 `json:"status,omitempty"`
+	ReleaseHistory            []ReleaseHistoryEntry  `json:"releaseHistory,omitempty"`
+	Approvals                 []PromotionApproval    `json:"approvals,omitempty"`
+	RolloutStrategy           *RolloutStrategy       `json:"rolloutStrategy,omitempty"`
+	Rollout                   *RolloutStatus         `json:"rollout,omitempty"`
+}
+
+// RolloutStatus represents the progress of a release binding's rollout strategy
+type RolloutStatus struct {
+	Phase         string     `json:"phase"`
+	StableRelease string     `json:"stableRelease"`
+	CanaryRelease string     `json:"canaryRelease,omitempty"`
+	CurrentStep   int32      `json:"currentStep"`
+	TotalSteps    int        `json:"totalSteps"`
+	CurrentWeight int32      `json:"currentWeight"`
+	StepStartedAt *time.Time `json:"stepStartedAt,omitempty"`
+	ErrorRate     string     `json:"errorRate,omitempty"`
+	Message       string     `json:"message,omitempty"`
+}
+
+// ReleaseHistoryEntry represents a release that was bound to an environment
+type ReleaseHistoryEntry struct {
+	ReleaseName string    `json:"releaseName"`
+	BoundAt     time.Time `json:"boundAt"`
+}
+
+// PromotionApproval represents an approved promotion recorded on a release binding
+type PromotionApproval struct {
+	PromotionRequest  string    `json:"promotionRequest"`
+	ReleaseName       string    `json:"releaseName"`
+	SourceEnvironment string    `json:"sourceEnvironment"`
+	RequestedBy       string    `json:"requestedBy,omitempty"`
+	ApprovedBy        string    `json:"approvedBy"`
+	ApprovedAt        time.Time `json:"approvedAt"`
+	Comment           string    `json:"comment,omitempty"`
+}
+
+// PromotionRequestResponse represents a request to promote a component into an environment that requires approval
+type PromotionRequestResponse struct {
+	Name              string     `json:"name"`
+	ComponentName     string     `json:"componentName"`
+	ProjectName       string     `json:"projectName"`
+	OrgName           string     `json:"orgName"`
+	SourceEnvironment string     `json:"sourceEnvironment"`
+	TargetEnvironment string     `json:"targetEnvironment"`
+	ReleaseName       string     `json:"releaseName"`
+	RequestedBy       string     `json:"requestedBy,omitempty"`
+	Phase             string     `json:"phase"`
+	DecidedBy         string     `json:"decidedBy,omitempty"`
+	DecidedAt         *time.Time `json:"decidedAt,omitempty"`
+	Comment           string     `json:"comment,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
 }
 
 // ReleaseResponse represents a Release in API responses
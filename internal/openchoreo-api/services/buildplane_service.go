@@ -68,9 +68,10 @@ func (s *BuildPlaneService) GetBuildPlaneClient(ctx context.Context, orgName str
 	}
 
 	buildPlaneClient, err := kubernetesClient.GetK8sClient(
+		ctx,
 		s.bpClientMgr,
-		orgName,
-		buildPlane.Name,
+		s.k8sClient,
+		buildPlane,
 		buildPlane.Spec.KubernetesCluster,
 	)
 	if err != nil {
@@ -102,9 +103,6 @@ func (s *BuildPlaneService) ListBuildPlanes(ctx context.Context, orgName string)
 		displayName := buildPlane.Annotations[controller.AnnotationKeyDisplayName]
 		description := buildPlane.Annotations[controller.AnnotationKeyDescription]
 
-		// Determine status from conditions
-		status := ""
-
 		// Extract observer information if available
 		observerURL := ""
 		observerUsername := ""
@@ -123,7 +121,9 @@ func (s *BuildPlaneService) ListBuildPlanes(ctx context.Context, orgName string)
 			ObserverURL:           observerURL,
 			ObserverUsername:      observerUsername,
 			CreatedAt:             buildPlane.CreationTimestamp.Time,
-			Status:                status,
+			Status:                planeStatus(buildPlane.Status.Conditions),
+			Cluster:               toClusterStatusResponse(buildPlane.Status.Cluster),
+			Conditions:            toConditionResponses(buildPlane.Status.Conditions),
 		}
 
 		buildPlaneResponses = append(buildPlaneResponses, buildPlaneResponse)
@@ -0,0 +1,93 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+// validateReleaseBindingOverrides evaluates the schema validation rules of the bound ComponentRelease
+// against the parameters of a ReleaseBinding with its overrides applied, so that overrides which would
+// fail rendering are rejected before they are stored.
+// Bindings without a release, or bound to a release that does not exist yet, are not validated here;
+// the ReleaseBinding controller reports those once the release is available.
+func (s *ComponentService) validateReleaseBindingOverrides(ctx context.Context, orgName, componentName string, binding *openchoreov1alpha1.ReleaseBinding) error {
+	if binding.Spec.ReleaseName == "" {
+		return nil
+	}
+
+	release, err := s.getOwnedComponentRelease(ctx, orgName, componentName, binding.Spec.ReleaseName)
+	if err != nil {
+		if errors.Is(err, ErrComponentReleaseNotFound) {
+			s.logger.Debug("Skipping override validation, release not found", "org", orgName, "release", binding.Spec.ReleaseName)
+			return nil
+		}
+		return err
+	}
+
+	errs, err := validateOverridesAgainstRelease(release, binding)
+	if err != nil {
+		s.logger.Error("Failed to validate release binding overrides", "error", err, "release", release.Name)
+		return fmt.Errorf("failed to validate release binding overrides: %w", err)
+	}
+	if len(errs) > 0 {
+		s.logger.Warn("Release binding overrides do not satisfy the schema validation rules", "binding", binding.Name, "errors", errs.ToAggregate().Error())
+		return fmt.Errorf("%w: %s", ErrInvalidParameters, errs.ToAggregate())
+	}
+	return nil
+}
+
+// validateOverridesAgainstRelease evaluates the schema validation rules of the ComponentType and Traits
+// of a release against the release parameters merged with the overrides of the binding.
+// Errors are rooted at componentTypeEnvOverrides and traitOverrides[instanceName] respectively.
+func validateOverridesAgainstRelease(release *openchoreov1alpha1.ComponentRelease, binding *openchoreov1alpha1.ReleaseBinding) (field.ErrorList, error) {
+	ctSchema := release.Spec.ComponentType.Schema
+	allErrs, err := pipelinecontext.ValidateParameterRules(
+		field.NewPath("componentTypeEnvOverrides"),
+		&pipelinecontext.SchemaInput{
+			Types:              ctSchema.Types,
+			ParametersSchema:   ctSchema.Parameters,
+			EnvOverridesSchema: ctSchema.EnvOverrides,
+		},
+		release.Spec.ComponentProfile.Parameters,
+		binding.Spec.ComponentTypeEnvOverrides,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("component type schema: %w", err)
+	}
+
+	for _, instance := range release.Spec.ComponentProfile.Traits {
+		trait, ok := release.Spec.Traits[instance.Name]
+		if !ok {
+			continue
+		}
+		overrides, ok := binding.Spec.TraitOverrides[instance.InstanceName]
+		if !ok {
+			continue
+		}
+
+		errs, err := pipelinecontext.ValidateParameterRules(
+			field.NewPath("traitOverrides").Key(instance.InstanceName),
+			&pipelinecontext.SchemaInput{
+				Types:              trait.Schema.Types,
+				ParametersSchema:   trait.Schema.Parameters,
+				EnvOverridesSchema: trait.Schema.EnvOverrides,
+			},
+			instance.Parameters,
+			&overrides,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("trait %s schema: %w", instance.Name, err)
+		}
+		allErrs = append(allErrs, errs...)
+	}
+	return allErrs, nil
+}
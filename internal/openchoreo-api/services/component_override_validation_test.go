@@ -0,0 +1,99 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestValidateOverridesAgainstRelease(t *testing.T) {
+	release := &v1alpha1.ComponentRelease{
+		Spec: v1alpha1.ComponentReleaseSpec{
+			ComponentType: v1alpha1.ComponentTypeSpec{
+				Schema: v1alpha1.ComponentTypeSchema{
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"minReplicas": "integer | default=1"}`)},
+					EnvOverrides: &runtime.RawExtension{Raw: []byte(`{
+						"maxReplicas": "integer | default=3",
+						"$validations": [{"rule": "self.maxReplicas >= self.minReplicas", "message": "maxReplicas must not be less than minReplicas"}]
+					}`)},
+				},
+			},
+			Traits: map[string]v1alpha1.TraitSpec{
+				"storage": {
+					Schema: v1alpha1.TraitSchema{
+						Parameters: &runtime.RawExtension{Raw: []byte(`{"size": "integer | default=1"}`)},
+						EnvOverrides: &runtime.RawExtension{Raw: []byte(`{
+							"tier": "string | default=standard",
+							"$validations": [{"rule": "self.tier != 'premium' || self.size >= 10", "message": "premium storage needs at least 10Gi"}]
+						}`)},
+					},
+				},
+			},
+			ComponentProfile: v1alpha1.ComponentProfile{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"minReplicas": 2}`)},
+				Traits: []v1alpha1.ComponentTrait{
+					{Name: "storage", InstanceName: "data", Parameters: &runtime.RawExtension{Raw: []byte(`{"size": 5}`)}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		binding    v1alpha1.ReleaseBindingSpec
+		wantErrors []string
+	}{
+		{
+			name: "No overrides",
+		},
+		{
+			name: "Valid overrides",
+			binding: v1alpha1.ReleaseBindingSpec{
+				ComponentTypeEnvOverrides: &runtime.RawExtension{Raw: []byte(`{"maxReplicas": 5}`)},
+				TraitOverrides: map[string]runtime.RawExtension{
+					"data": {Raw: []byte(`{"tier": "standard"}`)},
+				},
+			},
+		},
+		{
+			name: "Component type overrides violate the rules",
+			binding: v1alpha1.ReleaseBindingSpec{
+				ComponentTypeEnvOverrides: &runtime.RawExtension{Raw: []byte(`{"maxReplicas": 1}`)},
+			},
+			wantErrors: []string{"componentTypeEnvOverrides: Invalid value: \"object\": maxReplicas must not be less than minReplicas"},
+		},
+		{
+			name: "Trait overrides violate the rules",
+			binding: v1alpha1.ReleaseBindingSpec{
+				TraitOverrides: map[string]runtime.RawExtension{
+					"data": {Raw: []byte(`{"tier": "premium"}`)},
+				},
+			},
+			wantErrors: []string{"traitOverrides[data]: Invalid value: \"object\": premium storage needs at least 10Gi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := &v1alpha1.ReleaseBinding{Spec: tt.binding}
+			errs, err := validateOverridesAgainstRelease(release, binding)
+			if err != nil {
+				t.Fatalf("validateOverridesAgainstRelease() error = %v", err)
+			}
+			if len(errs) != len(tt.wantErrors) {
+				t.Fatalf("validateOverridesAgainstRelease() returned %d errors, want %d: %v", len(errs), len(tt.wantErrors), errs)
+			}
+			for i, want := range tt.wantErrors {
+				if !strings.HasPrefix(errs[i].Error(), want) {
+					t.Errorf("error[%d] = %q, want prefix %q", i, errs[i].Error(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,426 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// maxPromotionApprovals bounds the approval audit trail kept on a ReleaseBinding
+const maxPromotionApprovals = 10
+
+// CreatePromotionRequest asks for approval to promote a component into an environment that requires approval.
+// The release currently bound to the source environment is captured in the request, so that the release
+// that was reviewed is the one that gets promoted once the request is approved.
+func (s *ComponentService) CreatePromotionRequest(ctx context.Context, orgName, projectName, componentName, requestedBy string,
+	req *models.CreatePromotionRequestRequest) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Creating promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"source", req.SourceEnvironment, "target", req.TargetEnvironment, "requestedBy", requestedBy)
+
+	if _, err := s.getOwnedComponent(ctx, orgName, projectName, componentName); err != nil {
+		return nil, err
+	}
+
+	target, err := s.validatePromotionPath(ctx, orgName, projectName, req.SourceEnvironment, req.TargetEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if !target.ApprovalRequired() {
+		s.logger.Warn("Promotion does not require approval", "source", req.SourceEnvironment, "target", req.TargetEnvironment)
+		return nil, ErrApprovalNotRequired
+	}
+
+	sourceBinding, err := s.getReleaseBinding(ctx, orgName, projectName, componentName, req.SourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	promotionRequest := &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", componentName, req.TargetEnvironment),
+			Namespace:    orgName,
+			Labels: map[string]string{
+				labels.LabelKeyProjectName:     projectName,
+				labels.LabelKeyComponentName:   componentName,
+				labels.LabelKeyEnvironmentName: req.TargetEnvironment,
+			},
+		},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner: openchoreov1alpha1.PromotionRequestOwner{
+				ProjectName:   projectName,
+				ComponentName: componentName,
+			},
+			SourceEnvironment: req.SourceEnvironment,
+			TargetEnvironment: req.TargetEnvironment,
+			ReleaseName:       sourceBinding.Spec.ReleaseName,
+			RequestedBy:       requestedBy,
+		},
+	}
+	if err := s.k8sClient.Create(ctx, promotionRequest); err != nil {
+		s.logger.Error("Failed to create promotion request", "error", err)
+		return nil, fmt.Errorf("failed to create promotion request: %w", err)
+	}
+
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePending
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		s.logger.Error("Failed to update promotion request status", "error", err)
+		return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+
+	s.logger.Info("Created promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", promotionRequest.Name, "release", promotionRequest.Spec.ReleaseName, "target", req.TargetEnvironment)
+	return toPromotionRequestResponse(promotionRequest), nil
+}
+
+// ListPromotionRequests lists the promotion requests of a component
+func (s *ComponentService) ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string) ([]*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Listing promotion requests", "org", orgName, "project", projectName, "component", componentName)
+
+	if _, err := s.getOwnedComponent(ctx, orgName, projectName, componentName); err != nil {
+		return nil, err
+	}
+
+	var requestList openchoreov1alpha1.PromotionRequestList
+	if err := s.k8sClient.List(ctx, &requestList,
+		client.InNamespace(orgName),
+		client.MatchingLabels{
+			labels.LabelKeyProjectName:   projectName,
+			labels.LabelKeyComponentName: componentName,
+		}); err != nil {
+		s.logger.Error("Failed to list promotion requests", "error", err)
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+
+	requests := make([]*models.PromotionRequestResponse, 0, len(requestList.Items))
+	for i := range requestList.Items {
+		item := &requestList.Items[i]
+		if item.Spec.Owner.ProjectName != projectName || item.Spec.Owner.ComponentName != componentName {
+			continue
+		}
+		requests = append(requests, toPromotionRequestResponse(item))
+	}
+
+	return requests, nil
+}
+
+// GetPromotionRequest retrieves a promotion request of a component
+func (s *ComponentService) GetPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Getting promotion request", "org", orgName, "project", projectName, "component", componentName, "name", requestName)
+
+	if _, err := s.getOwnedComponent(ctx, orgName, projectName, componentName); err != nil {
+		return nil, err
+	}
+
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPromotionRequestResponse(promotionRequest), nil
+}
+
+// ApprovePromotionRequest approves a pending promotion request and binds the requested release to the
+// target environment. The approver must be listed in the approvers of the target environment in the
+// deployment pipeline. The approval is recorded in the audit trail of the target ReleaseBinding.
+func (s *ComponentService) ApprovePromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName, approver string,
+	req *models.PromotionDecisionRequest) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Approving promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", requestName, "approver", approver)
+
+	promotionRequest, err := s.getDecidablePromotionRequest(ctx, orgName, projectName, componentName, requestName, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the requested release still exists and belongs to the component
+	if _, err := s.getOwnedComponentRelease(ctx, orgName, componentName, promotionRequest.Spec.ReleaseName); err != nil {
+		return nil, err
+	}
+
+	// The request is approved before the release is bound. The update is guarded by the resource version
+	// of the pending request, so that concurrent approvals cannot both promote the release.
+	now := metav1.Now()
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhaseApproved
+	promotionRequest.Status.DecidedBy = approver
+	promotionRequest.Status.DecidedAt = &now
+	promotionRequest.Status.Comment = req.Comment
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		if apierrors.IsConflict(err) {
+			s.logger.Warn("Promotion request was changed while approving it", "name", requestName)
+			return nil, ErrPromotionRequestNotPending
+		}
+		s.logger.Error("Failed to update promotion request status", "error", err)
+		return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+
+	// Once approved, the release can still be bound to the target environment by rolling back or patching
+	// the binding if binding it fails here
+	promotion := &PromoteComponentPayload{
+		PromoteComponentRequest: models.PromoteComponentRequest{
+			SourceEnvironment: promotionRequest.Spec.SourceEnvironment,
+			TargetEnvironment: promotionRequest.Spec.TargetEnvironment,
+		},
+		ComponentName: componentName,
+		ProjectName:   projectName,
+		OrgName:       orgName,
+	}
+	binding, err := s.createOrUpdateReleaseBinding(ctx, promotion, promotionRequest.Spec.ReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := openchoreov1alpha1.PromotionApproval{
+		PromotionRequest:  promotionRequest.Name,
+		ReleaseName:       promotionRequest.Spec.ReleaseName,
+		SourceEnvironment: promotionRequest.Spec.SourceEnvironment,
+		RequestedBy:       promotionRequest.Spec.RequestedBy,
+		ApprovedBy:        approver,
+		ApprovedAt:        now,
+		Comment:           req.Comment,
+	}
+	if err := s.recordPromotionApproval(ctx, binding, approval); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Approved promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", requestName, "release", promotionRequest.Spec.ReleaseName, "target", promotionRequest.Spec.TargetEnvironment, "approver", approver)
+	return toPromotionRequestResponse(promotionRequest), nil
+}
+
+// RejectPromotionRequest rejects a pending promotion request. Only approvers of the target environment
+// may reject a promotion request.
+func (s *ComponentService) RejectPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName, approver string,
+	req *models.PromotionDecisionRequest) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Rejecting promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", requestName, "approver", approver)
+
+	promotionRequest, err := s.getDecidablePromotionRequest(ctx, orgName, projectName, componentName, requestName, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now()
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhaseRejected
+	promotionRequest.Status.DecidedBy = approver
+	promotionRequest.Status.DecidedAt = &now
+	promotionRequest.Status.Comment = req.Comment
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		s.logger.Error("Failed to update promotion request status", "error", err)
+		return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+
+	s.logger.Info("Rejected promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", requestName, "approver", approver)
+	return toPromotionRequestResponse(promotionRequest), nil
+}
+
+// getDecidablePromotionRequest retrieves a pending promotion request and checks that the approver is allowed
+// to decide on it. The promotion path is validated again since the deployment pipeline may have changed
+// after the request was created.
+func (s *ComponentService) getDecidablePromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName, approver string) (*openchoreov1alpha1.PromotionRequest, error) {
+	if _, err := s.getOwnedComponent(ctx, orgName, projectName, componentName); err != nil {
+		return nil, err
+	}
+
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !promotionRequest.IsPending() {
+		s.logger.Warn("Promotion request is not pending", "name", requestName, "phase", promotionRequest.Status.Phase)
+		return nil, ErrPromotionRequestNotPending
+	}
+
+	target, err := s.validatePromotionPath(ctx, orgName, projectName,
+		promotionRequest.Spec.SourceEnvironment, promotionRequest.Spec.TargetEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.IsApprover(approver) {
+		s.logger.Warn("Subject is not allowed to approve promotion", "name", requestName, "approver", approver,
+			"target", promotionRequest.Spec.TargetEnvironment)
+		return nil, ErrApproverNotAllowed
+	}
+
+	return promotionRequest, nil
+}
+
+// getPromotionRequest retrieves a PromotionRequest CR and verifies that it belongs to the component
+func (s *ComponentService) getPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string) (*openchoreov1alpha1.PromotionRequest, error) {
+	var promotionRequest openchoreov1alpha1.PromotionRequest
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: requestName}, &promotionRequest); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Promotion request not found", "org", orgName, "component", componentName, "name", requestName)
+			return nil, ErrPromotionRequestNotFound
+		}
+		s.logger.Error("Failed to get promotion request", "error", err)
+		return nil, fmt.Errorf("failed to get promotion request: %w", err)
+	}
+
+	if promotionRequest.Spec.Owner.ProjectName != projectName || promotionRequest.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Promotion request does not belong to component", "org", orgName, "component", componentName, "name", requestName)
+		return nil, ErrPromotionRequestNotFound
+	}
+
+	return &promotionRequest, nil
+}
+
+// requireApprovedRelease checks that a release may be bound to an environment outside of a promotion.
+// Environments that require approval for promotions into them only accept releases that were approved
+// through a PromotionRequest or that were bound to the environment before, so that binding a release
+// directly cannot bypass the approval.
+func (s *ComponentService) requireApprovedRelease(ctx context.Context, orgName, projectName, componentName, environment, releaseName string,
+	binding *openchoreov1alpha1.ReleaseBinding) error {
+	gated, err := s.environmentRequiresApproval(ctx, orgName, projectName, environment)
+	if err != nil || !gated {
+		return err
+	}
+
+	if binding != nil {
+		for _, entry := range binding.Status.ReleaseHistory {
+			if entry.ReleaseName == releaseName {
+				return nil
+			}
+		}
+	}
+
+	var requestList openchoreov1alpha1.PromotionRequestList
+	if err := s.k8sClient.List(ctx, &requestList,
+		client.InNamespace(orgName),
+		client.MatchingLabels{
+			labels.LabelKeyProjectName:     projectName,
+			labels.LabelKeyComponentName:   componentName,
+			labels.LabelKeyEnvironmentName: environment,
+		}); err != nil {
+		s.logger.Error("Failed to list promotion requests", "error", err)
+		return fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+	for i := range requestList.Items {
+		item := &requestList.Items[i]
+		if item.Spec.Owner.ProjectName == projectName && item.Spec.Owner.ComponentName == componentName &&
+			item.Spec.TargetEnvironment == environment && item.Spec.ReleaseName == releaseName &&
+			item.Status.Phase == openchoreov1alpha1.PromotionRequestPhaseApproved {
+			return nil
+		}
+	}
+
+	s.logger.Warn("Release was not approved for the environment", "component", componentName, "environment", environment,
+		"release", releaseName)
+	return ErrPromotionApprovalRequired
+}
+
+// environmentRequiresApproval reports whether promotions into the environment require approval in the
+// deployment pipeline of the project. Without a deployment pipeline no environment requires approval.
+func (s *ComponentService) environmentRequiresApproval(ctx context.Context, orgName, projectName, environment string) (bool, error) {
+	pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, projectName)
+	if err != nil {
+		if errors.Is(err, ErrDeploymentPipelineNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, path := range pipeline.Spec.PromotionPaths {
+		for i := range path.TargetEnvironmentRefs {
+			target := &path.TargetEnvironmentRefs[i]
+			if target.Name == environment && target.ApprovalRequired() {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// getOwnedComponent retrieves a Component CR and verifies that it belongs to the project
+func (s *ComponentService) getOwnedComponent(ctx context.Context, orgName, projectName, componentName string) (*openchoreov1alpha1.Component, error) {
+	if _, err := s.projectService.GetProject(ctx, orgName, projectName); err != nil {
+		return nil, err
+	}
+
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			return nil, ErrComponentNotFound
+		}
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	if component.Spec.Owner.ProjectName != projectName {
+		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
+		return nil, ErrComponentNotFound
+	}
+
+	return &component, nil
+}
+
+// recordPromotionApproval appends an approval to the audit trail of the ReleaseBinding.
+// The ReleaseBinding controller updates the binding status as well, so conflicts are retried on a fresh copy.
+func (s *ComponentService) recordPromotionApproval(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding,
+	approval openchoreov1alpha1.PromotionApproval) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &openchoreov1alpha1.ReleaseBinding{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(binding), latest); err != nil {
+			return err
+		}
+		latest.Status.Approvals = appendPromotionApproval(latest.Status.Approvals, approval)
+		return s.k8sClient.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		s.logger.Error("Failed to record promotion approval", "error", err, "binding", binding.Name)
+		return fmt.Errorf("failed to record promotion approval on release binding %s: %w", binding.Name, err)
+	}
+	return nil
+}
+
+// appendPromotionApproval appends an approval to the audit trail, dropping the oldest entries
+// beyond maxPromotionApprovals
+func appendPromotionApproval(approvals []openchoreov1alpha1.PromotionApproval,
+	approval openchoreov1alpha1.PromotionApproval) []openchoreov1alpha1.PromotionApproval {
+	approvals = append(approvals, approval)
+	if len(approvals) > maxPromotionApprovals {
+		approvals = approvals[len(approvals)-maxPromotionApprovals:]
+	}
+	return approvals
+}
+
+// toPromotionRequestResponse converts a PromotionRequest CR to a PromotionRequestResponse
+func toPromotionRequestResponse(promotionRequest *openchoreov1alpha1.PromotionRequest) *models.PromotionRequestResponse {
+	response := &models.PromotionRequestResponse{
+		Name:              promotionRequest.Name,
+		ComponentName:     promotionRequest.Spec.Owner.ComponentName,
+		ProjectName:       promotionRequest.Spec.Owner.ProjectName,
+		OrgName:           promotionRequest.Namespace,
+		SourceEnvironment: promotionRequest.Spec.SourceEnvironment,
+		TargetEnvironment: promotionRequest.Spec.TargetEnvironment,
+		ReleaseName:       promotionRequest.Spec.ReleaseName,
+		RequestedBy:       promotionRequest.Spec.RequestedBy,
+		Phase:             string(promotionRequest.Status.Phase),
+		DecidedBy:         promotionRequest.Status.DecidedBy,
+		Comment:           promotionRequest.Status.Comment,
+		CreatedAt:         promotionRequest.CreationTimestamp.Time,
+	}
+	if response.Phase == "" {
+		response.Phase = string(openchoreov1alpha1.PromotionRequestPhasePending)
+	}
+	if promotionRequest.Status.DecidedAt != nil {
+		decidedAt := promotionRequest.Status.DecidedAt.Time
+		response.DecidedAt = &decidedAt
+	}
+	return response
+}
@@ -0,0 +1,366 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// TestAppendPromotionApproval tests that the approval audit trail is bounded and keeps the newest entries
+func TestAppendPromotionApproval(t *testing.T) {
+	var approvals []v1alpha1.PromotionApproval
+	for i := 0; i < maxPromotionApprovals+3; i++ {
+		approvals = appendPromotionApproval(approvals, v1alpha1.PromotionApproval{
+			PromotionRequest: fmt.Sprintf("app-production-%d", i),
+			ApprovedBy:       "alice",
+		})
+	}
+
+	if len(approvals) != maxPromotionApprovals {
+		t.Fatalf("len(approvals) = %d, want %d", len(approvals), maxPromotionApprovals)
+	}
+	if got, want := approvals[0].PromotionRequest, "app-production-3"; got != want {
+		t.Errorf("oldest approval = %q, want %q", got, want)
+	}
+	if got, want := approvals[len(approvals)-1].PromotionRequest, fmt.Sprintf("app-production-%d", maxPromotionApprovals+2); got != want {
+		t.Errorf("newest approval = %q, want %q", got, want)
+	}
+}
+
+// TestTargetEnvironmentApproval tests which promotion targets are gated and who may approve them
+func TestTargetEnvironmentApproval(t *testing.T) {
+	tests := []struct {
+		name             string
+		target           v1alpha1.TargetEnvironmentRef
+		subject          string
+		wantGated        bool
+		wantApproverPass bool
+	}{
+		{
+			name:             "Ungated environment",
+			target:           v1alpha1.TargetEnvironmentRef{Name: "staging"},
+			subject:          "alice",
+			wantGated:        false,
+			wantApproverPass: false,
+		},
+		{
+			name:             "Listed approver",
+			target:           v1alpha1.TargetEnvironmentRef{Name: "production", RequiresApproval: true, Approvers: []string{"alice", "bob"}},
+			subject:          "bob",
+			wantGated:        true,
+			wantApproverPass: true,
+		},
+		{
+			name:             "Subject not listed",
+			target:           v1alpha1.TargetEnvironmentRef{Name: "production", IsManualApprovalRequired: true, Approvers: []string{"alice"}},
+			subject:          "mallory",
+			wantGated:        true,
+			wantApproverPass: false,
+		},
+		{
+			name:             "No approvers configured",
+			target:           v1alpha1.TargetEnvironmentRef{Name: "production", RequiresApproval: true},
+			subject:          "alice",
+			wantGated:        true,
+			wantApproverPass: false,
+		},
+		{
+			name:             "Empty subject",
+			target:           v1alpha1.TargetEnvironmentRef{Name: "production", RequiresApproval: true, Approvers: []string{""}},
+			subject:          "",
+			wantGated:        true,
+			wantApproverPass: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.target.ApprovalRequired(); got != tt.wantGated {
+				t.Errorf("ApprovalRequired() = %v, want %v", got, tt.wantGated)
+			}
+			if got := tt.target.IsApprover(tt.subject); got != tt.wantApproverPass {
+				t.Errorf("IsApprover(%q) = %v, want %v", tt.subject, got, tt.wantApproverPass)
+			}
+		})
+	}
+}
+
+// newPromotionTestService returns a ComponentService backed by a fake client holding a component that is
+// bound to the gated production environment, along with the given objects
+func newPromotionTestService(t *testing.T, objects ...client.Object) (*ComponentService, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	objects = append(objects,
+		&v1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "acme"},
+			Spec:       v1alpha1.ProjectSpec{DeploymentPipelineRef: "pipeline"},
+		},
+		&v1alpha1.DeploymentPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipeline", Namespace: "acme"},
+			Spec: v1alpha1.DeploymentPipelineSpec{PromotionPaths: []v1alpha1.PromotionPath{{
+				SourceEnvironmentRef: "development",
+				TargetEnvironmentRefs: []v1alpha1.TargetEnvironmentRef{
+					{Name: "staging"},
+					{Name: "production", RequiresApproval: true, Approvers: []string{"alice"}},
+				},
+			}}},
+		},
+		&v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acme"},
+			Spec:       v1alpha1.ComponentSpec{Owner: v1alpha1.ComponentOwner{ProjectName: "shop"}},
+		},
+		&v1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-production", Namespace: "acme"},
+			Spec: v1alpha1.ReleaseBindingSpec{
+				Owner:       v1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "app"},
+				Environment: "production",
+				ReleaseName: "app-2",
+			},
+			Status: v1alpha1.ReleaseBindingStatus{ReleaseHistory: []v1alpha1.ReleaseHistoryEntry{
+				{ReleaseName: "app-1"}, {ReleaseName: "app-2"},
+			}},
+		},
+	)
+	for _, name := range []string{"app-1", "app-2", "app-3"} {
+		objects = append(objects, &v1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+			Spec: v1alpha1.ComponentReleaseSpec{
+				Owner: v1alpha1.ComponentReleaseOwner{ProjectName: "shop", ComponentName: "app"},
+			},
+		})
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&v1alpha1.ReleaseBinding{}, &v1alpha1.PromotionRequest{}).
+		Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewComponentService(k8sClient, NewProjectService(k8sClient, logger), logger), k8sClient
+}
+
+// approvedPromotionRequest returns a promotion request of app that approved a release for production
+func approvedPromotionRequest(releaseName string, phase v1alpha1.PromotionRequestPhase) *v1alpha1.PromotionRequest {
+	return &v1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-production-" + releaseName,
+			Namespace: "acme",
+			Labels: map[string]string{
+				labels.LabelKeyProjectName:     "shop",
+				labels.LabelKeyComponentName:   "app",
+				labels.LabelKeyEnvironmentName: "production",
+			},
+		},
+		Spec: v1alpha1.PromotionRequestSpec{
+			Owner:             v1alpha1.PromotionRequestOwner{ProjectName: "shop", ComponentName: "app"},
+			SourceEnvironment: "development",
+			TargetEnvironment: "production",
+			ReleaseName:       releaseName,
+			RequestedBy:       "bob",
+		},
+		Status: v1alpha1.PromotionRequestStatus{Phase: phase},
+	}
+}
+
+// TestRollbackReleaseBindingApprovalGate tests that rollbacks into a gated environment only bind releases that
+// were bound or approved there before
+func TestRollbackReleaseBindingApprovalGate(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []client.Object
+		release string
+		wantErr error
+	}{
+		{
+			name:    "Previously bound release",
+			release: "app-1",
+		},
+		{
+			name:    "Release that was never approved",
+			release: "app-3",
+			wantErr: ErrPromotionApprovalRequired,
+		},
+		{
+			name:    "Release with a pending promotion request",
+			objects: []client.Object{approvedPromotionRequest("app-3", v1alpha1.PromotionRequestPhasePending)},
+			release: "app-3",
+			wantErr: ErrPromotionApprovalRequired,
+		},
+		{
+			name:    "Release with an approved promotion request",
+			objects: []client.Object{approvedPromotionRequest("app-3", v1alpha1.PromotionRequestPhaseApproved)},
+			release: "app-3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, k8sClient := newPromotionTestService(t, tt.objects...)
+
+			_, err := service.RollbackReleaseBinding(context.Background(), "acme", "shop", "app", "production",
+				&models.RollbackReleaseBindingRequest{ReleaseName: tt.release})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RollbackReleaseBinding() error = %v, want %v", err, tt.wantErr)
+			}
+
+			binding := &v1alpha1.ReleaseBinding{}
+			if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "acme", Name: "app-production"}, binding); err != nil {
+				t.Fatalf("failed to get binding: %v", err)
+			}
+			want := tt.release
+			if tt.wantErr != nil {
+				want = "app-2"
+			}
+			if binding.Spec.ReleaseName != want {
+				t.Errorf("bound release = %q, want %q", binding.Spec.ReleaseName, want)
+			}
+		})
+	}
+}
+
+// TestPatchReleaseBindingApprovalGate tests that bindings created by a patch only bind releases to gated
+// environments once they were approved there
+func TestPatchReleaseBindingApprovalGate(t *testing.T) {
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		bindingName string
+		environment string
+		release     string
+		wantErr     error
+	}{
+		{
+			name:        "Unapproved release in a gated environment",
+			bindingName: "app-production-2",
+			environment: "production",
+			release:     "app-3",
+			wantErr:     ErrPromotionApprovalRequired,
+		},
+		{
+			name:        "Approved release in a gated environment",
+			objects:     []client.Object{approvedPromotionRequest("app-3", v1alpha1.PromotionRequestPhaseApproved)},
+			bindingName: "app-production-2",
+			environment: "production",
+			release:     "app-3",
+		},
+		{
+			name:        "Release in an environment without approval",
+			bindingName: "app-staging",
+			environment: "staging",
+			release:     "app-3",
+		},
+		{
+			name:        "Overrides of a gated binding without changing its release",
+			bindingName: "app-production",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, k8sClient := newPromotionTestService(t, tt.objects...)
+
+			_, err := service.PatchReleaseBinding(context.Background(), "acme", "shop", "app", tt.bindingName,
+				&models.PatchReleaseBindingRequest{ReleaseName: tt.release, Environment: tt.environment})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PatchReleaseBinding() error = %v, want %v", err, tt.wantErr)
+			}
+
+			binding := &v1alpha1.ReleaseBinding{}
+			err = k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "acme", Name: tt.bindingName}, binding)
+			if tt.wantErr != nil {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("binding %s was created for an unapproved release, error = %v", tt.bindingName, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get binding: %v", err)
+			}
+		})
+	}
+}
+
+// TestApprovePromotionRequestApprovesOnce tests that a promotion request is approved before the release is bound,
+// so that an approval of a stale copy of the request does not promote the release again
+func TestApprovePromotionRequestApprovesOnce(t *testing.T) {
+	service, k8sClient := newPromotionTestService(t, approvedPromotionRequest("app-3", v1alpha1.PromotionRequestPhasePending))
+	ctx := context.Background()
+
+	response, err := service.ApprovePromotionRequest(ctx, "acme", "shop", "app", "app-production-app-3", "alice",
+		&models.PromotionDecisionRequest{Comment: "ship it"})
+	if err != nil {
+		t.Fatalf("ApprovePromotionRequest() error = %v", err)
+	}
+	if response.Phase != string(v1alpha1.PromotionRequestPhaseApproved) {
+		t.Errorf("phase = %q, want %q", response.Phase, v1alpha1.PromotionRequestPhaseApproved)
+	}
+
+	binding := &v1alpha1.ReleaseBinding{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "app-production"}, binding); err != nil {
+		t.Fatalf("failed to get binding: %v", err)
+	}
+	if binding.Spec.ReleaseName != "app-3" || len(binding.Status.Approvals) != 1 {
+		t.Errorf("binding = %q with %d approvals, want app-3 with 1 approval", binding.Spec.ReleaseName, len(binding.Status.Approvals))
+	}
+
+	if _, err := service.ApprovePromotionRequest(ctx, "acme", "shop", "app", "app-production-app-3", "alice",
+		&models.PromotionDecisionRequest{}); !errors.Is(err, ErrPromotionRequestNotPending) {
+		t.Errorf("second ApprovePromotionRequest() error = %v, want %v", err, ErrPromotionRequestNotPending)
+	}
+}
+
+// TestApprovePromotionRequestConcurrentApproval tests that an approval losing the race against a concurrent
+// decision on the request does not bind the release
+func TestApprovePromotionRequestConcurrentApproval(t *testing.T) {
+	service, k8sClient := newPromotionTestService(t, approvedPromotionRequest("app-3", v1alpha1.PromotionRequestPhasePending))
+	ctx := context.Background()
+
+	// Another approver decides on the request after this approval read it
+	service.k8sClient = interceptor.NewClient(k8sClient.(client.WithWatch), interceptor.Funcs{
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if _, ok := obj.(*v1alpha1.PromotionRequest); ok {
+				concurrent := &v1alpha1.PromotionRequest{}
+				if err := c.Get(ctx, client.ObjectKeyFromObject(obj), concurrent); err != nil {
+					return err
+				}
+				concurrent.Status.Phase = v1alpha1.PromotionRequestPhaseApproved
+				if err := c.Status().Update(ctx, concurrent); err != nil {
+					return err
+				}
+			}
+			return c.Status().Update(ctx, obj, opts...)
+		},
+	})
+
+	if _, err := service.ApprovePromotionRequest(ctx, "acme", "shop", "app", "app-production-app-3", "alice",
+		&models.PromotionDecisionRequest{}); !errors.Is(err, ErrPromotionRequestNotPending) {
+		t.Fatalf("ApprovePromotionRequest() error = %v, want %v", err, ErrPromotionRequestNotPending)
+	}
+
+	binding := &v1alpha1.ReleaseBinding{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "app-production"}, binding); err != nil {
+		t.Fatalf("failed to get binding: %v", err)
+	}
+	if binding.Spec.ReleaseName != "app-2" || len(binding.Status.Approvals) != 0 {
+		t.Errorf("binding = %q with %d approvals, want it unchanged", binding.Spec.ReleaseName, len(binding.Status.Approvals))
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// DiffComponentReleases renders two ComponentReleases for the same environment and returns the
+// resource-by-resource differences of releaseName relative to againstRelease.
+// The environment's ReleaseBinding overrides are applied to both renders; if the component has no
+// ReleaseBinding for the environment, both releases are rendered without overrides.
+func (s *ComponentService) DiffComponentReleases(ctx context.Context, orgName, projectName, componentName, releaseName, againstRelease, environmentName string) (*models.ComponentReleaseDiffResponse, error) {
+	s.logger.Debug("Diffing component releases", "org", orgName, "project", projectName, "component", componentName,
+		"release", releaseName, "against", againstRelease, "environment", environmentName)
+
+	var project openchoreov1alpha1.Project
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: projectName}, &project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Project not found", "org", orgName, "project", projectName)
+			return nil, ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			return nil, ErrComponentNotFound
+		}
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	if component.Spec.Owner.ProjectName != projectName {
+		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
+		return nil, ErrComponentNotFound
+	}
+
+	newRelease, err := s.getOwnedComponentRelease(ctx, orgName, componentName, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	oldRelease, err := s.getOwnedComponentRelease(ctx, orgName, componentName, againstRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	var environment openchoreov1alpha1.Environment
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environmentName}, &environment); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Environment not found", "org", orgName, "environment", environmentName)
+			return nil, ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err, "org", orgName, "environment", environmentName)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	if environment.Spec.DataPlaneRef == "" {
+		s.logger.Error("Environment has no dataplane reference", "environment", environmentName)
+		return nil, fmt.Errorf("environment %s has no dataplane reference", environmentName)
+	}
+
+	var dataPlane openchoreov1alpha1.DataPlane
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environment.Spec.DataPlaneRef}, &dataPlane); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Error("DataPlane not found", "org", orgName, "dataplane", environment.Spec.DataPlaneRef)
+			return nil, ErrDataPlaneNotFound
+		}
+		s.logger.Error("Failed to get dataplane", "error", err, "org", orgName, "dataplane", environment.Spec.DataPlaneRef)
+		return nil, fmt.Errorf("failed to get dataplane: %w", err)
+	}
+
+	binding, err := s.getReleaseBinding(ctx, orgName, projectName, componentName, environmentName)
+	if err != nil {
+		if !errors.Is(err, ErrReleaseBindingNotFound) {
+			return nil, err
+		}
+		s.logger.Debug("No release binding for environment, rendering without overrides", "environment", environmentName)
+	}
+
+	newResources, err := s.renderComponentRelease(ctx, newRelease, binding, &environment, &dataPlane, &component, &project)
+	if err != nil {
+		return nil, err
+	}
+	oldResources, err := s.renderComponentRelease(ctx, oldRelease, binding, &environment, &dataPlane, &component, &project)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, summary := diffRenderedResources(oldResources, newResources)
+
+	s.logger.Debug("Diffed component releases", "org", orgName, "project", projectName, "component", componentName,
+		"release", releaseName, "against", againstRelease, "environment", environmentName,
+		"added", summary.Added, "removed", summary.Removed, "changed", summary.Changed)
+
+	return &models.ComponentReleaseDiffResponse{
+		ReleaseName:    releaseName,
+		AgainstRelease: againstRelease,
+		Environment:    environmentName,
+		Summary:        summary,
+		Resources:      resources,
+	}, nil
+}
+
+// getOwnedComponentRelease fetches a ComponentRelease and verifies that it belongs to the component
+func (s *ComponentService) getOwnedComponentRelease(ctx context.Context, orgName, componentName, releaseName string) (*openchoreov1alpha1.ComponentRelease, error) {
+	var release openchoreov1alpha1.ComponentRelease
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: releaseName}, &release); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component release not found", "org", orgName, "component", componentName, "release", releaseName)
+			return nil, ErrComponentReleaseNotFound
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+
+	if release.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Component release does not belong to component", "org", orgName, "component", componentName, "release", releaseName)
+		return nil, ErrComponentReleaseNotFound
+	}
+
+	return &release, nil
+}
+
+// renderComponentRelease renders a ComponentRelease snapshot the same way the ReleaseBinding controller does
+func (s *ComponentService) renderComponentRelease(ctx context.Context, release *openchoreov1alpha1.ComponentRelease,
+	binding *openchoreov1alpha1.ReleaseBinding, environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) ([]map[string]any, error) {
+	renderInput := releasebinding.NewRenderInput(release, binding, environment, dataPlane, component, project)
+
+	secretReferences, err := releasebinding.CollectSecretReferences(ctx, s.k8sClient, renderInput.Workload, binding)
+	if err != nil {
+		s.logger.Error("Failed to collect secret references", "error", err, "release", release.Name)
+		return nil, fmt.Errorf("failed to collect secret references for release %s: %w", release.Name, err)
+	}
+	renderInput.SecretReferences = secretReferences
+
+	output, err := s.renderPipeline.RenderWithContext(ctx, renderInput)
+	if err != nil {
+		s.logger.Warn("Failed to render component release", "error", err, "release", release.Name)
+		return nil, fmt.Errorf("%w %s: %w", ErrReleaseRenderFailed, release.Name, err)
+	}
+
+	return output.Resources, nil
+}
+
+// renderedResourceKey identifies a rendered resource across two renders
+type renderedResourceKey struct {
+	apiVersion string
+	kind       string
+	namespace  string
+	name       string
+}
+
+func newRenderedResourceKey(resource map[string]any) renderedResourceKey {
+	key := renderedResourceKey{}
+	key.apiVersion, _ = resource["apiVersion"].(string)
+	key.kind, _ = resource["kind"].(string)
+	if metadata, ok := resource["metadata"].(map[string]any); ok {
+		key.namespace, _ = metadata["namespace"].(string)
+		key.name, _ = metadata["name"].(string)
+	}
+	return key
+}
+
+// diffRenderedResources compares two sets of rendered resources.
+// Resources are matched by apiVersion, kind, namespace and name; unchanged resources are only counted.
+func diffRenderedResources(oldResources, newResources []map[string]any) ([]models.ResourceDiff, models.ResourceDiffSummary) {
+	summary := models.ResourceDiffSummary{}
+	diffs := []models.ResourceDiff{}
+
+	oldByKey := make(map[renderedResourceKey]map[string]any, len(oldResources))
+	for _, resource := range oldResources {
+		oldByKey[newRenderedResourceKey(resource)] = resource
+	}
+
+	seen := make(map[renderedResourceKey]bool, len(newResources))
+	for _, resource := range newResources {
+		key := newRenderedResourceKey(resource)
+		seen[key] = true
+
+		oldResource, exists := oldByKey[key]
+		if !exists {
+			summary.Added++
+			diffs = append(diffs, toResourceDiff(key, models.ResourceChangeAdded, nil))
+			continue
+		}
+
+		changes := diffFields("", oldResource, resource, nil)
+		if len(changes) == 0 {
+			summary.Unchanged++
+			continue
+		}
+		summary.Changed++
+		diffs = append(diffs, toResourceDiff(key, models.ResourceChangeChanged, changes))
+	}
+
+	for _, resource := range oldResources {
+		key := newRenderedResourceKey(resource)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		summary.Removed++
+		diffs = append(diffs, toResourceDiff(key, models.ResourceChangeRemoved, nil))
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		a, b := diffs[i], diffs[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.APIVersion != b.APIVersion {
+			return a.APIVersion < b.APIVersion
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	return diffs, summary
+}
+
+func toResourceDiff(key renderedResourceKey, change string, fieldChanges []models.FieldChange) models.ResourceDiff {
+	return models.ResourceDiff{
+		APIVersion:   key.apiVersion,
+		Kind:         key.kind,
+		Name:         key.name,
+		Namespace:    key.namespace,
+		Change:       change,
+		FieldChanges: fieldChanges,
+	}
+}
+
+// diffFields recursively compares two values and appends the leaf-level differences to changes.
+// Maps are compared key by key and lists index by index; any other differing value is reported as a whole.
+func diffFields(path string, oldValue, newValue any, changes []models.FieldChange) []models.FieldChange {
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if oldIsMap && newIsMap {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for k := range oldMap {
+			keys = append(keys, k)
+		}
+		for k := range newMap {
+			if _, exists := oldMap[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			childPath := joinFieldPath(path, k)
+			oldChild, inOld := oldMap[k]
+			newChild, inNew := newMap[k]
+			switch {
+			case !inOld:
+				changes = append(changes, models.FieldChange{Path: childPath, New: newChild})
+			case !inNew:
+				changes = append(changes, models.FieldChange{Path: childPath, Old: oldChild})
+			default:
+				changes = diffFields(childPath, oldChild, newChild, changes)
+			}
+		}
+		return changes
+	}
+
+	oldList, oldIsList := oldValue.([]any)
+	newList, newIsList := newValue.([]any)
+	if oldIsList && newIsList {
+		for i := 0; i < max(len(oldList), len(newList)); i++ {
+			childPath := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= len(oldList):
+				changes = append(changes, models.FieldChange{Path: childPath, New: newList[i]})
+			case i >= len(newList):
+				changes = append(changes, models.FieldChange{Path: childPath, Old: oldList[i]})
+			default:
+				changes = diffFields(childPath, oldList[i], newList[i], changes)
+			}
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		changes = append(changes, models.FieldChange{Path: path, Old: oldValue, New: newValue})
+	}
+	return changes
+}
+
+var simpleFieldName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// joinFieldPath appends a map key to a field path, quoting keys such as label names
+// that cannot be written in dotted form.
+func joinFieldPath(path, key string) string {
+	if !simpleFieldName.MatchString(key) {
+		return path + "[" + strconv.Quote(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func testDeploymentResource(name, image string, replicas int64, labels map[string]any) map[string]any {
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "dp-ns",
+			"labels":    labels,
+		},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "main", "image": image},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testServiceResource(name string) map[string]any {
+	return map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "dp-ns",
+		},
+	}
+}
+
+// TestDiffRenderedResources tests the resource matching and summary of diffRenderedResources
+func TestDiffRenderedResources(t *testing.T) {
+	labels := map[string]any{"app.kubernetes.io/name": "app"}
+
+	tests := []struct {
+		name         string
+		oldResources []map[string]any
+		newResources []map[string]any
+		wantSummary  models.ResourceDiffSummary
+		wantDiffs    []models.ResourceDiff
+	}{
+		{
+			name:         "Identical renders",
+			oldResources: []map[string]any{testDeploymentResource("app", "app:v1", 1, labels), testServiceResource("app")},
+			newResources: []map[string]any{testDeploymentResource("app", "app:v1", 1, labels), testServiceResource("app")},
+			wantSummary:  models.ResourceDiffSummary{Unchanged: 2},
+			wantDiffs:    []models.ResourceDiff{},
+		},
+		{
+			name:         "Added and removed resources",
+			oldResources: []map[string]any{testDeploymentResource("app", "app:v1", 1, labels), testServiceResource("old")},
+			newResources: []map[string]any{testDeploymentResource("app", "app:v1", 1, labels), testServiceResource("new")},
+			wantSummary:  models.ResourceDiffSummary{Added: 1, Removed: 1, Unchanged: 1},
+			wantDiffs: []models.ResourceDiff{
+				{APIVersion: "v1", Kind: "Service", Name: "new", Namespace: "dp-ns", Change: models.ResourceChangeAdded},
+				{APIVersion: "v1", Kind: "Service", Name: "old", Namespace: "dp-ns", Change: models.ResourceChangeRemoved},
+			},
+		},
+		{
+			name:         "Changed fields",
+			oldResources: []map[string]any{testDeploymentResource("app", "app:v1", 1, labels)},
+			newResources: []map[string]any{testDeploymentResource("app", "app:v2", 3, map[string]any{"tier": "web"})},
+			wantSummary:  models.ResourceDiffSummary{Changed: 1},
+			wantDiffs: []models.ResourceDiff{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "app",
+					Namespace:  "dp-ns",
+					Change:     models.ResourceChangeChanged,
+					FieldChanges: []models.FieldChange{
+						{Path: `metadata.labels["app.kubernetes.io/name"]`, Old: "app"},
+						{Path: "metadata.labels.tier", New: "web"},
+						{Path: "spec.replicas", Old: int64(1), New: int64(3)},
+						{Path: "spec.template.spec.containers[0].image", Old: "app:v1", New: "app:v2"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDiffs, gotSummary := diffRenderedResources(tt.oldResources, tt.newResources)
+			if gotSummary != tt.wantSummary {
+				t.Errorf("diffRenderedResources() summary = %+v, want %+v", gotSummary, tt.wantSummary)
+			}
+			if !reflect.DeepEqual(gotDiffs, tt.wantDiffs) {
+				t.Errorf("diffRenderedResources() diffs = %+v, want %+v", gotDiffs, tt.wantDiffs)
+			}
+		})
+	}
+}
+
+// TestDiffFieldsLists tests that list elements are compared index by index
+func TestDiffFieldsLists(t *testing.T) {
+	oldValue := map[string]any{"args": []any{"--a", "--b"}}
+	newValue := map[string]any{"args": []any{"--a", "--c", "--d"}}
+
+	got := diffFields("", oldValue, newValue, nil)
+	want := []models.FieldChange{
+		{Path: "args[1]", Old: "--b", New: "--c"},
+		{Path: "args[2]", New: "--d"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffFields() = %+v, want %+v", got, want)
+	}
+}
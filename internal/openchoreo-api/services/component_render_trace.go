@@ -0,0 +1,144 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// TraceReleaseBinding renders the ComponentRelease of a ReleaseBinding with template tracing enabled
+// and returns every evaluated expression along with the rendered resources.
+// A failed render is not returned as an error; the response carries the render error and the trace
+// up to the failing expression so that the failure can be debugged.
+func (s *ComponentService) TraceReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string) (*models.ReleaseBindingTraceResponse, error) {
+	s.logger.Debug("Tracing release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+
+	var project openchoreov1alpha1.Project
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: projectName}, &project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Project not found", "org", orgName, "project", projectName)
+			return nil, ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			return nil, ErrComponentNotFound
+		}
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	if component.Spec.Owner.ProjectName != projectName {
+		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
+		return nil, ErrComponentNotFound
+	}
+
+	var binding openchoreov1alpha1.ReleaseBinding
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: bindingName}, &binding); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Release binding not found", "org", orgName, "binding", bindingName)
+			return nil, ErrReleaseBindingNotFound
+		}
+		s.logger.Error("Failed to get release binding", "error", err)
+		return nil, fmt.Errorf("failed to get release binding: %w", err)
+	}
+
+	if binding.Spec.Owner.ProjectName != projectName || binding.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Release binding does not belong to component", "org", orgName, "component", componentName, "binding", bindingName)
+		return nil, ErrReleaseBindingNotFound
+	}
+
+	if binding.Spec.ReleaseName == "" {
+		s.logger.Warn("Release binding has no release", "org", orgName, "binding", bindingName)
+		return nil, ErrComponentReleaseNotFound
+	}
+	release, err := s.getOwnedComponentRelease(ctx, orgName, componentName, binding.Spec.ReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	var environment openchoreov1alpha1.Environment
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: binding.Spec.Environment}, &environment); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Environment not found", "org", orgName, "environment", binding.Spec.Environment)
+			return nil, ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err, "org", orgName, "environment", binding.Spec.Environment)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	if environment.Spec.DataPlaneRef == "" {
+		s.logger.Error("Environment has no dataplane reference", "environment", environment.Name)
+		return nil, fmt.Errorf("environment %s has no dataplane reference", environment.Name)
+	}
+
+	var dataPlane openchoreov1alpha1.DataPlane
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environment.Spec.DataPlaneRef}, &dataPlane); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Error("DataPlane not found", "org", orgName, "dataplane", environment.Spec.DataPlaneRef)
+			return nil, ErrDataPlaneNotFound
+		}
+		s.logger.Error("Failed to get dataplane", "error", err, "org", orgName, "dataplane", environment.Spec.DataPlaneRef)
+		return nil, fmt.Errorf("failed to get dataplane: %w", err)
+	}
+
+	renderInput := releasebinding.NewRenderInput(release, &binding, &environment, &dataPlane, &component, &project)
+	secretReferences, err := releasebinding.CollectSecretReferences(ctx, s.k8sClient, renderInput.Workload, &binding)
+	if err != nil {
+		s.logger.Error("Failed to collect secret references", "error", err, "release", release.Name)
+		return nil, fmt.Errorf("failed to collect secret references for release %s: %w", release.Name, err)
+	}
+	renderInput.SecretReferences = secretReferences
+
+	response := &models.ReleaseBindingTraceResponse{
+		BindingName: bindingName,
+		ReleaseName: release.Name,
+		Environment: binding.Spec.Environment,
+	}
+
+	output, err := s.tracePipeline.RenderWithContext(ctx, renderInput)
+	if err != nil {
+		s.logger.Debug("Traced render of release binding failed", "error", err, "binding", bindingName)
+		response.Error = err.Error()
+	}
+	if output != nil {
+		response.Resources = output.Resources
+		response.Trace = toTemplateTraceEntries(output.Trace)
+	}
+	if response.Trace == nil {
+		response.Trace = []models.TemplateTraceEntry{}
+	}
+
+	s.logger.Debug("Traced release binding", "org", orgName, "binding", bindingName, "expressions", len(response.Trace))
+	return response, nil
+}
+
+func toTemplateTraceEntries(entries []template.TraceEntry) []models.TemplateTraceEntry {
+	result := make([]models.TemplateTraceEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, models.TemplateTraceEntry{
+			Path:       entry.Path,
+			Expression: entry.Expression,
+			Inputs:     entry.Inputs,
+			Result:     entry.Result,
+			Omitted:    entry.Omitted,
+			Excluded:   entry.Excluded,
+			Error:      entry.Error,
+		})
+	}
+	return result
+}
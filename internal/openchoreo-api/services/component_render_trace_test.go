@@ -0,0 +1,114 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// connectedRelease is a ComponentRelease of a component exposing the URL of its API connection in a ConfigMap
+const connectedRelease = `
+metadata:
+  name: app-1
+  namespace: acme
+spec:
+  owner:
+    projectName: shop
+    componentName: app
+  componentType:
+    workloadType: deployment
+    resources:
+      - id: config
+        template:
+          apiVersion: v1
+          kind: ConfigMap
+          metadata:
+            name: ${metadata.name}
+            namespace: ${metadata.namespace}
+          data:
+            ordersURL: ${connections.orders.url}
+  componentProfile: {}
+  workload:
+    containers:
+      main:
+        image: app:v1
+    connections:
+      orders:
+        type: api
+        params:
+          projectName: store
+          componentName: orders
+          endpoint: rest
+`
+
+// TestTraceReleaseBindingRendersConnections tests that traced renders resolve API connections to the endpoints
+// recorded by the controller, as the controller does when it deploys the release
+func TestTraceReleaseBindingRendersConnections(t *testing.T) {
+	release := &v1alpha1.ComponentRelease{}
+	if err := yaml.Unmarshal([]byte(connectedRelease), release); err != nil {
+		t.Fatalf("failed to unmarshal release: %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	objects := []runtime.Object{
+		release,
+		&v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "acme"}},
+		&v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acme"},
+			Spec:       v1alpha1.ComponentSpec{Owner: v1alpha1.ComponentOwner{ProjectName: "shop"}},
+		},
+		&v1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "development", Namespace: "acme"},
+			Spec:       v1alpha1.EnvironmentSpec{DataPlaneRef: "default"},
+		},
+		&v1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "acme"}},
+		&v1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-development", Namespace: "acme"},
+			Spec: v1alpha1.ReleaseBindingSpec{
+				Owner:       v1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "app"},
+				Environment: "development",
+				ReleaseName: "app-1",
+			},
+			Status: v1alpha1.ReleaseBindingStatus{Connections: []v1alpha1.ConnectionStatus{{
+				Name:          "orders",
+				ProjectName:   "store",
+				ComponentName: "orders",
+				Endpoint:      "rest",
+				Visibility:    v1alpha1.ConnectionVisibilityOrganization,
+				URL:           "http://orders.dp-acme-store-development.svc.cluster.local:8080",
+			}}},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := NewComponentService(k8sClient, NewProjectService(k8sClient, logger), logger)
+
+	response, err := service.TraceReleaseBinding(context.Background(), "acme", "shop", "app", "app-development")
+	if err != nil {
+		t.Fatalf("TraceReleaseBinding() error = %v", err)
+	}
+	if response.Error != "" {
+		t.Fatalf("TraceReleaseBinding() render error = %s", response.Error)
+	}
+	if len(response.Resources) != 1 {
+		t.Fatalf("TraceReleaseBinding() rendered %d resources, want 1", len(response.Resources))
+	}
+	data, _ := response.Resources[0]["data"].(map[string]any)
+	if got, want := data["ordersURL"], "http://orders.dp-acme-store-development.svc.cluster.local:8080"; got != want {
+		t.Errorf("ordersURL = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// toRolloutStrategy converts the rollout strategy of a request to the ReleaseBinding rollout strategy.
+// A strategy without a canary removes the rollout strategy.
+func toRolloutStrategy(strategy *models.RolloutStrategy) (*openchoreov1alpha1.RolloutStrategy, error) {
+	if strategy.Canary == nil {
+		return nil, nil
+	}
+
+	canary := &openchoreov1alpha1.CanaryStrategy{
+		Steps: make([]openchoreov1alpha1.CanaryStep, 0, len(strategy.Canary.Steps)),
+	}
+	for _, step := range strategy.Canary.Steps {
+		pause, err := time.ParseDuration(step.Pause)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pause %q: %w", step.Pause, err)
+		}
+		canary.Steps = append(canary.Steps, openchoreov1alpha1.CanaryStep{
+			Weight: step.Weight,
+			Pause:  metav1.Duration{Duration: pause},
+		})
+	}
+	if strategy.Canary.Analysis != nil {
+		canary.Analysis = &openchoreov1alpha1.CanaryAnalysis{
+			MaxErrorRate: strategy.Canary.Analysis.MaxErrorRate,
+		}
+	}
+	return &openchoreov1alpha1.RolloutStrategy{Canary: canary}, nil
+}
+
+func toRolloutStrategyResponse(strategy *openchoreov1alpha1.RolloutStrategy) *models.RolloutStrategy {
+	if strategy == nil || strategy.Canary == nil {
+		return nil
+	}
+
+	canary := &models.CanaryStrategy{
+		Steps: make([]models.CanaryStep, 0, len(strategy.Canary.Steps)),
+	}
+	for _, step := range strategy.Canary.Steps {
+		canary.Steps = append(canary.Steps, models.CanaryStep{
+			Weight: step.Weight,
+			Pause:  step.Pause.Duration.String(),
+		})
+	}
+	if strategy.Canary.Analysis != nil {
+		canary.Analysis = &models.CanaryAnalysis{
+			MaxErrorRate: strategy.Canary.Analysis.MaxErrorRate,
+		}
+	}
+	return &models.RolloutStrategy{Canary: canary}
+}
+
+func toRolloutStatusResponse(binding *openchoreov1alpha1.ReleaseBinding) *models.RolloutStatus {
+	rollout := binding.Status.Rollout
+	if rollout == nil {
+		return nil
+	}
+
+	response := &models.RolloutStatus{
+		Phase:         string(rollout.Phase),
+		StableRelease: rollout.StableRelease,
+		CanaryRelease: rollout.CanaryRelease,
+		CurrentStep:   rollout.CurrentStep,
+		CurrentWeight: rollout.CurrentWeight,
+		ErrorRate:     rollout.ErrorRate,
+		Message:       rollout.Message,
+	}
+	if strategy := binding.Spec.RolloutStrategy; strategy != nil && strategy.Canary != nil {
+		response.TotalSteps = len(strategy.Canary.Steps)
+	}
+	if rollout.StepStartedAt != nil {
+		stepStartedAt := rollout.StepStartedAt.Time
+		response.StepStartedAt = &stepStartedAt
+	}
+	return response
+}
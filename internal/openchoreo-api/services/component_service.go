@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
@@ -23,7 +24,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 const (
@@ -34,11 +37,26 @@ const (
 	statusFailed   = "Failed"
 )
 
+// renderEvaluationTimeout bounds the time spent evaluating the templates of a single render in a request.
+// It is kept below the write timeout of the server, so that a release diff rendering twice can still respond.
+const renderEvaluationTimeout = 5 * time.Second
+
+// renderPipelineOptions bounds the evaluation of the templates rendered in requests, as the
+// templates are authored by platform teams and rendered on demand.
+func renderPipelineOptions(opts ...componentpipeline.Option) []componentpipeline.Option {
+	return append([]componentpipeline.Option{
+		componentpipeline.WithCostLimits(template.DefaultCostLimits()),
+		componentpipeline.WithEvaluationTimeout(renderEvaluationTimeout),
+	}, opts...)
+}
+
 // ComponentService handles component-related business logic
 type ComponentService struct {
 	k8sClient           client.Client
 	projectService      *ProjectService
 	specFetcherRegistry *ComponentSpecFetcherRegistry
+	renderPipeline      *componentpipeline.Pipeline
+	tracePipeline       *componentpipeline.Pipeline
 	logger              *slog.Logger
 }
 
@@ -100,6 +118,8 @@ func NewComponentService(k8sClient client.Client, projectService *ProjectService
 		k8sClient:           k8sClient,
 		projectService:      projectService,
 		specFetcherRegistry: NewComponentSpecFetcherRegistry(),
+		renderPipeline:      componentpipeline.NewPipeline(renderPipelineOptions()...),
+		tracePipeline:       componentpipeline.NewPipeline(renderPipelineOptions(componentpipeline.WithTracing(true))...),
 		logger:              logger,
 	}
 }
@@ -692,7 +712,8 @@ func (s *ComponentService) GetEnvironmentRelease(ctx context.Context, orgName, p
 	}, nil
 }
 
-// PatchReleaseBinding patches a ReleaseBinding with environment-specific overrides
+// PatchReleaseBinding patches a ReleaseBinding with environment-specific overrides.
+// A release bound to an environment that requires approval must have been approved for it.
 func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (*models.ReleaseBindingResponse, error) {
 	s.logger.Debug("Patching release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
 
@@ -773,6 +794,11 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 		return nil, ErrReleaseBindingNotFound
 	}
 
+	var existingBinding *openchoreov1alpha1.ReleaseBinding
+	if bindingExists {
+		existingBinding = binding.DeepCopy()
+	}
+
 	if req.ComponentTypeEnvOverrides != nil {
 		overridesJSON, err := json.Marshal(req.ComponentTypeEnvOverrides)
 		if err != nil {
@@ -863,6 +889,27 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 		}
 	}
 
+	if req.RolloutStrategy != nil {
+		rolloutStrategy, err := toRolloutStrategy(req.RolloutStrategy)
+		if err != nil {
+			s.logger.Error("Failed to convert rollout strategy", "error", err)
+			return nil, fmt.Errorf("failed to convert rollout strategy: %w", err)
+		}
+		binding.Spec.RolloutStrategy = rolloutStrategy
+	}
+
+	if err := s.validateReleaseBindingOverrides(ctx, orgName, componentName, &binding); err != nil {
+		return nil, err
+	}
+
+	// Binding a release directly must not bypass the approval of environments that require it
+	if binding.Spec.ReleaseName != "" && (existingBinding == nil || existingBinding.Spec.ReleaseName != binding.Spec.ReleaseName) {
+		if err := s.requireApprovedRelease(ctx, orgName, projectName, componentName, binding.Spec.Environment,
+			binding.Spec.ReleaseName, existingBinding); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create or update the binding
 	if bindingExists {
 		if err := s.k8sClient.Update(ctx, &binding); err != nil {
@@ -897,6 +944,25 @@ func (s *ComponentService) toReleaseBindingResponse(binding *openchoreov1alpha1.
 	// Determine status from conditions
 	response.Status = s.determineReleaseBindingStatus(binding)
 
+	for _, entry := range binding.Status.ReleaseHistory {
+		response.ReleaseHistory = append(response.ReleaseHistory, models.ReleaseHistoryEntry{
+			ReleaseName: entry.ReleaseName,
+			BoundAt:     entry.BoundAt.Time,
+		})
+	}
+
+	for _, approval := range binding.Status.Approvals {
+		response.Approvals = append(response.Approvals, models.PromotionApproval{
+			PromotionRequest:  approval.PromotionRequest,
+			ReleaseName:       approval.ReleaseName,
+			SourceEnvironment: approval.SourceEnvironment,
+			RequestedBy:       approval.RequestedBy,
+			ApprovedBy:        approval.ApprovedBy,
+			ApprovedAt:        approval.ApprovedAt.Time,
+			Comment:           approval.Comment,
+		})
+	}
+
 	if binding.Spec.ComponentTypeEnvOverrides != nil {
 		var overrides map[string]interface{}
 		if err := json.Unmarshal(binding.Spec.ComponentTypeEnvOverrides.Raw, &overrides); err == nil {
@@ -970,6 +1036,9 @@ func (s *ComponentService) toReleaseBindingResponse(binding *openchoreov1alpha1.
 		}
 	}
 
+	response.RolloutStrategy = toRolloutStrategyResponse(binding.Spec.RolloutStrategy)
+	response.Rollout = toRolloutStatusResponse(binding)
+
 	return response
 }
 
@@ -1207,6 +1276,93 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 	return s.toReleaseBindingResponse(&binding, orgName, projectName, componentName), nil
 }
 
+// RollbackReleaseBinding rebinds an environment to a previously deployed ComponentRelease.
+// If req.ReleaseName is empty, the most recent release in the binding's release history that differs
+// from the currently bound release is used. The ReleaseBinding controller records the change in the
+// binding's release history and reports it through the RolledBack condition.
+// Environments that require approval only accept releases that were bound or approved there before.
+func (s *ComponentService) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, environmentName string, req *models.RollbackReleaseBindingRequest) (*models.ReleaseBindingResponse, error) {
+	s.logger.Debug("Rolling back release binding", "org", orgName, "project", projectName, "component", componentName,
+		"environment", environmentName, "release", req.ReleaseName)
+
+	if _, err := s.projectService.GetProject(ctx, orgName, projectName); err != nil {
+		return nil, err
+	}
+
+	componentKey := client.ObjectKey{
+		Namespace: orgName,
+		Name:      componentName,
+	}
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, componentKey, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			return nil, ErrComponentNotFound
+		}
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	if component.Spec.Owner.ProjectName != projectName {
+		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
+		return nil, ErrComponentNotFound
+	}
+
+	binding, err := s.getReleaseBinding(ctx, orgName, projectName, componentName, environmentName)
+	if err != nil {
+		if errors.Is(err, ErrReleaseBindingNotFound) {
+			s.logger.Warn("Release binding not found", "org", orgName, "component", componentName, "environment", environmentName)
+		}
+		return nil, err
+	}
+
+	targetRelease := req.ReleaseName
+	if targetRelease == "" {
+		targetRelease = previousReleaseName(binding)
+		if targetRelease == "" {
+			s.logger.Warn("No previous release to roll back to", "binding", binding.Name)
+			return nil, ErrNoPreviousRelease
+		}
+	}
+
+	if targetRelease == binding.Spec.ReleaseName {
+		s.logger.Warn("Release is already bound", "binding", binding.Name, "release", targetRelease)
+		return nil, ErrReleaseAlreadyBound
+	}
+
+	// Make sure the target release still exists and belongs to the component
+	if _, err := s.getOwnedComponentRelease(ctx, orgName, componentName, targetRelease); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireApprovedRelease(ctx, orgName, projectName, componentName, environmentName, targetRelease, binding); err != nil {
+		return nil, err
+	}
+
+	previousRelease := binding.Spec.ReleaseName
+	binding.Spec.ReleaseName = targetRelease
+	if err := s.k8sClient.Update(ctx, binding); err != nil {
+		s.logger.Error("Failed to update release binding", "error", err)
+		return nil, fmt.Errorf("failed to update release binding: %w", err)
+	}
+
+	s.logger.Info("Rolled back release binding", "org", orgName, "project", projectName, "component", componentName,
+		"environment", environmentName, "from", previousRelease, "to", targetRelease)
+	return s.toReleaseBindingResponse(binding, orgName, projectName, componentName), nil
+}
+
+// previousReleaseName returns the most recent release in the binding's release history that differs
+// from the currently bound release, or an empty string if there is none.
+func previousReleaseName(binding *openchoreov1alpha1.ReleaseBinding) string {
+	history := binding.Status.ReleaseHistory
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ReleaseName != binding.Spec.ReleaseName {
+			return history[i].ReleaseName
+		}
+	}
+	return ""
+}
+
 // findLowestEnvironment finds the lowest environment in the deployment pipeline
 // The lowest environment is one that is not a target in any promotion path
 func (s *ComponentService) findLowestEnvironment(promotionPaths []openchoreov1alpha1.PromotionPath) string {
@@ -1831,16 +1987,23 @@ func (s *ComponentService) PromoteComponent(ctx context.Context, req *PromoteCom
 	s.logger.Debug("Promoting component", "org", req.OrgName, "project", req.ProjectName, "component", req.ComponentName,
 		"source", req.SourceEnvironment, "target", req.TargetEnvironment)
 
-	if err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment); err != nil {
+	target, err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment)
+	if err != nil {
 		return nil, err
 	}
 
+	// Gated environments are only promoted to through an approved PromotionRequest
+	if target.ApprovalRequired() {
+		s.logger.Warn("Promotion requires approval", "source", req.SourceEnvironment, "target", req.TargetEnvironment)
+		return nil, ErrPromotionApprovalRequired
+	}
+
 	sourceReleaseBinding, err := s.getReleaseBinding(ctx, req.OrgName, req.ProjectName, req.ComponentName, req.SourceEnvironment)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get source release binding: %w", err)
 	}
 
-	if err := s.createOrUpdateReleaseBinding(ctx, req, sourceReleaseBinding); err != nil {
+	if _, err := s.createOrUpdateReleaseBinding(ctx, req, sourceReleaseBinding.Spec.ReleaseName); err != nil {
 		return nil, fmt.Errorf("failed to create/update target release binding: %w", err)
 	}
 
@@ -1894,11 +2057,41 @@ func (s *ComponentService) mapConditionToBindingStatus(condition metav1.Conditio
 }
 
 // validatePromotionPath validates that the promotion path is allowed by the deployment pipeline
-func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, projectName, sourceEnv, targetEnv string) error {
+// and returns the target environment reference with its approval settings
+func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, projectName, sourceEnv, targetEnv string) (*openchoreov1alpha1.TargetEnvironmentRef, error) {
+	pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	pipelineName := pipeline.Name
+
+	s.logger.Info("Promotion paths", "promotionPaths", pipeline.Spec.PromotionPaths)
+
+	// Check if the promotion path is valid
+	for _, path := range pipeline.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef == sourceEnv {
+			s.logger.Info("Source environment", "source", sourceEnv)
+			for _, target := range path.TargetEnvironmentRefs {
+				s.logger.Info("Target environment", "target", target.Name)
+				if target.Name == targetEnv {
+					s.logger.Info("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
+					s.logger.Debug("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
+					return &target, nil
+				}
+			}
+		}
+	}
+
+	s.logger.Warn("Invalid promotion path", "source", sourceEnv, "target", targetEnv, "pipeline", pipelineName)
+	return nil, ErrInvalidPromotionPath
+}
+
+// getProjectDeploymentPipeline retrieves the deployment pipeline of a project, falling back to the default pipeline
+func (s *ComponentService) getProjectDeploymentPipeline(ctx context.Context, orgName, projectName string) (*openchoreov1alpha1.DeploymentPipeline, error) {
 	// Get the project to determine the deployment pipeline reference
 	project, err := s.projectService.GetProject(ctx, orgName, projectName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var pipelineName string
@@ -1917,30 +2110,11 @@ func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, p
 
 	if err := s.k8sClient.Get(ctx, key, pipeline); err != nil {
 		if client.IgnoreNotFound(err) == nil {
-			return ErrDeploymentPipelineNotFound
-		}
-		return fmt.Errorf("failed to get deployment pipeline: %w", err)
-	}
-
-	s.logger.Info("Promotion paths", "promotionPaths", pipeline.Spec.PromotionPaths)
-
-	// Check if the promotion path is valid
-	for _, path := range pipeline.Spec.PromotionPaths {
-		if path.SourceEnvironmentRef == sourceEnv {
-			s.logger.Info("Source environment", "source", sourceEnv)
-			for _, target := range path.TargetEnvironmentRefs {
-				s.logger.Info("Target environment", "target", target.Name)
-				if target.Name == targetEnv {
-					s.logger.Info("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
-					s.logger.Debug("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
-					return nil
-				}
-			}
+			return nil, ErrDeploymentPipelineNotFound
 		}
+		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
 	}
-
-	s.logger.Warn("Invalid promotion path", "source", sourceEnv, "target", targetEnv, "pipeline", pipelineName)
-	return ErrInvalidPromotionPath
+	return pipeline, nil
 }
 
 // getReleaseBinding retrieves a ReleaseBinding for a component in a specific environment
@@ -1971,14 +2145,14 @@ func (s *ComponentService) getReleaseBinding(ctx context.Context, orgName, proje
 	return nil, ErrReleaseBindingNotFound
 }
 
-// createOrUpdateReleaseBinding creates or updates a ReleaseBinding in the target environment
-func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req *PromoteComponentPayload, sourceBinding *openchoreov1alpha1.ReleaseBinding) error {
+// createOrUpdateReleaseBinding creates or updates the ReleaseBinding in the target environment to bind the given release
+func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req *PromoteComponentPayload, releaseName string) (*openchoreov1alpha1.ReleaseBinding, error) {
 	// Check if there's already a binding for this component in the target environment
 	existingTargetBinding, err := s.getReleaseBinding(ctx, req.OrgName, req.ProjectName, req.ComponentName, req.TargetEnvironment)
 	var targetBindingName string
 
 	if err != nil && !errors.Is(err, ErrReleaseBindingNotFound) {
-		return fmt.Errorf("failed to check existing target binding: %w", err)
+		return nil, fmt.Errorf("failed to check existing target binding: %w", err)
 	}
 
 	if errors.Is(err, ErrReleaseBindingNotFound) {
@@ -2007,29 +2181,29 @@ func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req
 					ComponentName: req.ComponentName,
 				},
 				Environment: req.TargetEnvironment,
-				ReleaseName: sourceBinding.Spec.ReleaseName,
+				ReleaseName: releaseName,
 			},
 		}
 	} else {
 		targetBinding = existingTargetBinding
-		targetBinding.Spec.ReleaseName = sourceBinding.Spec.ReleaseName
+		targetBinding.Spec.ReleaseName = releaseName
 	}
 
 	if existingTargetBinding == nil {
 		// Create new binding
 		if err := s.k8sClient.Create(ctx, targetBinding); err != nil {
-			return fmt.Errorf("failed to create target release binding: %w", err)
+			return nil, fmt.Errorf("failed to create target release binding: %w", err)
 		}
 		s.logger.Debug("Created new ReleaseBinding", "name", targetBindingName, "namespace", req.OrgName, "environment", req.TargetEnvironment)
 	} else {
 		// Update existing binding
 		if err := s.k8sClient.Update(ctx, targetBinding); err != nil {
-			return fmt.Errorf("failed to update target release binding: %w", err)
+			return nil, fmt.Errorf("failed to update target release binding: %w", err)
 		}
 		s.logger.Debug("Updated existing ReleaseBinding", "name", targetBindingName, "namespace", req.OrgName, "environment", req.TargetEnvironment)
 	}
 
-	return nil
+	return targetBinding, nil
 }
 
 // getServiceBindingCR retrieves a ServiceBinding CR from the cluster
@@ -606,3 +606,63 @@ func TestDetermineReleaseBindingStatus(t *testing.T) {
 		})
 	}
 }
+
+// TestPreviousReleaseName tests how the rollback target is chosen from the release history
+func TestPreviousReleaseName(t *testing.T) {
+	history := func(names ...string) []v1alpha1.ReleaseHistoryEntry {
+		entries := make([]v1alpha1.ReleaseHistoryEntry, 0, len(names))
+		for _, name := range names {
+			entries = append(entries, v1alpha1.ReleaseHistoryEntry{ReleaseName: name})
+		}
+		return entries
+	}
+
+	tests := []struct {
+		name           string
+		currentRelease string
+		history        []v1alpha1.ReleaseHistoryEntry
+		want           string
+	}{
+		{
+			name:           "No history",
+			currentRelease: "app-v1",
+			want:           "",
+		},
+		{
+			name:           "Only the current release",
+			currentRelease: "app-v1",
+			history:        history("app-v1"),
+			want:           "",
+		},
+		{
+			name:           "Previous release",
+			currentRelease: "app-v3",
+			history:        history("app-v1", "app-v2", "app-v3"),
+			want:           "app-v2",
+		},
+		{
+			name:           "After a rollback the release before it is chosen",
+			currentRelease: "app-v1",
+			history:        history("app-v1", "app-v2", "app-v1"),
+			want:           "app-v2",
+		},
+		{
+			name:           "Current release not yet recorded by the controller",
+			currentRelease: "app-v3",
+			history:        history("app-v1", "app-v2"),
+			want:           "app-v2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := &v1alpha1.ReleaseBinding{
+				Spec:   v1alpha1.ReleaseBindingSpec{ReleaseName: tt.currentRelease},
+				Status: v1alpha1.ReleaseBindingStatus{ReleaseHistory: tt.history},
+			}
+			if got := previousReleaseName(binding); got != tt.want {
+				t.Errorf("previousReleaseName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
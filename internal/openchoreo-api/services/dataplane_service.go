@@ -201,18 +201,6 @@ func (s *DataPlaneService) toDataPlaneResponse(dp *openchoreov1alpha1.DataPlane)
 	displayName := dp.Annotations[controller.AnnotationKeyDisplayName]
 	description := dp.Annotations[controller.AnnotationKeyDescription]
 
-	// Get status from conditions
-	status := statusUnknown
-	if len(dp.Status.Conditions) > 0 {
-		// Get the latest condition
-		latestCondition := dp.Status.Conditions[len(dp.Status.Conditions)-1]
-		if latestCondition.Status == metav1.ConditionTrue {
-			status = statusReady
-		} else {
-			status = statusNotReady
-		}
-	}
-
 	// Extract secretStoreRef name if present
 	var secretStoreRef string
 	if dp.Spec.SecretStoreRef != nil {
@@ -231,7 +219,9 @@ func (s *DataPlaneService) toDataPlaneResponse(dp *openchoreov1alpha1.DataPlane)
 		PublicVirtualHost:       dp.Spec.Gateway.PublicVirtualHost,
 		OrganizationVirtualHost: dp.Spec.Gateway.OrganizationVirtualHost,
 		CreatedAt:               dp.CreationTimestamp.Time,
-		Status:                  status,
+		Status:                  planeStatus(dp.Status.Conditions),
+		Cluster:                 toClusterStatusResponse(dp.Status.Cluster),
+		Conditions:              toConditionResponses(dp.Status.Conditions),
 	}
 
 	// Add observer configuration if present
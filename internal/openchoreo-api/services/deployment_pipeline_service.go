@@ -86,6 +86,7 @@ func (s *DeploymentPipelineService) toDeploymentPipelineResponse(pipeline *openc
 				Name:                     target.Name,
 				RequiresApproval:         target.RequiresApproval,
 				IsManualApprovalRequired: target.IsManualApprovalRequired,
+				Approvers:                target.Approvers,
 			})
 		}
 		promotionPaths = append(promotionPaths, models.PromotionPath{
@@ -29,6 +29,15 @@ var (
 	ErrReleaseBindingNotFound     = errors.New("release binding not found")
 	ErrWorkflowSchemaInvalid      = errors.New("workflow schema is invalid")
 	ErrReleaseNotFound            = errors.New("release not found")
+	ErrReleaseRenderFailed        = errors.New("failed to render release")
+	ErrNoPreviousRelease          = errors.New("no previous release to roll back to")
+	ErrReleaseAlreadyBound        = errors.New("release is already bound to the environment")
+	ErrPromotionApprovalRequired  = errors.New("promotion requires approval")
+	ErrApprovalNotRequired        = errors.New("promotion does not require approval")
+	ErrPromotionRequestNotFound   = errors.New("promotion request not found")
+	ErrPromotionRequestNotPending = errors.New("promotion request is not pending")
+	ErrApproverNotAllowed         = errors.New("subject is not allowed to approve the promotion")
+	ErrInvalidParameters          = errors.New("parameters do not satisfy the schema validation rules")
 )
 
 // Error codes for API responses
@@ -54,7 +63,16 @@ const (
 	CodeComponentReleaseNotFound   = "COMPONENT_RELEASE_NOT_FOUND"
 	CodeReleaseBindingNotFound     = "RELEASE_BINDING_NOT_FOUND"
 	CodeReleaseNotFound            = "RELEASE_NOT_FOUND"
+	CodeReleaseRenderFailed        = "RELEASE_RENDER_FAILED"
+	CodeNoPreviousRelease          = "NO_PREVIOUS_RELEASE"
+	CodeReleaseAlreadyBound        = "RELEASE_ALREADY_BOUND"
+	CodePromotionApprovalRequired  = "PROMOTION_APPROVAL_REQUIRED"
+	CodeApprovalNotRequired        = "APPROVAL_NOT_REQUIRED"
+	CodePromotionRequestNotFound   = "PROMOTION_REQUEST_NOT_FOUND"
+	CodePromotionRequestNotPending = "PROMOTION_REQUEST_NOT_PENDING"
+	CodeApproverNotAllowed         = "APPROVER_NOT_ALLOWED"
 	CodeInvalidInput               = "INVALID_INPUT"
 	CodeInternalError              = "INTERNAL_ERROR"
 	CodeWorkflowSchemaInvalid      = "WORKFLOW_SCHEMA_INVALID"
+	CodeInvalidParameters          = "INVALID_PARAMETERS"
 )
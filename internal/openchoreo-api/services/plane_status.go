@@ -0,0 +1,65 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// planeStatus summarizes the conditions of a dataplane or buildplane, which is ready only when all of
+// its conditions are true.
+func planeStatus(conditions []metav1.Condition) string {
+	if len(conditions) == 0 {
+		return statusUnknown
+	}
+	for _, condition := range conditions {
+		if condition.Status != metav1.ConditionTrue {
+			return statusNotReady
+		}
+	}
+	return statusReady
+}
+
+// toClusterStatusResponse converts the probed cluster status of a dataplane or buildplane
+func toClusterStatusResponse(cluster *openchoreov1alpha1.ClusterStatus) *models.ClusterStatusResponse {
+	if cluster == nil {
+		return nil
+	}
+	response := &models.ClusterStatusResponse{
+		ServerVersion: cluster.ServerVersion,
+	}
+	for _, capability := range cluster.Capabilities {
+		response.Capabilities = append(response.Capabilities, models.ClusterCapabilityResponse{
+			Name:             capability.Name,
+			Available:        capability.Available,
+			MissingResources: capability.MissingResources,
+		})
+	}
+	if cluster.LastProbeTime != nil {
+		lastProbeTime := cluster.LastProbeTime.Time
+		response.LastProbeTime = &lastProbeTime
+	}
+	return response
+}
+
+// toConditionResponses converts the status conditions of a resource
+func toConditionResponses(conditions []metav1.Condition) []models.ConditionResponse {
+	if len(conditions) == 0 {
+		return nil
+	}
+	responses := make([]models.ConditionResponse, 0, len(conditions))
+	for _, condition := range conditions {
+		responses = append(responses, models.ConditionResponse{
+			Type:               condition.Type,
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		})
+	}
+	return responses
+}
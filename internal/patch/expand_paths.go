@@ -8,12 +8,43 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 // filterExpr recognizes the `[?(@.field=='value')]` selectors used in array filter expressions.
-// The pattern captures the field path (group 1) and the expected value (group 2).
+// The pattern captures the field path (group 1) and the expected value, quoted with single
+// (group 2) or double quotes (group 3). The value is a single literal without the quote.
 // Example: `[?(@.name=='app')]` matches items where the 'name' field equals 'app'.
-var filterExpr = regexp.MustCompile(`^@\.([A-Za-z0-9_.-]+)\s*==\s*['"](.*)['"]$`)
+//
+// These equality filters are matched without CEL so that they keep their lenient comparison
+// of the string form of the field value. All other filters, including compound conditions like
+// `@.name=='a' || @.name=='b'`, are evaluated as CEL predicates.
+var filterExpr = regexp.MustCompile(`^@\.([\w.-]+)\s*==\s*(?:'([^']*)'|"([^"]*)")$`)
+
+// filterVariable is the CEL variable bound to the array element when evaluating a filter predicate.
+// References to the current element (@) are rewritten to this variable before evaluation.
+const filterVariable = "self"
+
+// defaultFilterEngine evaluates CEL filter predicates when no engine is given.
+// It has the same functions available to templates and the default cost limits.
+var defaultFilterEngine = template.NewEngine()
+
+// filterEvaluator evaluates CEL filter predicates with an engine and the render options of the
+// patch operations, such as the budget of the render they belong to.
+type filterEvaluator struct {
+	engine *template.Engine
+	opts   []template.RenderOption
+}
+
+// evaluate evaluates a filter predicate with the filter variable bound to the item.
+func (f filterEvaluator) evaluate(expr string, item any) (any, error) {
+	engine := f.engine
+	if engine == nil {
+		engine = defaultFilterEngine
+	}
+	return engine.Evaluate(expr, map[string]any{filterVariable: item}, f.opts...)
+}
 
 // pathState represents a single location within the document tree during path expansion.
 // As we traverse the path, we maintain both the JSON Pointer segments and the actual
@@ -38,7 +69,7 @@ type pathState struct {
 //
 // The algorithm maintains a set of possible states as it processes each segment,
 // allowing filters to fan out into multiple parallel paths.
-func expandPaths(root map[string]any, rawPath string) ([]string, error) {
+func expandPaths(root map[string]any, rawPath string, filters filterEvaluator) ([]string, error) {
 	if rawPath == "" {
 		return []string{""}, nil
 	}
@@ -58,7 +89,7 @@ func expandPaths(root map[string]any, rawPath string) ([]string, error) {
 		// Expand each current state by applying this segment
 		nextStates := make([]pathState, 0, len(states))
 		for _, st := range states {
-			expanded, err := applySegment(st, segment)
+			expanded, err := applySegment(st, segment, filters)
 			if err != nil {
 				return nil, err
 			}
@@ -94,7 +125,7 @@ func expandPaths(root map[string]any, rawPath string) ([]string, error) {
 // because brackets may be nested or combined in complex ways.
 //
 // Returns a slice of states representing all possible locations after traversing this segment.
-func applySegment(state pathState, segment string) ([]pathState, error) {
+func applySegment(state pathState, segment string, filters filterEvaluator) ([]pathState, error) {
 	current := []pathState{state}
 	remaining := segment
 
@@ -102,7 +133,7 @@ func applySegment(state pathState, segment string) ([]pathState, error) {
 	for len(remaining) > 0 {
 		if strings.HasPrefix(remaining, "[") {
 			// Extract bracket content: [...]
-			closeIdx := strings.Index(remaining, "]")
+			closeIdx := findClosingBracket(remaining)
 			if closeIdx == -1 {
 				return nil, fmt.Errorf("unclosed bracket segment in %q", segment)
 			}
@@ -115,7 +146,7 @@ func applySegment(state pathState, segment string) ([]pathState, error) {
 			case strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"):
 				// Array filter: [?(@.field=='value')]
 				expr := content[2 : len(content)-1]
-				current, err = applyFilter(current, expr)
+				current, err = applyFilter(current, expr, filters)
 			case content == "-":
 				// Append marker: [-]
 				current = applyDash(current)
@@ -232,9 +263,7 @@ func applyDash(states []pathState) []pathState {
 // This allows a single filter to fan out into multiple paths. For example,
 // if containers = [{name: "app"}, {name: "sidecar"}, {name: "app"}],
 // then [?(@.name=='app')] produces two states: [0] and [2].
-//
-// Note: Filters are evaluated using simple field lookups, not CEL, for simplicity.
-func applyFilter(states []pathState, expr string) ([]pathState, error) {
+func applyFilter(states []pathState, expr string, filters filterEvaluator) ([]pathState, error) {
 	next := []pathState{}
 	for _, st := range states {
 		arr, ok := st.value.([]any)
@@ -243,7 +272,7 @@ func applyFilter(states []pathState, expr string) ([]pathState, error) {
 			continue
 		}
 		for idx, item := range arr {
-			match, err := matchesFilter(item, expr)
+			match, err := matchesFilter(item, expr, filters)
 			if err != nil {
 				return nil, err
 			}
@@ -260,18 +289,25 @@ func applyFilter(states []pathState, expr string) ([]pathState, error) {
 
 // matchesFilter tests if an item matches a filter expression.
 //
-// Currently supports only equality filters of the form: @.field.path=='value'
-// The field path can contain dots for nested fields: @.metadata.labels.app=='web'
+// Equality filters of the form @.field.path=='value' are matched by comparing the string form
+// of the field value. The field path can contain dots for nested fields: @.metadata.labels.app=='web'
 //
-// Returns false (without error) if the field path doesn't exist or types don't match.
-func matchesFilter(item any, expr string) (bool, error) {
-	matches := filterExpr.FindStringSubmatch(strings.TrimSpace(expr))
-	if len(matches) != 3 {
-		return false, fmt.Errorf("unsupported filter expression: %s", expr)
+// Any other filter is evaluated as a CEL predicate with @ bound to the item, for example:
+//
+//	@.name.startsWith('app-')
+//	@.ports.exists(p, p.containerPort == 8080)
+//	has(@.resources) && !('limits' in @.resources)
+//
+// Returns false (without error) if a referenced field doesn't exist.
+func matchesFilter(item any, expr string, filters filterEvaluator) (bool, error) {
+	expr = strings.TrimSpace(expr)
+	matches := filterExpr.FindStringSubmatch(expr)
+	if matches == nil {
+		return matchesCELFilter(item, expr, filters)
 	}
 
 	fieldPath := strings.Split(matches[1], ".")
-	expected := matches[2]
+	expected := matches[2] + matches[3]
 
 	// Navigate through nested fields
 	current := item
@@ -295,6 +331,80 @@ func matchesFilter(item any, expr string) (bool, error) {
 	return fmt.Sprintf("%v", current) == expected, nil
 }
 
+// matchesCELFilter evaluates a filter expression as a CEL predicate against an item.
+func matchesCELFilter(item any, expr string, filters filterEvaluator) (bool, error) {
+	result, err := filters.evaluate(rewriteFilterExpression(expr), item)
+	if err != nil {
+		// Items without the referenced fields don't match, like with equality filters
+		if template.IsMissingDataError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("invalid filter expression %q: %w", expr, err)
+	}
+
+	match, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("filter expression %q must evaluate to boolean, got %T", expr, result)
+	}
+	return match, nil
+}
+
+// rewriteFilterExpression replaces references to the current item (@) outside of string
+// literals with the CEL filter variable, e.g. "@.name == 'a@b'" becomes "self.name == 'a@b'".
+func rewriteFilterExpression(expr string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case quote != 0:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(expr) {
+				i++
+				b.WriteByte(expr[i])
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			b.WriteByte(c)
+		case c == '@':
+			b.WriteString(filterVariable)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// findClosingBracket returns the index of the "]" closing the "[" at the start of s, or -1.
+// Brackets nested in filter expressions (e.g. @.ports[0]) and brackets in string literals are skipped.
+func findClosingBracket(s string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // splitRawPath splits a path expression into segments and unescapes RFC 6901 sequences.
 // This is used during path expansion to parse user input paths with advanced features
 // like array filters and special syntax.
@@ -303,8 +413,39 @@ func matchesFilter(item any, expr string) (bool, error) {
 // can be used directly as map keys. For example:
 //
 //	"/metadata/annotations/app.kubernetes.io~1name" becomes ["metadata", "annotations", "app.kubernetes.io/name"]
+//
+// Unlike JSON Pointers, a "/" inside brackets does not separate segments, so filter
+// expressions such as [?(@.image.startsWith('registry.io/'))] don't need escaping.
 func splitRawPath(path string) []string {
-	return splitAndUnescapePath(path)
+	if !strings.Contains(path, "[") {
+		return splitAndUnescapePath(path)
+	}
+
+	trimmed := strings.TrimPrefix(path, "/")
+	segments := []string{}
+	start, depth := 0, 0
+	var quote byte
+	for i := 0; i < len(trimmed); i++ {
+		c := trimmed[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case depth > 0 && (c == '\'' || c == '"'):
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case c == '/' && depth == 0:
+			segments = append(segments, unescapePointerSegment(trimmed[start:i]))
+			start = i + 1
+		}
+	}
+	return append(segments, unescapePointerSegment(trimmed[start:]))
 }
 
 // appendPointer creates a new pointer slice with an additional segment.
@@ -5,6 +5,7 @@ package patch
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"sigs.k8s.io/yaml"
@@ -30,6 +31,26 @@ spec:
           env: []
 `
 
+	celRoot := `
+spec:
+  containers:
+    - name: app-web
+      image: registry.io/web:v1
+      ports:
+        - name: http
+          containerPort: 8080
+    - name: app-metrics
+      image: registry.io/metrics:v1
+      labels:
+        sidecar: "true"
+      ports:
+        - name: metrics
+          containerPort: 9090
+    - name: proxy
+      image: docker.io/envoy:v1
+      owner: team@example.com
+`
+
 	tests := []struct {
 		name string
 		root string
@@ -153,6 +174,97 @@ spec:
 				"/spec/items/0",
 			},
 		},
+		{
+			name: "CEL filter with startsWith",
+			root: celRoot,
+			path: "/spec/containers/[?(@.name.startsWith('app-'))]/image",
+			want: []string{
+				"/spec/containers/0/image",
+				"/spec/containers/1/image",
+			},
+		},
+		{
+			name: "CEL filter with exists macro",
+			root: celRoot,
+			path: "/spec/containers/[?(@.ports.exists(p, p.containerPort == 8080))]",
+			want: []string{
+				"/spec/containers/0",
+			},
+		},
+		{
+			name: "CEL filter on port range skips items without the field",
+			root: celRoot,
+			path: "/spec/containers/[?(@.ports.exists(p, p.containerPort >= 9000 && p.containerPort < 10000))]",
+			want: []string{
+				"/spec/containers/1",
+			},
+		},
+		{
+			name: "CEL filter on absent label",
+			root: celRoot,
+			path: "/spec/containers/[?(!has(@.labels) || !('sidecar' in @.labels))]",
+			want: []string{
+				"/spec/containers/0",
+				"/spec/containers/2",
+			},
+		},
+		{
+			name: "CEL filter with compound condition and unescaped slash",
+			root: celRoot,
+			path: "/spec/containers/[?(@.image.startsWith('registry.io/') && @.name != 'app-web')]/image",
+			want: []string{
+				"/spec/containers/1/image",
+			},
+		},
+		{
+			name: "CEL filter with nested index",
+			root: celRoot,
+			path: "/spec/containers/[?(@.ports[0].name == 'metrics')]",
+			want: []string{
+				"/spec/containers/1",
+			},
+		},
+		{
+			name: "equality filters joined with or",
+			root: celRoot,
+			path: "/spec/containers/[?(@.name=='app-web' || @.name=='proxy')]",
+			want: []string{
+				"/spec/containers/0",
+				"/spec/containers/2",
+			},
+		},
+		{
+			name: "equality filters joined with and",
+			root: celRoot,
+			path: "/spec/containers/[?(@.name=='app-metrics' && @.image=='registry.io/metrics:v1')]",
+			want: []string{
+				"/spec/containers/1",
+			},
+		},
+		{
+			name: "equality filters joined with and skip items without the field",
+			root: celRoot,
+			path: "/spec/containers/[?(@.owner=='team@example.com' && @.name=='proxy')]",
+			want: []string{
+				"/spec/containers/2",
+			},
+		},
+		{
+			name: "equality filter with double quotes",
+			root: celRoot,
+			path: `/spec/containers/[?(@.name=="proxy")]`,
+			want: []string{
+				"/spec/containers/2",
+			},
+		},
+		{
+			name: "CEL filter with @ in string literal",
+			root: celRoot,
+			path: "/spec/containers/[?(@.owner == 'team@example.com')]",
+			want: []string{
+				"/spec/containers/2",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -164,7 +276,7 @@ spec:
 				t.Fatalf("failed to unmarshal root YAML: %v", err)
 			}
 
-			got, err := expandPaths(root, tt.path)
+			got, err := expandPaths(root, tt.path, filterEvaluator{})
 			if err != nil {
 				t.Fatalf("expandPaths error = %v", err)
 			}
@@ -176,6 +288,57 @@ spec:
 	}
 }
 
+func TestExpandPathsFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	root := map[string]any{
+		"containers": []any{
+			map[string]any{"name": "app", "port": int64(8080)},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{
+			name:    "syntax error",
+			path:    "/containers/[?(@.name ==)]",
+			wantErr: `invalid filter expression "@.name =="`,
+		},
+		{
+			name:    "non-boolean result",
+			path:    "/containers/[?(@.name)]",
+			wantErr: `filter expression "@.name" must evaluate to boolean, got string`,
+		},
+		{
+			name:    "type error",
+			path:    "/containers/[?(@.port.startsWith('80'))]",
+			wantErr: `invalid filter expression "@.port.startsWith('80')"`,
+		},
+		{
+			name:    "unterminated string",
+			path:    "/containers/[?(@.name == 'app)]",
+			wantErr: "unclosed bracket segment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := expandPaths(root, tt.path, filterEvaluator{})
+			if err == nil {
+				t.Fatalf("expandPaths(%q) expected error", tt.path)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expandPaths(%q) error = %v, want it to contain %q", tt.path, err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func cmpDiffStrings(want, got []string) string {
 	if len(want) != len(got) {
 		return fmt.Sprintf("length mismatch: want %d, got %d (%v)", len(want), len(got), got)
@@ -3,7 +3,12 @@
 
 package patch
 
-import "strings"
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
 
 // splitPointer parses a JSON Pointer string into segments, unescaping each one.
 // This is used when executing RFC 6902 operations on already-expanded JSON Pointers.
@@ -20,11 +25,11 @@ func splitPointer(pointer string) []string {
 //   - "~0" represents "~"
 //   - "~1" represents "/"
 //
-// IMPORTANT: When using filter expressions in paths, any "/" or "~" characters in filter
-// values MUST be escaped. For example:
+// Filter expressions in user input paths are split with splitRawPath, which keeps "/" inside
+// brackets. Escaped filter values are still decoded, so both of these are equivalent:
 //
 //	/containers[?(@.url=='http:~1~1example.com')]/env
-//	                            ↑↑   each / escaped as ~1
+//	/containers[?(@.url=='http://example.com')]/env
 //
 // The append marker "-" doesn't contain escape sequences (it's a special RFC 6902 token),
 // but unescaping it is safe and returns "-" unchanged.
@@ -67,3 +72,50 @@ func unescapePointerSegment(seg string) string {
 	seg = strings.ReplaceAll(seg, "~0", "~")
 	return seg
 }
+
+// getValue returns the value at a JSON Pointer and whether it exists.
+func getValue(root map[string]any, pointer string) (any, bool) {
+	current := any(root)
+	for _, seg := range splitPointer(pointer) {
+		switch node := current.(type) {
+		case map[string]any:
+			child, exists := node[seg]
+			if !exists {
+				return nil, false
+			}
+			current = child
+		case []any:
+			index, err := strconv.Atoi(seg)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// valuesEqual compares two values by their JSON representation.
+// This makes numbers of different Go types, such as int64(1) and float64(1), compare equal.
+func valuesEqual(a, b any) bool {
+	normalizedA, errA := normalizeJSON(a)
+	normalizedB, errB := normalizeJSON(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+func normalizeJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
@@ -21,6 +21,18 @@ import (
 // nested structures. If both target and value have a key "nested" that contains an object,
 // value's "nested" object completely replaces target's "nested" object.
 func mergeShallowAtPointer(root map[string]any, pointer string, value map[string]any) error {
+	return mergeAtPointer(root, pointer, value, "mergeShallow", mergeShallowInto)
+}
+
+// mergeDeepAtPointer performs a deep merge at the location specified by the pointer.
+// It follows the same rules as mergeShallowAtPointer, but merges the maps with mergeDeepInto.
+func mergeDeepAtPointer(root map[string]any, pointer string, value map[string]any) error {
+	return mergeAtPointer(root, pointer, value, "mergeDeep", mergeDeepInto)
+}
+
+// mergeAtPointer merges value into the map at the location specified by the pointer,
+// setting the location to a copy of value if it doesn't hold a map.
+func mergeAtPointer(root map[string]any, pointer string, value map[string]any, op string, merge func(target, overlay map[string]any)) error {
 	parent, last, err := navigateToParent(root, pointer, true)
 	if err != nil {
 		return err
@@ -40,18 +52,18 @@ func mergeShallowAtPointer(root map[string]any, pointer string, value map[string
 			container[last] = clone.DeepCopy(value)
 			return nil
 		}
-		// Target is a map, perform shallow merge
-		mergeShallowInto(targetMap, value)
+		// Target is a map, perform the merge
+		merge(targetMap, value)
 	case []any:
 		if last == "-" {
-			return fmt.Errorf("mergeShallow operation cannot target append position '-'")
+			return fmt.Errorf("%s operation cannot target append position '-'", op)
 		}
 		index, err := strconv.Atoi(last)
 		if err != nil {
-			return fmt.Errorf("invalid array index %q for mergeShallow", last)
+			return fmt.Errorf("invalid array index %q for %s", last, op)
 		}
 		if index < 0 || index >= len(container) {
-			return fmt.Errorf("array index %d out of bounds for mergeShallow", index)
+			return fmt.Errorf("array index %d out of bounds for %s", index, op)
 		}
 		existing := container[index]
 		if existing == nil {
@@ -63,9 +75,9 @@ func mergeShallowAtPointer(root map[string]any, pointer string, value map[string
 			container[index] = clone.DeepCopy(value)
 			return nil
 		}
-		mergeShallowInto(targetMap, value)
+		merge(targetMap, value)
 	default:
-		return fmt.Errorf("mergeShallow parent must be object or array, got %T", parent)
+		return fmt.Errorf("%s parent must be object or array, got %T", op, parent)
 	}
 	return nil
 }
@@ -78,6 +90,72 @@ func mergeShallowInto(target map[string]any, overlay map[string]any) {
 	}
 }
 
+// mergeDeepInto recursively merges overlay into target, modifying target in-place.
+//
+//   - Nested maps are merged recursively
+//   - Lists of named objects are merged by name (see mergeNamedLists)
+//   - A nil value removes the key from target
+//   - Any other value replaces the value in target
+func mergeDeepInto(target map[string]any, overlay map[string]any) {
+	for k, v := range overlay {
+		switch overlayValue := v.(type) {
+		case nil:
+			delete(target, k)
+		case map[string]any:
+			if existing, ok := target[k].(map[string]any); ok && existing != nil {
+				mergeDeepInto(existing, overlayValue)
+				continue
+			}
+			target[k] = clone.DeepCopy(overlayValue)
+		case []any:
+			if existing, ok := target[k].([]any); ok && isNamedList(existing) && len(overlayValue) > 0 && isNamedList(overlayValue) {
+				target[k] = mergeNamedLists(existing, overlayValue)
+				continue
+			}
+			target[k] = clone.DeepCopy(overlayValue)
+		default:
+			target[k] = clone.DeepCopy(v)
+		}
+	}
+}
+
+// mergeNamedLists merges two lists of objects keyed by their "name" field, the way strategic
+// merge patch merges containers, volumes and env. Items of the overlay with a name that exists
+// in the target are merged into the existing item, while other items are appended in order.
+func mergeNamedLists(target, overlay []any) []any {
+	merged := append(make([]any, 0, len(target)+len(overlay)), target...)
+	indexByName := make(map[string]int, len(target))
+	for i, item := range target {
+		indexByName[item.(map[string]any)["name"].(string)] = i
+	}
+
+	for _, item := range overlay {
+		overlayItem := item.(map[string]any)
+		name := overlayItem["name"].(string)
+		if i, exists := indexByName[name]; exists {
+			mergeDeepInto(merged[i].(map[string]any), overlayItem)
+			continue
+		}
+		indexByName[name] = len(merged)
+		merged = append(merged, clone.DeepCopy(overlayItem))
+	}
+	return merged
+}
+
+// isNamedList checks if every item of a list is an object with a string "name" field.
+func isNamedList(list []any) bool {
+	for _, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		if _, ok := m["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // navigateToParent traverses all but the last segment of a pointer, returning the
 // parent container and the final segment name.
 //
@@ -4,17 +4,29 @@
 package patch
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"regexp"
 	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/clone"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 const (
 	opAdd     = "add"
 	opReplace = "replace"
 	opRemove  = "remove"
+	opMove    = "move"
+	opCopy    = "copy"
+	opTest    = "test"
 )
 
+// ErrTestFailed is returned when the value at the path of a test operation does not match.
+// Callers can check for it with errors.Is to make a patch conditional on its tests.
+var ErrTestFailed = errors.New("test operation failed")
+
 // filterPattern matches array filter expressions like [?(@.name=='app')]
 var filterPattern = regexp.MustCompile(`\[\?\(.*?\)\]`)
 
@@ -30,26 +42,61 @@ var filterPattern = regexp.MustCompile(`\[\?\(.*?\)\]`)
 // Those concerns are handled by higher-level orchestration code (e.g., trait processor).
 //
 // Supported operations:
-//   - add, replace, remove: standard RFC 6902 JSON Patch operations
+//   - add, replace, remove, move, copy, test: standard RFC 6902 JSON Patch operations
 //   - mergeShallow: custom operation that overlays map keys without deep merging
+//   - mergeDeep: custom operation that merges maps recursively and lists of objects by name
 //
 // Path expressions support:
 //   - Array filters: /containers[?(@.name=='app')]/env
+//   - CEL predicate filters: /containers[?(@.name.startsWith('app-'))]/env
 //   - Array indices: /containers/0/env
 //   - Append marker: /env/-
 //
-// The resource is modified in-place.
-func ApplyPatches(resource map[string]any, operations []JSONPatchOperation) error {
+// CEL predicate filters are evaluated with the given render options, e.g. against the budget of
+// the render the operations belong to, using an engine with the default cost limits.
+//
+// The resource is modified in-place. When the operations contain a test, they are applied
+// atomically: if any operation fails, including a test, the resource is left unchanged.
+func ApplyPatches(resource map[string]any, operations []JSONPatchOperation, opts ...template.RenderOption) error {
+	return ApplyPatchesWithEngine(nil, resource, operations, opts...)
+}
+
+// ApplyPatchesWithEngine applies patch operations like ApplyPatches, evaluating CEL predicate
+// filters with the given engine so that they are bound by its cost limits. A nil engine uses
+// an engine with the default cost limits.
+func ApplyPatchesWithEngine(engine *template.Engine, resource map[string]any, operations []JSONPatchOperation, opts ...template.RenderOption) error {
+	filters := filterEvaluator{engine: engine, opts: opts}
+	target := resource
+	atomic := containsTest(operations)
+	if atomic {
+		target = clone.DeepCopyMap(resource)
+	}
+
 	for i, operation := range operations {
-		if err := applyOperation(resource, operation); err != nil {
+		if err := applyOperation(target, operation, filters); err != nil {
 			return fmt.Errorf("operation #%d failed: %w", i, err)
 		}
 	}
+
+	if atomic {
+		clear(resource)
+		maps.Copy(resource, target)
+	}
 	return nil
 }
 
+// containsTest checks if any of the operations is a test operation.
+func containsTest(operations []JSONPatchOperation) bool {
+	for _, operation := range operations {
+		if strings.EqualFold(operation.Op, opTest) {
+			return true
+		}
+	}
+	return false
+}
+
 // applyOperation applies a single patch operation to a resource.
-func applyOperation(target map[string]any, operation JSONPatchOperation) error {
+func applyOperation(target map[string]any, operation JSONPatchOperation, filters filterEvaluator) error {
 	path := operation.Path
 	value := operation.Value
 
@@ -57,11 +104,19 @@ func applyOperation(target map[string]any, operation JSONPatchOperation) error {
 	op := strings.ToLower(operation.Op)
 	switch op {
 	case opAdd, opReplace, opRemove:
-		return applyRFC6902(target, op, path, value)
+		return applyRFC6902(target, op, path, value, filters)
+	case opMove:
+		return applyMove(target, operation.From, path, filters)
+	case opCopy:
+		return applyCopy(target, operation.From, path, filters)
+	case opTest:
+		return applyTest(target, path, value, filters)
 	case "mergeshallow":
-		return applyMergeShallow(target, path, value)
+		return applyMergeShallow(target, path, value, filters)
+	case "mergedeep":
+		return applyMergeDeep(target, path, value, filters)
 	default:
-		return fmt.Errorf("unsupported patch operation %q (supported: add, replace, remove, mergeShallow)", operation.Op)
+		return fmt.Errorf("unsupported patch operation %q (supported: add, replace, remove, move, copy, test, mergeShallow, mergeDeep)", operation.Op)
 	}
 }
 
@@ -78,9 +133,9 @@ func applyOperation(target map[string]any, operation JSONPatchOperation) error {
 //
 // Note: For map key traversal, expandPaths allows traversing through nil values,
 // so missing intermediate keys don't cause empty results. Those are handled by ensureParentExists.
-func applyRFC6902(target map[string]any, op, rawPath string, value any) error {
+func applyRFC6902(target map[string]any, op, rawPath string, value any, filters filterEvaluator) error {
 	// Expand paths to handle filters and special markers
-	resolved, err := expandPaths(target, rawPath)
+	resolved, err := expandPaths(target, rawPath, filters)
 	if err != nil {
 		return err
 	}
@@ -128,13 +183,13 @@ func containsFilter(path string) bool {
 //	existing: {a: {x: 1, y: 2}, b: 3}
 //	overlay:  {a: {z: 3}}
 //	result:   {a: {z: 3}, b: 3}  // note: a.x and a.y are gone
-func applyMergeShallow(target map[string]any, rawPath string, value any) error {
+func applyMergeShallow(target map[string]any, rawPath string, value any, filters filterEvaluator) error {
 	valueMap, ok := value.(map[string]any)
 	if !ok {
 		return fmt.Errorf("mergeShallow value must be an object")
 	}
 
-	resolved, err := expandPaths(target, rawPath)
+	resolved, err := expandPaths(target, rawPath, filters)
 	if err != nil {
 		return err
 	}
@@ -150,3 +205,121 @@ func applyMergeShallow(target map[string]any, rawPath string, value any) error {
 	}
 	return nil
 }
+
+// applyMergeDeep applies a deep merge operation, recursively merging nested maps.
+//
+// Lists of objects that all have a "name" field, such as containers, volumes and env,
+// are merged by name: items with a matching name are merged recursively and new items
+// are appended. Other lists are replaced. A null value removes the key from the target.
+//
+// Example:
+//
+//	existing: {containers: [{name: app, image: app:v1}], replicas: 1}
+//	overlay:  {containers: [{name: app, env: [...]}, {name: proxy, image: envoy}]}
+//	result:   {containers: [{name: app, image: app:v1, env: [...]}, {name: proxy, image: envoy}], replicas: 1}
+func applyMergeDeep(target map[string]any, rawPath string, value any, filters filterEvaluator) error {
+	valueMap, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("mergeDeep value must be an object")
+	}
+
+	resolved, err := expandPaths(target, rawPath, filters)
+	if err != nil {
+		return err
+	}
+	if len(resolved) == 0 {
+		// Nothing to merge into.
+		return nil
+	}
+
+	for _, pointer := range resolved {
+		if err := mergeDeepAtPointer(target, pointer, valueMap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyMove implements the "move" operation from RFC 6902.
+//
+// The value at from is removed and then added at path. Both paths must resolve to a
+// single location, and path is resolved after the removal so that array indices refer
+// to the array without the moved element.
+func applyMove(target map[string]any, rawFrom, rawPath string, filters filterEvaluator) error {
+	fromPointer, err := resolveSinglePath(target, rawFrom, "from", filters)
+	if err != nil {
+		return err
+	}
+	value, exists := getValue(target, fromPointer)
+	if !exists {
+		return fmt.Errorf("move operation failed: from %q does not exist", rawFrom)
+	}
+
+	if err := applyJSONPatch(target, opRemove, fromPointer, nil); err != nil {
+		return err
+	}
+
+	pointer, err := resolveSinglePath(target, rawPath, "path", filters)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(pointer, fromPointer+"/") {
+		return fmt.Errorf("move operation cannot move %q into one of its children %q", fromPointer, pointer)
+	}
+	if err := ensureParentExists(target, pointer); err != nil {
+		return err
+	}
+	return applyJSONPatch(target, opAdd, pointer, value)
+}
+
+// applyCopy implements the "copy" operation from RFC 6902.
+// The value at from, which must resolve to a single location, is added at every location of path.
+func applyCopy(target map[string]any, rawFrom, rawPath string, filters filterEvaluator) error {
+	fromPointer, err := resolveSinglePath(target, rawFrom, "from", filters)
+	if err != nil {
+		return err
+	}
+	value, exists := getValue(target, fromPointer)
+	if !exists {
+		return fmt.Errorf("copy operation failed: from %q does not exist", rawFrom)
+	}
+	return applyRFC6902(target, opAdd, rawPath, value, filters)
+}
+
+// applyTest implements the "test" operation from RFC 6902.
+//
+// The test succeeds if the value at every location of path equals the expected value.
+// Numbers are compared by value, so 1 and 1.0 are equal. A path that doesn't exist or a
+// filter that matches no elements fails the test. Failures wrap ErrTestFailed.
+func applyTest(target map[string]any, rawPath string, value any, filters filterEvaluator) error {
+	resolved, err := expandPaths(target, rawPath, filters)
+	if err != nil {
+		return err
+	}
+	if len(resolved) == 0 {
+		return fmt.Errorf("%w: path %q matched 0 elements", ErrTestFailed, rawPath)
+	}
+
+	for _, pointer := range resolved {
+		actual, exists := getValue(target, pointer)
+		if !exists {
+			return fmt.Errorf("%w: path %q does not exist", ErrTestFailed, pointer)
+		}
+		if !valuesEqual(actual, value) {
+			return fmt.Errorf("%w: value at %q does not match", ErrTestFailed, pointer)
+		}
+	}
+	return nil
+}
+
+// resolveSinglePath expands a path expression that must resolve to exactly one location.
+func resolveSinglePath(target map[string]any, rawPath, field string, filters filterEvaluator) (string, error) {
+	resolved, err := expandPaths(target, rawPath, filters)
+	if err != nil {
+		return "", err
+	}
+	if len(resolved) != 1 {
+		return "", fmt.Errorf("%s %q must resolve to a single location, matched %d", field, rawPath, len(resolved))
+	}
+	return resolved[0], nil
+}
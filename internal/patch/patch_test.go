@@ -4,11 +4,15 @@
 package patch
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 func TestApplyPatches(t *testing.T) {
@@ -437,6 +441,319 @@ spec:
 			},
 			wantErr: true,
 		},
+		{
+			name: "replace image using CEL filter",
+			initial: `
+spec:
+  containers:
+    - name: app
+      image: registry.io/app:v1
+      ports:
+        - containerPort: 8080
+    - name: sidecar
+      image: registry.io/sidecar:v1
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:    "replace",
+					Path:  "/spec/containers/[?(has(@.ports) && @.ports.exists(p, p.containerPort == 8080))]/image",
+					Value: "registry.io/app:v2",
+				},
+			},
+			want: `
+spec:
+  containers:
+    - name: app
+      image: registry.io/app:v2
+      ports:
+        - containerPort: 8080
+    - name: sidecar
+      image: registry.io/sidecar:v1
+`,
+		},
+		{
+			name: "invalid CEL filter should error",
+			initial: `
+spec:
+  containers:
+    - name: app
+      image: app:v1
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:    "replace",
+					Path:  "/spec/containers/[?(@.name.startsWith())]/image",
+					Value: "app:v2",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "move annotation to label",
+			initial: `
+metadata:
+  annotations:
+    team: payments
+  labels:
+    app: web
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "move",
+					From: "/metadata/annotations/team",
+					Path: "/metadata/labels/team",
+				},
+			},
+			want: `
+metadata:
+  annotations: {}
+  labels:
+    app: web
+    team: payments
+`,
+		},
+		{
+			name: "move array element resolves path after removal",
+			initial: `
+spec:
+  containers:
+    - name: init
+    - name: app
+    - name: sidecar
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "move",
+					From: "/spec/containers/[?(@.name=='init')]",
+					Path: "/spec/containers/-",
+				},
+			},
+			want: `
+spec:
+  containers:
+    - name: app
+    - name: sidecar
+    - name: init
+`,
+		},
+		{
+			name: "move into own child should error",
+			initial: `
+spec:
+  template:
+    metadata: {}
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "move",
+					From: "/spec/template",
+					Path: "/spec/template/copy",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy volume mount to all matching containers",
+			initial: `
+spec:
+  containers:
+    - name: app
+      role: worker
+      volumeMounts:
+        - name: shared
+          mountPath: /shared
+    - name: logger
+      role: worker
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "copy",
+					From: "/spec/containers/[?(@.name=='app')]/volumeMounts",
+					Path: "/spec/containers/[?(@.name=='logger')]/volumeMounts",
+				},
+			},
+			want: `
+spec:
+  containers:
+    - name: app
+      role: worker
+      volumeMounts:
+        - name: shared
+          mountPath: /shared
+    - name: logger
+      role: worker
+      volumeMounts:
+        - name: shared
+          mountPath: /shared
+`,
+		},
+		{
+			name: "copy from missing path should error",
+			initial: `
+spec: {}
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "copy",
+					From: "/spec/missing",
+					Path: "/spec/copy",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "passing test applies the remaining operations",
+			initial: `
+spec:
+  replicas: 1
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:    "test",
+					Path:  "/spec/replicas",
+					Value: int64(1),
+				},
+				{
+					Op:    "replace",
+					Path:  "/spec/replicas",
+					Value: 3,
+				},
+			},
+			want: `
+spec:
+  replicas: 3
+`,
+		},
+		{
+			name: "failed test should error",
+			initial: `
+spec:
+  replicas: 2
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:    "test",
+					Path:  "/spec/replicas",
+					Value: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "mergeDeep merges nested maps and named lists",
+			initial: `
+spec:
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+        - name: app
+          image: app:v1
+          env:
+            - name: A
+              value: "1"
+      volumes:
+        - name: config
+          configMap:
+            name: web-config
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "mergeDeep",
+					Path: "/spec/template",
+					Value: map[string]any{
+						"metadata": map[string]any{
+							"labels": map[string]any{"sidecar": "envoy"},
+						},
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{
+									"name": "app",
+									"env": []any{
+										map[string]any{"name": "A", "value": "2"},
+										map[string]any{"name": "B", "value": "3"},
+									},
+									"volumeMounts": []any{
+										map[string]any{"name": "shared", "mountPath": "/shared"},
+									},
+								},
+								map[string]any{
+									"name":  "envoy",
+									"image": "envoy:v1",
+									"volumeMounts": []any{
+										map[string]any{"name": "shared", "mountPath": "/shared"},
+									},
+								},
+							},
+							"volumes": []any{
+								map[string]any{"name": "shared", "emptyDir": map[string]any{}},
+							},
+						},
+					},
+				},
+			},
+			want: `
+spec:
+  template:
+    metadata:
+      labels:
+        app: web
+        sidecar: envoy
+    spec:
+      containers:
+        - name: app
+          image: app:v1
+          env:
+            - name: A
+              value: "2"
+            - name: B
+              value: "3"
+          volumeMounts:
+            - name: shared
+              mountPath: /shared
+        - name: envoy
+          image: envoy:v1
+          volumeMounts:
+            - name: shared
+              mountPath: /shared
+      volumes:
+        - name: config
+          configMap:
+            name: web-config
+        - name: shared
+          emptyDir: {}
+`,
+		},
+		{
+			name: "mergeDeep replaces unnamed lists and removes null keys",
+			initial: `
+spec:
+  args: ["--a", "--b"]
+  debug: true
+  nested:
+    keep: 1
+`,
+			operations: []JSONPatchOperation{
+				{
+					Op:   "mergeDeep",
+					Path: "/spec",
+					Value: map[string]any{
+						"args":   []any{"--c"},
+						"debug":  nil,
+						"nested": map[string]any{"added": 2},
+					},
+				},
+			},
+			want: `
+spec:
+  args: ["--c"]
+  nested:
+    keep: 1
+    added: 2
+`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -473,6 +790,105 @@ spec:
 	}
 }
 
+func TestApplyPatchesTestFailureIsAtomic(t *testing.T) {
+	t.Parallel()
+
+	resource := map[string]any{
+		"metadata": map[string]any{"name": "web"},
+		"spec":     map[string]any{"replicas": float64(2)},
+	}
+	operations := []JSONPatchOperation{
+		{Op: "add", Path: "/metadata/labels", Value: map[string]any{"patched": "true"}},
+		{Op: "test", Path: "/spec/replicas", Value: 1},
+		{Op: "replace", Path: "/spec/replicas", Value: 3},
+	}
+
+	err := ApplyPatches(resource, operations)
+	if !errors.Is(err, ErrTestFailed) {
+		t.Fatalf("ApplyPatches error = %v, want ErrTestFailed", err)
+	}
+
+	want := map[string]any{
+		"metadata": map[string]any{"name": "web"},
+		"spec":     map[string]any{"replicas": float64(2)},
+	}
+	if diff := cmpDiff(want, resource); diff != "" {
+		t.Fatalf("resource was modified by a failed patch (-want +got):\n%s", diff)
+	}
+}
+
+func TestApplyPatchesEvaluatesFiltersAgainstTheBudget(t *testing.T) {
+	t.Parallel()
+
+	resource := map[string]any{
+		"spec": map[string]any{
+			"containers": []any{map[string]any{"name": "app-main"}},
+		},
+	}
+	operations := []JSONPatchOperation{
+		{Op: "add", Path: "/spec/containers[?(@.name.startsWith('app-'))]/image", Value: "app:v2"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := ApplyPatches(resource, operations, template.WithBudget(template.NewBudget(ctx)))
+	if !template.IsEvaluationBudgetError(err) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("ApplyPatches error = %v, want an interrupted filter evaluation", err)
+	}
+}
+
+func TestApplyPatchesWithEngineUsesTheEngineCostLimits(t *testing.T) {
+	t.Parallel()
+
+	engine := template.NewEngineWithOptions(template.WithCostLimits(template.CostLimits{
+		PerExpression: template.DefaultExpressionCostLimit,
+		PerRender:     5 * template.DefaultRenderCostLimit,
+	}))
+
+	// Spend more than the default per-render limit, but less than the configured one
+	items := make([]any, 400)
+	for i := range items {
+		items[i] = int64(i)
+	}
+	budget := template.NewBudget(context.Background())
+	for budget.Spent() <= template.DefaultRenderCostLimit {
+		if _, err := engine.Evaluate("items.all(x, items.all(y, y >= 0))", map[string]any{"items": items}, template.WithBudget(budget)); err != nil {
+			t.Fatalf("Evaluate() error = %v", err)
+		}
+	}
+
+	newResource := func() map[string]any {
+		return map[string]any{
+			"spec": map[string]any{
+				"containers": []any{map[string]any{"name": "app-main"}},
+			},
+		}
+	}
+	operations := []JSONPatchOperation{
+		{Op: "add", Path: "/spec/containers[?(@.name.startsWith('app-'))]/image", Value: "app:v2"},
+	}
+
+	resource := newResource()
+	if err := ApplyPatchesWithEngine(engine, resource, operations, template.WithBudget(budget)); err != nil {
+		t.Fatalf("ApplyPatchesWithEngine error = %v, want the filter to be evaluated against the configured per-render limit", err)
+	}
+	want := map[string]any{
+		"spec": map[string]any{
+			"containers": []any{map[string]any{"name": "app-main", "image": "app:v2"}},
+		},
+	}
+	if diff := cmpDiff(want, resource); diff != "" {
+		t.Fatalf("resource mismatch (-want +got):\n%s", diff)
+	}
+
+	// Without the engine, the filter is evaluated against the default per-render limit
+	err := ApplyPatches(newResource(), operations, template.WithBudget(budget))
+	if !template.IsEvaluationBudgetError(err) {
+		t.Fatalf("ApplyPatches error = %v, want the default per-render limit to be exceeded", err)
+	}
+}
+
 func cmpDiff(expected, actual map[string]any) string {
 	wantJSON, _ := json.Marshal(expected)
 	gotJSON, _ := json.Marshal(actual)
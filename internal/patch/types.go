@@ -7,5 +7,6 @@ package patch
 type JSONPatchOperation struct {
 	Op    string `yaml:"op"`
 	Path  string `yaml:"path"`
+	From  string `yaml:"from,omitempty"`
 	Value any    `yaml:"value,omitempty"`
 }
@@ -8,6 +8,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -196,6 +199,7 @@ spec:
 						},
 					},
 				},
+				"connections": map[string]any{},
 				"environment": map[string]any{
 					"name":  "dev",
 					"vhost": "api.example.com",
@@ -228,6 +232,44 @@ spec:
 			componentTypeYAML: "", // Empty to test nil
 			wantErr:           true,
 		},
+		{
+			name: "environment overrides violating schema validation rules",
+			componentYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: test-component
+spec:
+  type: service
+  parameters:
+    minReplicas: 2
+`,
+			componentTypeYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: ComponentType
+metadata:
+  name: service
+spec:
+  schema:
+    parameters:
+      minReplicas: "integer | default=1"
+    envOverrides:
+      maxReplicas: "integer | default=3"
+      $validations:
+        - rule: self.maxReplicas >= self.minReplicas
+`,
+			envSettingsYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: test-component-prod
+spec:
+  componentTypeEnvOverrides:
+    maxReplicas: 1
+`,
+			environment: "prod",
+			wantErr:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -447,6 +489,49 @@ spec:
 			traitYAML: "", // Empty to test nil
 			wantErr:   true,
 		},
+		{
+			name: "trait environment overrides violating schema validation rules",
+			traitYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: mysql-trait
+spec:
+  schema:
+    parameters:
+      size: "string | default=small"
+      storage: "integer | default=10"
+      $validations:
+        - rule: self.size != 'large' || self.storage >= 100
+          message: large databases need at least 100Gi of storage
+`,
+			componentYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: test-component
+spec:
+  type: service
+`,
+			instanceYAML: `
+name: mysql-trait
+instanceName: db-1
+parameters:
+  size: small
+`,
+			envSettingsYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: test-component-prod
+spec:
+  traitOverrides:
+    db-1:
+      size: large
+`,
+			environment: "prod",
+			wantErr:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -517,6 +602,52 @@ spec:
 	}
 }
 
+func TestValidateParameterRules(t *testing.T) {
+	input := &SchemaInput{
+		ParametersSchema: &runtime.RawExtension{Raw: []byte(`{"minReplicas": "integer | default=1"}`)},
+		EnvOverridesSchema: &runtime.RawExtension{Raw: []byte(`{
+			"maxReplicas": "integer | default=3",
+			"$validations": [{"rule": "self.maxReplicas >= self.minReplicas", "message": "maxReplicas must not be less than minReplicas", "fieldPath": ".maxReplicas"}]
+		}`)},
+	}
+	parameters := &runtime.RawExtension{Raw: []byte(`{"minReplicas": 2}`)}
+
+	tests := []struct {
+		name         string
+		envOverrides *runtime.RawExtension
+		wantErrors   []string
+	}{
+		{
+			name:         "overrides satisfy the rules",
+			envOverrides: &runtime.RawExtension{Raw: []byte(`{"maxReplicas": 4}`)},
+		},
+		{
+			name: "defaults satisfy the rules",
+		},
+		{
+			name:         "overrides violate the rules",
+			envOverrides: &runtime.RawExtension{Raw: []byte(`{"maxReplicas": 1}`)},
+			wantErrors:   []string{`componentTypeEnvOverrides.maxReplicas: Invalid value: "object": maxReplicas must not be less than minReplicas`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := ValidateParameterRules(field.NewPath("componentTypeEnvOverrides"), input, parameters, tt.envOverrides)
+			if err != nil {
+				t.Fatalf("ValidateParameterRules() error = %v", err)
+			}
+			got := make([]string, 0, len(errs))
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if diff := cmp.Diff(tt.wantErrors, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("ValidateParameterRules() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDeepMerge(t *testing.T) {
 	tests := []struct {
 		name     string
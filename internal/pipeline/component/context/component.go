@@ -9,6 +9,7 @@ import (
 
 	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -73,6 +74,9 @@ func BuildComponentContext(input *ComponentContextInput) (map[string]any, error)
 	}
 
 	parameters = schema.ApplyDefaults(parameters, structural)
+	if err := validateParameterRules(parameters, structural); err != nil {
+		return nil, err
+	}
 	ctx["parameters"] = parameters
 
 	workload := input.Workload
@@ -93,6 +97,13 @@ func BuildComponentContext(input *ComponentContextInput) (map[string]any, error)
 		if len(configurations) > 0 {
 			ctx["configurations"] = configurations
 		}
+
+		connections, err := extractConnections(input.SecretReferences, input.ConnectionEndpoints, workload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract connections: %w", err)
+		}
+		// Connections are always set, so that templates can iterate over them without checking for them
+		ctx["connections"] = connections
 	}
 
 	componentMeta := map[string]any{
@@ -293,6 +304,39 @@ func BuildStructuralSchema(input *SchemaInput) (*apiextschema.Structural, error)
 	return structural, nil
 }
 
+// ValidateParameterRules resolves parameters the way they are resolved for rendering, by merging the
+// environment overrides and applying the schema defaults, and evaluates the $validations rules of the
+// schema against them. Rule failures are reported with paths rooted at fldPath.
+//
+// It lets callers reject overrides that would fail rendering before they are stored, such as
+// the ReleaseBinding PATCH endpoint of the API.
+func ValidateParameterRules(fldPath *field.Path, input *SchemaInput, parameters, envOverrides *runtime.RawExtension) (field.ErrorList, error) {
+	structural, err := BuildStructuralSchema(input)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := extractParameters(parameters)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := extractParameters(envOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	values = schema.ApplyDefaults(deepMerge(values, overrides), structural)
+	return schema.ValidateRules(fldPath, values, structural), nil
+}
+
+// validateParameterRules evaluates the $validations rules of the schema against resolved parameters.
+func validateParameterRules(parameters map[string]any, structural *apiextschema.Structural) error {
+	if errs := schema.ValidateRules(field.NewPath("parameters"), parameters, structural); len(errs) > 0 {
+		return fmt.Errorf("parameters do not satisfy the schema validation rules: %w", errs.ToAggregate())
+	}
+	return nil
+}
+
 // deepMerge recursively merges two parameter maps with override precedence.
 //
 // This function implements the parameter precedence model for ComponentDeployments:
@@ -0,0 +1,278 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"text/template"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// extractConnections extracts the connections of the workload and organizes them by connection name.
+// Each connection exposes its type, its properties, and the env vars and files to inject separated
+// into configs, whose values are rendered from the connection properties, and secrets, which are
+// resolved from the SecretReference holding the connection credentials.
+// Example structure: {"orders-db": {"type": "database", "host": "...", "port": 5432, "url": "...",
+// "configs": {"envs": [...], "files": [...]}, "secrets": {"envs": [...], "files": [...]}}}
+//
+// API connections expose their params, and the address of their endpoint once it is resolved by the
+// controller. API connections without a resolved endpoint are not injected.
+func extractConnections(
+	secretReferences map[string]*v1alpha1.SecretReference,
+	endpoints map[string]ConnectionEndpoint,
+	workload *v1alpha1.Workload,
+) (map[string]any, error) {
+	result := make(map[string]any)
+	if workload == nil {
+		return result, nil
+	}
+
+	for name, conn := range workload.Spec.Connections {
+		properties, credentials := connectionProperties(conn)
+		endpoint, resolved := endpoints[name]
+		if conn.Type == v1alpha1.ConnectionTypeAPI && resolved {
+			for key, value := range endpoint.properties() {
+				properties[key] = value
+			}
+		}
+
+		configs := map[string]any{
+			"envs":  make([]any, 0),
+			"files": make([]any, 0),
+		}
+		secrets := map[string]any{
+			"envs":  make([]any, 0),
+			"files": make([]any, 0),
+		}
+
+		if conn.Type != v1alpha1.ConnectionTypeAPI || resolved {
+			for _, env := range conn.Inject.Env {
+				if env.SecretKey != "" {
+					remoteRef, err := resolveConnectionSecret(secretReferences, credentials, env.SecretKey)
+					if err != nil {
+						return nil, fmt.Errorf("connection %q: env %q: %w", name, env.Name, err)
+					}
+					secrets["envs"] = append(secrets["envs"].([]any), map[string]any{
+						"name":      env.Name,
+						"remoteRef": remoteRef,
+					})
+					continue
+				}
+				value, err := renderConnectionValue(env.Value, properties)
+				if err != nil {
+					return nil, fmt.Errorf("connection %q: env %q: %w", name, env.Name, err)
+				}
+				configs["envs"] = append(configs["envs"].([]any), map[string]any{
+					"name":  env.Name,
+					"value": value,
+				})
+			}
+
+			for _, file := range conn.Inject.Files {
+				if file.SecretKey != "" {
+					remoteRef, err := resolveConnectionSecret(secretReferences, credentials, file.SecretKey)
+					if err != nil {
+						return nil, fmt.Errorf("connection %q: file %q: %w", name, file.Key, err)
+					}
+					secrets["files"] = append(secrets["files"].([]any), map[string]any{
+						"name":      file.Key,
+						"mountPath": file.MountPath,
+						"remoteRef": remoteRef,
+					})
+					continue
+				}
+				value, err := renderConnectionValue(file.Value, properties)
+				if err != nil {
+					return nil, fmt.Errorf("connection %q: file %q: %w", name, file.Key, err)
+				}
+				configs["files"] = append(configs["files"].([]any), map[string]any{
+					"name":      file.Key,
+					"mountPath": file.MountPath,
+					"value":     value,
+				})
+			}
+		}
+
+		connection := make(map[string]any, len(properties)+3)
+		for key, value := range properties {
+			connection[key] = value
+		}
+		connection["type"] = conn.Type
+		connection["configs"] = configs
+		connection["secrets"] = secrets
+		result[name] = connection
+	}
+
+	return result, nil
+}
+
+// connectionProperties returns the properties of a connection that its injected values can refer
+// to, and the credentials of the connection if any.
+func connectionProperties(conn v1alpha1.WorkloadConnection) (map[string]any, *v1alpha1.ConnectionCredentials) {
+	switch {
+	case conn.Type == v1alpha1.ConnectionTypeDatabase && conn.Database != nil:
+		db := conn.Database
+		hostPort := net.JoinHostPort(db.Host, strconv.Itoa(int(db.Port)))
+		properties := map[string]any{
+			"engine": db.Engine,
+			"host":   db.Host,
+			"port":   int64(db.Port),
+			"name":   db.Name,
+			"url":    hostPort + "/" + db.Name,
+		}
+		if db.Engine != "" {
+			properties["url"] = (&url.URL{Scheme: db.Engine, Host: hostPort, Path: "/" + db.Name}).String()
+		}
+		return properties, db.Credentials
+	case conn.Type == v1alpha1.ConnectionTypeQueue && conn.Queue != nil:
+		return map[string]any{
+			"brokerURL": conn.Queue.BrokerURL,
+			"topic":     conn.Queue.Topic,
+			"url":       conn.Queue.BrokerURL,
+		}, conn.Queue.Credentials
+	case conn.Type == v1alpha1.ConnectionTypeExternal && conn.External != nil:
+		properties := map[string]any{
+			"url": conn.External.URL,
+		}
+		if u, err := url.Parse(conn.External.URL); err == nil {
+			properties["scheme"] = u.Scheme
+			properties["host"] = u.Hostname()
+			properties["path"] = u.Path
+			// The port is only set when the URL has one, like with the other connection types it is a number
+			if port, err := strconv.ParseInt(u.Port(), 10, 64); err == nil {
+				properties["port"] = port
+			}
+		}
+		return properties, conn.External.Credentials
+	default:
+		properties := make(map[string]any, len(conn.Params))
+		for key, value := range conn.Params {
+			properties[key] = value
+		}
+		return properties, nil
+	}
+}
+
+// URL returns the URL of the endpoint. The port is omitted when it is the default port of the scheme,
+// and endpoints without a scheme, such as TCP endpoints, are addressed as host:port.
+func (e ConnectionEndpoint) URL() string {
+	if e.Scheme == "" {
+		return net.JoinHostPort(e.Host, strconv.Itoa(int(e.Port)))
+	}
+	host := e.Host
+	if e.Port != 0 && !isDefaultPort(e.Scheme, e.Port) {
+		host = net.JoinHostPort(e.Host, strconv.Itoa(int(e.Port)))
+	}
+	return (&url.URL{Scheme: e.Scheme, Host: host, Path: e.BasePath}).String()
+}
+
+// ParseConnectionEndpoint parses the URL of an endpoint, as returned by ConnectionEndpoint.URL, back into
+// the endpoint reached through the given visibility level.
+func ParseConnectionEndpoint(rawURL string, visibility v1alpha1.ConnectionVisibility) (ConnectionEndpoint, error) {
+	endpoint := ConnectionEndpoint{Visibility: visibility}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		// Endpoints without a scheme are addressed as host:port
+		host, port, splitErr := net.SplitHostPort(rawURL)
+		if splitErr != nil {
+			return ConnectionEndpoint{}, fmt.Errorf("invalid endpoint URL %q: %w", rawURL, splitErr)
+		}
+		endpoint.Host = host
+		parsed, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return ConnectionEndpoint{}, fmt.Errorf("invalid port in endpoint URL %q: %w", rawURL, err)
+		}
+		endpoint.Port = int32(parsed)
+		return endpoint, nil
+	}
+
+	endpoint.Scheme = u.Scheme
+	endpoint.Host = u.Hostname()
+	endpoint.BasePath = u.Path
+	if rawPort := u.Port(); rawPort != "" {
+		parsed, err := strconv.ParseInt(rawPort, 10, 32)
+		if err != nil {
+			return ConnectionEndpoint{}, fmt.Errorf("invalid port in endpoint URL %q: %w", rawURL, err)
+		}
+		endpoint.Port = int32(parsed)
+	} else {
+		endpoint.Port = defaultPort(u.Scheme)
+	}
+	return endpoint, nil
+}
+
+// properties returns the properties of a resolved API connection, named after the properties
+// available to the connections of ServiceBindings.
+func (e ConnectionEndpoint) properties() map[string]any {
+	return map[string]any{
+		"host":       e.Host,
+		"port":       int64(e.Port),
+		"scheme":     e.Scheme,
+		"basePath":   e.BasePath,
+		"uri":        e.URL(),
+		"url":        e.URL(),
+		"visibility": string(e.Visibility),
+	}
+}
+
+// defaultPort returns the default port of a scheme, or zero if the scheme has none.
+func defaultPort(scheme string) int32 {
+	switch scheme {
+	case "http", "ws":
+		return 80
+	case "https", "wss":
+		return 443
+	default:
+		return 0
+	}
+}
+
+func isDefaultPort(scheme string, port int32) bool {
+	defaultPort := defaultPort(scheme)
+	return defaultPort != 0 && port == defaultPort
+}
+
+// ConnectionCredentialsRef returns the name of the SecretReference holding the credentials of a
+// connection, or an empty string if the connection has no credentials.
+func ConnectionCredentialsRef(conn v1alpha1.WorkloadConnection) string {
+	if _, credentials := connectionProperties(conn); credentials != nil {
+		return credentials.SecretRef
+	}
+	return ""
+}
+
+// renderConnectionValue renders an injected value template against the connection properties.
+// Referring to a property the connection does not have is an error.
+func renderConnectionValue(value string, properties map[string]any) (string, error) {
+	tmpl, err := template.New("connection").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse value template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, properties); err != nil {
+		return "", fmt.Errorf("failed to render value template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// resolveConnectionSecret resolves a secret key of the connection credentials to remoteRef information.
+func resolveConnectionSecret(
+	secretReferences map[string]*v1alpha1.SecretReference,
+	credentials *v1alpha1.ConnectionCredentials,
+	secretKey string,
+) (map[string]any, error) {
+	if credentials == nil {
+		return nil, fmt.Errorf("secret key %q requires the connection credentials to be set", secretKey)
+	}
+	remoteRef := resolveSecretRef(secretReferences, &v1alpha1.SecretKeyRef{Name: credentials.SecretRef, Key: secretKey})
+	if remoteRef == nil {
+		return nil, fmt.Errorf("secret key %q not found in SecretReference %q", secretKey, credentials.SecretRef)
+	}
+	return remoteRef, nil
+}
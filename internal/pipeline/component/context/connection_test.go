@@ -0,0 +1,350 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestExtractConnections(t *testing.T) {
+	secretReferences := map[string]*v1alpha1.SecretReference{
+		"orders-db-credentials": {
+			Spec: v1alpha1.SecretReferenceSpec{
+				Data: []v1alpha1.SecretDataSource{
+					{
+						SecretKey: "password",
+						RemoteRef: v1alpha1.RemoteReference{Key: "secret/data/orders-db", Property: "password"},
+					},
+					{
+						SecretKey: "ca.crt",
+						RemoteRef: v1alpha1.RemoteReference{Key: "secret/data/orders-db", Property: "ca"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		connections map[string]v1alpha1.WorkloadConnection
+		endpoints   map[string]ConnectionEndpoint
+		want        map[string]any
+		wantErr     string
+	}{
+		{
+			name: "database connection with credentials",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"orders-db": {
+					Type: v1alpha1.ConnectionTypeDatabase,
+					Database: &v1alpha1.DatabaseConnection{
+						Engine:      "postgresql",
+						Host:        "orders.db.internal",
+						Port:        5432,
+						Name:        "orders",
+						Credentials: &v1alpha1.ConnectionCredentials{SecretRef: "orders-db-credentials"},
+					},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{
+							{Name: "DB_URL", Value: "{{ .url }}"},
+							{Name: "DB_HOST", Value: "{{ .host }}:{{ .port }}"},
+							{Name: "DB_PASSWORD", SecretKey: "password"},
+						},
+						Files: []v1alpha1.WorkloadConnectionFile{
+							{Key: "database.conf", MountPath: "/etc/db", Value: "name={{ .name }}"},
+							{Key: "ca.crt", MountPath: "/etc/db/tls", SecretKey: "ca.crt"},
+						},
+					},
+				},
+			},
+			want: map[string]any{
+				"orders-db": map[string]any{
+					"type":   "database",
+					"engine": "postgresql",
+					"host":   "orders.db.internal",
+					"port":   int64(5432),
+					"name":   "orders",
+					"url":    "postgresql://orders.db.internal:5432/orders",
+					"configs": map[string]any{
+						"envs": []any{
+							map[string]any{"name": "DB_URL", "value": "postgresql://orders.db.internal:5432/orders"},
+							map[string]any{"name": "DB_HOST", "value": "orders.db.internal:5432"},
+						},
+						"files": []any{
+							map[string]any{"name": "database.conf", "mountPath": "/etc/db", "value": "name=orders"},
+						},
+					},
+					"secrets": map[string]any{
+						"envs": []any{
+							map[string]any{
+								"name":      "DB_PASSWORD",
+								"remoteRef": map[string]any{"key": "secret/data/orders-db", "property": "password"},
+							},
+						},
+						"files": []any{
+							map[string]any{
+								"name":      "ca.crt",
+								"mountPath": "/etc/db/tls",
+								"remoteRef": map[string]any{"key": "secret/data/orders-db", "property": "ca"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "queue and external connections",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"events": {
+					Type:  v1alpha1.ConnectionTypeQueue,
+					Queue: &v1alpha1.QueueConnection{BrokerURL: "kafka://kafka:9092", Topic: "orders"},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{
+							{Name: "BROKER_URL", Value: "{{ .brokerURL }}"},
+							{Name: "TOPIC", Value: "{{ .topic }}"},
+						},
+					},
+				},
+				"payments": {
+					Type:     v1alpha1.ConnectionTypeExternal,
+					External: &v1alpha1.ExternalConnection{URL: "https://api.payments.example.com:8443/v2"},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{
+							{Name: "PAYMENTS_HOST", Value: "{{ .host }}"},
+						},
+					},
+				},
+				"webhooks": {
+					Type:     v1alpha1.ConnectionTypeExternal,
+					External: &v1alpha1.ExternalConnection{URL: "https://hooks.example.com/notify"},
+				},
+			},
+			want: map[string]any{
+				"events": map[string]any{
+					"type":      "queue",
+					"brokerURL": "kafka://kafka:9092",
+					"topic":     "orders",
+					"url":       "kafka://kafka:9092",
+					"configs": map[string]any{
+						"envs": []any{
+							map[string]any{"name": "BROKER_URL", "value": "kafka://kafka:9092"},
+							map[string]any{"name": "TOPIC", "value": "orders"},
+						},
+						"files": []any{},
+					},
+					"secrets": map[string]any{"envs": []any{}, "files": []any{}},
+				},
+				"payments": map[string]any{
+					"type":   "external",
+					"url":    "https://api.payments.example.com:8443/v2",
+					"scheme": "https",
+					"host":   "api.payments.example.com",
+					"port":   int64(8443),
+					"path":   "/v2",
+					"configs": map[string]any{
+						"envs": []any{
+							map[string]any{"name": "PAYMENTS_HOST", "value": "api.payments.example.com"},
+						},
+						"files": []any{},
+					},
+					"secrets": map[string]any{"envs": []any{}, "files": []any{}},
+				},
+				// The port is omitted when the URL has none
+				"webhooks": map[string]any{
+					"type":    "external",
+					"url":     "https://hooks.example.com/notify",
+					"scheme":  "https",
+					"host":    "hooks.example.com",
+					"path":    "/notify",
+					"configs": map[string]any{"envs": []any{}, "files": []any{}},
+					"secrets": map[string]any{"envs": []any{}, "files": []any{}},
+				},
+			},
+		},
+		{
+			name: "resolved api connection",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"inventory": {
+					Type:   v1alpha1.ConnectionTypeAPI,
+					Params: map[string]string{"projectName": "warehouse", "componentName": "inventory", "endpoint": "http"},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{
+							{Name: "INVENTORY_URL", Value: "{{ .url }}"},
+							{Name: "INVENTORY_HOST", Value: "{{ .host }}"},
+						},
+					},
+				},
+			},
+			endpoints: map[string]ConnectionEndpoint{
+				"inventory": {
+					Scheme:     "https",
+					Host:       "dev.choreoapis.example.com",
+					Port:       443,
+					BasePath:   "/warehouse/inventory",
+					Visibility: v1alpha1.ConnectionVisibilityPublic,
+				},
+			},
+			want: map[string]any{
+				"inventory": map[string]any{
+					"type":          "api",
+					"projectName":   "warehouse",
+					"componentName": "inventory",
+					"endpoint":      "http",
+					"host":          "dev.choreoapis.example.com",
+					"port":          int64(443),
+					"scheme":        "https",
+					"basePath":      "/warehouse/inventory",
+					"uri":           "https://dev.choreoapis.example.com/warehouse/inventory",
+					"url":           "https://dev.choreoapis.example.com/warehouse/inventory",
+					"visibility":    "public",
+					"configs": map[string]any{
+						"envs": []any{
+							map[string]any{"name": "INVENTORY_URL", "value": "https://dev.choreoapis.example.com/warehouse/inventory"},
+							map[string]any{"name": "INVENTORY_HOST", "value": "dev.choreoapis.example.com"},
+						},
+						"files": []any{},
+					},
+					"secrets": map[string]any{"envs": []any{}, "files": []any{}},
+				},
+			},
+		},
+		{
+			name: "unresolved api connection exposes its params",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"inventory": {
+					Type:   v1alpha1.ConnectionTypeAPI,
+					Params: map[string]string{"componentName": "inventory", "endpoint": "http"},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{
+							{Name: "INVENTORY_URL", Value: "{{ .url }}"},
+						},
+					},
+				},
+			},
+			want: map[string]any{
+				"inventory": map[string]any{
+					"type":          "api",
+					"componentName": "inventory",
+					"endpoint":      "http",
+					"configs":       map[string]any{"envs": []any{}, "files": []any{}},
+					"secrets":       map[string]any{"envs": []any{}, "files": []any{}},
+				},
+			},
+		},
+		{
+			name: "unknown property",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"events": {
+					Type:  v1alpha1.ConnectionTypeQueue,
+					Queue: &v1alpha1.QueueConnection{BrokerURL: "amqp://rabbitmq:5672", Topic: "orders"},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{{Name: "QUEUE_HOST", Value: "{{ .host }}"}},
+					},
+				},
+			},
+			wantErr: `connection "events": env "QUEUE_HOST": failed to render value template`,
+		},
+		{
+			name: "secret key without credentials",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"payments": {
+					Type:     v1alpha1.ConnectionTypeExternal,
+					External: &v1alpha1.ExternalConnection{URL: "https://api.payments.example.com"},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{{Name: "API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			},
+			wantErr: `secret key "apiKey" requires the connection credentials to be set`,
+		},
+		{
+			name: "secret key missing from the SecretReference",
+			connections: map[string]v1alpha1.WorkloadConnection{
+				"orders-db": {
+					Type: v1alpha1.ConnectionTypeDatabase,
+					Database: &v1alpha1.DatabaseConnection{
+						Host:        "orders.db.internal",
+						Port:        5432,
+						Name:        "orders",
+						Credentials: &v1alpha1.ConnectionCredentials{SecretRef: "orders-db-credentials"},
+					},
+					Inject: v1alpha1.WorkloadConnectionInject{
+						Env: []v1alpha1.WorkloadConnectionEnvVar{{Name: "DB_USER", SecretKey: "username"}},
+					},
+				},
+			},
+			wantErr: `secret key "username" not found in SecretReference "orders-db-credentials"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workload := &v1alpha1.Workload{
+				Spec: v1alpha1.WorkloadSpec{
+					WorkloadTemplateSpec: v1alpha1.WorkloadTemplateSpec{
+						Connections: tt.connections,
+					},
+				},
+			}
+
+			got, err := extractConnections(secretReferences, tt.endpoints, workload)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("extractConnections() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("extractConnections() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("extractConnections() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionCredentialsRef(t *testing.T) {
+	credentials := &v1alpha1.ConnectionCredentials{SecretRef: "broker-credentials"}
+
+	if got := ConnectionCredentialsRef(v1alpha1.WorkloadConnection{
+		Type:  v1alpha1.ConnectionTypeQueue,
+		Queue: &v1alpha1.QueueConnection{BrokerURL: "amqp://rabbitmq:5672", Topic: "orders", Credentials: credentials},
+	}); got != "broker-credentials" {
+		t.Errorf("ConnectionCredentialsRef() = %q, want %q", got, "broker-credentials")
+	}
+	if got := ConnectionCredentialsRef(v1alpha1.WorkloadConnection{Type: v1alpha1.ConnectionTypeAPI}); got != "" {
+		t.Errorf("ConnectionCredentialsRef() = %q for an api connection, want empty", got)
+	}
+}
+
+func TestParseConnectionEndpoint(t *testing.T) {
+	endpoints := []ConnectionEndpoint{
+		{Scheme: "http", Host: "orders.dp-acme-shop-dev.svc.cluster.local", Port: 8080, Visibility: v1alpha1.ConnectionVisibilityProject},
+		{Scheme: "http", Host: "orders.dp-acme-shop-dev.svc.cluster.local", Port: 80, Visibility: v1alpha1.ConnectionVisibilityOrganization},
+		{Scheme: "https", Host: "orders.example.com", Port: 443, BasePath: "/orders/api", Visibility: v1alpha1.ConnectionVisibilityPublic},
+		{Scheme: "grpc", Host: "payments.dp-acme-shop-dev.svc.cluster.local", Port: 9090, Visibility: v1alpha1.ConnectionVisibilityProject},
+		{Host: "cache.dp-acme-shop-dev.svc.cluster.local", Port: 6379, Visibility: v1alpha1.ConnectionVisibilityProject},
+	}
+
+	for _, want := range endpoints {
+		t.Run(want.URL(), func(t *testing.T) {
+			got, err := ParseConnectionEndpoint(want.URL(), want.Visibility)
+			if err != nil {
+				t.Fatalf("ParseConnectionEndpoint() error = %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ParseConnectionEndpoint() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := ParseConnectionEndpoint("orders", v1alpha1.ConnectionVisibilityProject); err == nil {
+		t.Errorf("ParseConnectionEndpoint() expected an error for a URL without a port")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"fmt"
+
+	apiservercel "k8s.io/apiserver/pkg/cel"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/schema"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// ComponentContextVariables declares the types of the variables available to ComponentType templates.
+// The declarations mirror the context built by BuildComponentContext, with the parameters typed
+// by the parameters and envOverrides schemas of the ComponentType.
+func ComponentContextVariables(ct *v1alpha1.ComponentType) ([]template.Variable, error) {
+	structural, err := BuildStructuralSchema(&SchemaInput{
+		Types:              ct.Spec.Schema.Types,
+		ParametersSchema:   ct.Spec.Schema.Parameters,
+		EnvOverridesSchema: ct.Spec.Schema.EnvOverrides,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build component schema: %w", err)
+	}
+
+	return []template.Variable{
+		declareVariable("parameters", schema.ToDeclType(structural)),
+		declareVariable("workload", workloadDeclType()),
+		declareVariable("configurations", apiservercel.NewMapType(apiservercel.StringType, apiservercel.DynType, -1)),
+		declareVariable("connections", apiservercel.NewMapType(apiservercel.StringType, apiservercel.DynType, -1)),
+		declareVariable("component", objectDeclType("name", "namespace")),
+		declareVariable("environment", objectDeclType("name", "vhost")),
+		declareVariable("metadata", metadataDeclType(
+			"name", "namespace", "componentName", "componentUID", "projectName", "projectUID",
+			"dataPlaneName", "dataPlaneUID", "environmentName", "environmentUID",
+		)),
+		declareVariable("dataplane", objectDeclType("secretStore")),
+	}, nil
+}
+
+// TraitContextVariables declares the types of the variables available to Trait templates and patches.
+// The declarations mirror the context built by BuildTraitContext.
+func TraitContextVariables(trait *v1alpha1.Trait) ([]template.Variable, error) {
+	structural, err := BuildStructuralSchema(&SchemaInput{
+		Types:              trait.Spec.Schema.Types,
+		ParametersSchema:   trait.Spec.Schema.Parameters,
+		EnvOverridesSchema: trait.Spec.Schema.EnvOverrides,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trait schema: %w", err)
+	}
+
+	return []template.Variable{
+		declareVariable("parameters", schema.ToDeclType(structural)),
+		declareVariable("trait", objectDeclType("name", "instanceName")),
+		declareVariable("component", objectDeclType("name", "namespace")),
+		declareVariable("environment", objectDeclType("name", "vhost")),
+		declareVariable("metadata", metadataDeclType("name", "namespace")),
+	}, nil
+}
+
+// declareVariable names the object types of a variable after the variable so that
+// the types of all variables can be registered in the same CEL environment.
+func declareVariable(name string, declType *apiservercel.DeclType) template.Variable {
+	return template.Variable{
+		Name: name,
+		Type: declType.MaybeAssignTypeName("__" + name + "_type"),
+	}
+}
+
+func workloadDeclType() *apiservercel.DeclType {
+	container := apiservercel.NewObjectType("object", map[string]*apiservercel.DeclField{
+		"image":   apiservercel.NewDeclField("image", apiservercel.StringType, false, nil, nil),
+		"command": apiservercel.NewDeclField("command", apiservercel.NewListType(apiservercel.StringType, -1), false, nil, nil),
+		"args":    apiservercel.NewDeclField("args", apiservercel.NewListType(apiservercel.StringType, -1), false, nil, nil),
+	})
+	networkVisibilities := apiservercel.NewObjectType("object", map[string]*apiservercel.DeclField{
+		"organization": apiservercel.NewDeclField("organization", apiservercel.BoolType, false, nil, nil),
+		"public":       apiservercel.NewDeclField("public", apiservercel.BoolType, false, nil, nil),
+	})
+	endpoint := apiservercel.NewObjectType("object", map[string]*apiservercel.DeclField{
+		"type":                apiservercel.NewDeclField("type", apiservercel.StringType, false, nil, nil),
+		"port":                apiservercel.NewDeclField("port", apiservercel.DoubleType, false, nil, nil),
+		"schema":              apiservercel.NewDeclField("schema", apiservercel.DynType, false, nil, nil),
+		"networkVisibilities": apiservercel.NewDeclField("networkVisibilities", networkVisibilities, false, nil, nil),
+	})
+
+	return apiservercel.NewObjectType("object", map[string]*apiservercel.DeclField{
+		"name": apiservercel.NewDeclField("name", apiservercel.StringType, false, nil, nil),
+		"containers": apiservercel.NewDeclField("containers",
+			apiservercel.NewMapType(apiservercel.StringType, container, -1), false, nil, nil),
+		"endpoints": apiservercel.NewDeclField("endpoints",
+			apiservercel.NewMapType(apiservercel.StringType, endpoint, -1), false, nil, nil),
+		"connections": apiservercel.NewDeclField("connections",
+			apiservercel.NewMapType(apiservercel.StringType, apiservercel.DynType, -1), false, nil, nil),
+	})
+}
+
+// metadataDeclType declares the metadata variable with the given string fields
+// and the common labels, annotations and pod selectors.
+func metadataDeclType(stringFields ...string) *apiservercel.DeclType {
+	metadata := objectDeclType(stringFields...)
+	stringMap := apiservercel.NewMapType(apiservercel.StringType, apiservercel.StringType, -1)
+	for _, name := range []string{"labels", "annotations", "podSelectors"} {
+		metadata.Fields[name] = apiservercel.NewDeclField(name, stringMap, false, nil, nil)
+	}
+	return metadata
+}
+
+func objectDeclType(stringFields ...string) *apiservercel.DeclType {
+	fields := make(map[string]*apiservercel.DeclField, len(stringFields))
+	for _, name := range stringFields {
+		fields[name] = apiservercel.NewDeclField(name, apiservercel.StringType, false, nil, nil)
+	}
+	return apiservercel.NewObjectType("object", fields)
+}
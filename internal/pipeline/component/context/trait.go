@@ -102,6 +102,9 @@ func BuildTraitContext(input *TraitContextInput) (map[string]any, error) {
 
 	// 4. Apply schema defaults
 	parameters = schema.ApplyDefaults(parameters, structural)
+	if err := validateParameterRules(parameters, structural); err != nil {
+		return nil, err
+	}
 	ctx["parameters"] = parameters
 
 	// 5. Add trait metadata
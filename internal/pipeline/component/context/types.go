@@ -102,11 +102,34 @@ type ComponentContextInput struct {
 	// Optional - can be nil if no secret references need to be resolved.
 	SecretReferences map[string]*v1alpha1.SecretReference
 
+	// ConnectionEndpoints are the resolved endpoints of the API connections of the workload.
+	// Keyed by connection name.
+	// Optional - API connections without a resolved endpoint are not injected.
+	ConnectionEndpoints map[string]ConnectionEndpoint
+
 	// Metadata provides structured naming and labeling information.
 	// Required - controller must provide this.
 	Metadata MetadataContext
 }
 
+// ConnectionEndpoint is the resolved address of the endpoint an API connection points to.
+type ConnectionEndpoint struct {
+	// Scheme is the URL scheme of the endpoint, e.g. http or https.
+	Scheme string
+
+	// Host is the host name of the endpoint.
+	Host string
+
+	// Port is the port of the endpoint.
+	Port int32
+
+	// BasePath is the path prefix of the endpoint, if any.
+	BasePath string
+
+	// Visibility is the network visibility level through which the endpoint is reached.
+	Visibility v1alpha1.ConnectionVisibility
+}
+
 // TraitContextInput contains all inputs needed to build a trait rendering context.
 type TraitContextInput struct {
 	// Trait is the trait definition.
@@ -3,7 +3,12 @@
 
 package component
 
-import "maps"
+import (
+	"maps"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/template"
+)
 
 // Option is a function that configures a Pipeline.
 type Option func(*Pipeline)
@@ -39,3 +44,36 @@ func WithResourceAnnotations(annotations map[string]string) Option {
 		maps.Copy(p.options.ResourceAnnotations, annotations)
 	}
 }
+
+// WithSourceTracking enables or disables tracking of which ComponentType template
+// or trait produced each rendered resource. When enabled, RenderOutput.Sources
+// is populated in the same order as RenderOutput.Resources.
+func WithSourceTracking(enabled bool) Option {
+	return func(p *Pipeline) {
+		p.options.TrackSources = enabled
+	}
+}
+
+// WithTracing enables or disables tracing of template expressions. When enabled,
+// RenderOutput.Trace records every evaluated expression with its inputs and result.
+func WithTracing(enabled bool) Option {
+	return func(p *Pipeline) {
+		p.options.EnableTracing = enabled
+	}
+}
+
+// WithCostLimits sets the cost limits of the template engine, bounding the cost of evaluating a
+// single expression and all expressions of a render. See template.CostLimits.
+func WithCostLimits(limits template.CostLimits) Option {
+	return func(p *Pipeline) {
+		p.templateEngine = template.NewEngineWithOptions(template.WithCostLimits(limits))
+	}
+}
+
+// WithEvaluationTimeout bounds the time spent evaluating the templates of a render.
+// The context passed to RenderWithContext can still end the evaluation earlier.
+func WithEvaluationTimeout(timeout time.Duration) Option {
+	return func(p *Pipeline) {
+		p.options.EvaluationTimeout = timeout
+	}
+}
@@ -12,14 +12,17 @@
 package component
 
 import (
+	"context"
 	"fmt"
 	"maps"
+	"reflect"
 	"sort"
 
 	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
-	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/clone"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/trait"
 	"github.com/openchoreo/openchoreo/internal/template"
@@ -50,8 +53,40 @@ func NewPipeline(opts ...Option) *Pipeline {
 //  5. Post-process (validate, add labels/annotations, sort)
 //  6. Return output
 //
-// Returns an error if any step fails.
+// Returns an error if any step fails. When tracing is enabled, an output holding the
+// trace recorded up to the failure is returned along with the error.
 func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
+	return p.RenderWithContext(context.Background(), input)
+}
+
+// RenderWithContext renders like Render, interrupting the evaluation of templates when the
+// context is done or the evaluation timeout of the pipeline elapses. Templates exceeding the
+// evaluation budget fail with a template.EvaluationBudgetError (see template.IsEvaluationBudgetError).
+func (p *Pipeline) RenderWithContext(ctx context.Context, input *RenderInput) (*RenderOutput, error) {
+	if p.options.EvaluationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.options.EvaluationTimeout)
+		defer cancel()
+	}
+
+	var trace *template.Trace
+	if p.options.EnableTracing {
+		trace = &template.Trace{}
+	}
+
+	output, err := p.render(input, trace, template.NewBudget(ctx))
+	if trace != nil {
+		if output == nil {
+			output = &RenderOutput{}
+		}
+		output.Trace = trace.Entries
+	}
+	return output, err
+}
+
+// render performs the rendering workflow, recording evaluated expressions into trace if it is not nil.
+// All expressions are evaluated against the budget of the render.
+func (p *Pipeline) render(input *RenderInput, trace *template.Trace, budget *template.Budget) (*RenderOutput, error) {
 	// 1. Validate input
 	if err := p.validateInput(input); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
@@ -62,13 +97,13 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	}
 
 	// Build environment context
-	environment := context.EnvironmentContext{
+	environment := pipelinecontext.EnvironmentContext{
 		Name:        input.Environment.Name,
 		VirtualHost: input.DataPlane.Spec.Gateway.PublicVirtualHost,
 	}
 
 	// 2. Build component context
-	componentContext, err := context.BuildComponentContext(&context.ComponentContextInput{
+	componentContext, err := pipelinecontext.BuildComponentContext(&pipelinecontext.ComponentContextInput{
 		Component:           input.Component,
 		ComponentType:       input.ComponentType,
 		Workload:            input.Workload,
@@ -77,6 +112,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		ReleaseBinding:      input.ReleaseBinding,
 		DataPlane:           input.DataPlane,
 		SecretReferences:    input.SecretReferences,
+		ConnectionEndpoints: input.ConnectionEndpoints,
 		Metadata:            input.Metadata,
 	})
 	if err != nil {
@@ -84,8 +120,8 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	}
 
 	// 3. Render base resources from ComponentType
-	resourceRenderer := renderer.NewRenderer(p.templateEngine)
-	resources, err := resourceRenderer.RenderResources(
+	resourceRenderer := renderer.NewRenderer(p.templateEngine).WithTrace(trace).WithBudget(budget)
+	resources, templateIDs, err := resourceRenderer.RenderResourcesWithIDs(
 		input.ComponentType.Spec.Resources,
 		componentContext,
 	)
@@ -94,8 +130,16 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	}
 	metadata.BaseResourceCount = len(resources)
 
+	var sources []ResourceSource
+	if p.options.TrackSources {
+		sources = make([]ResourceSource, 0, len(resources))
+		for _, id := range templateIDs {
+			sources = append(sources, ResourceSource{TemplateID: id})
+		}
+	}
+
 	// 4. Process traits
-	traitProcessor := trait.NewProcessor(p.templateEngine)
+	traitProcessor := trait.NewProcessor(p.templateEngine).WithBudget(budget)
 
 	// Build trait map
 	traitMap := make(map[string]*v1alpha1.Trait)
@@ -115,7 +159,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		}
 
 		// Build trait context (BuildtraitContext will handle schema caching)
-		traitContext, err := context.BuildTraitContext(&context.TraitContextInput{
+		traitContext, err := pipelinecontext.BuildTraitContext(&pipelinecontext.TraitContextInput{
 			Trait:               trait,
 			Instance:            traitInstance,
 			Component:           input.Component,
@@ -130,6 +174,19 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 				traitInstance.Name, traitInstance.InstanceName, err)
 		}
 
+		// Snapshot existing resources so patched ones can be attributed to this trait
+		var before []map[string]any
+		if p.options.TrackSources {
+			before = make([]map[string]any, len(resources))
+			for i := range resources {
+				before[i] = clone.DeepCopyMap(resources[i])
+			}
+		}
+
+		if trace != nil {
+			traitProcessor.WithTrace(trace, fmt.Sprintf("traits[%s]", traitInstance.InstanceName))
+		}
+
 		// Process trait (creates + patches)
 		resources, err = traitProcessor.ProcessTraits(resources, trait, traitContext)
 		if err != nil {
@@ -137,6 +194,10 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 				traitInstance.Name, traitInstance.InstanceName, err)
 		}
 
+		if p.options.TrackSources {
+			sources = trackTraitSources(sources, before, resources, traitInstance)
+		}
+
 		metadata.TraitCount++
 	}
 
@@ -155,16 +216,45 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	}
 
 	// Sort resources for deterministic output
-	sortResources(resources)
+	if p.options.TrackSources {
+		sortResourcesWithSources(resources, sources)
+	} else {
+		sortResources(resources)
+	}
 
 	metadata.ResourceCount = len(resources)
 
 	return &RenderOutput{
 		Resources: resources,
 		Metadata:  metadata,
+		Sources:   sources,
 	}, nil
 }
 
+// trackTraitSources extends sources after a trait has been processed.
+// Resources beyond the length of before were created by the trait; existing
+// resources that differ from their snapshot in before were patched by it.
+func trackTraitSources(
+	sources []ResourceSource,
+	before []map[string]any,
+	after []map[string]any,
+	instance v1alpha1.ComponentTrait,
+) []ResourceSource {
+	traitRef := instance.Name + "/" + instance.InstanceName
+	for i := range before {
+		if !reflect.DeepEqual(before[i], after[i]) {
+			sources[i].PatchedBy = append(sources[i].PatchedBy, traitRef)
+		}
+	}
+	for i := len(before); i < len(after); i++ {
+		sources = append(sources, ResourceSource{
+			Trait:         instance.Name,
+			TraitInstance: instance.InstanceName,
+		})
+	}
+	return sources
+}
+
 // validateInput ensures the input has all required fields.
 func (p *Pipeline) validateInput(input *RenderInput) error {
 	if input == nil {
@@ -295,30 +385,57 @@ func (p *Pipeline) validateResources(resources []map[string]any) error {
 // Sorts by: kind, apiVersion, metadata.namespace, metadata.name
 func sortResources(resources []map[string]any) {
 	sort.Slice(resources, func(i, j int) bool {
-		// Extract fields
-		kind1, _ := resources[i]["kind"].(string)
-		kind2, _ := resources[j]["kind"].(string)
-		if kind1 != kind2 {
-			return kind1 < kind2
-		}
+		return resourceLess(resources[i], resources[j])
+	})
+}
 
-		apiVersion1, _ := resources[i]["apiVersion"].(string)
-		apiVersion2, _ := resources[j]["apiVersion"].(string)
-		if apiVersion1 != apiVersion2 {
-			return apiVersion1 < apiVersion2
-		}
+// sortResourcesWithSources sorts resources like sortResources while keeping
+// the parallel sources slice aligned with the resources.
+func sortResourcesWithSources(resources []map[string]any, sources []ResourceSource) {
+	sort.Sort(&resourcesWithSources{resources: resources, sources: sources})
+}
 
-		meta1, _ := resources[i]["metadata"].(map[string]any)
-		meta2, _ := resources[j]["metadata"].(map[string]any)
+// resourcesWithSources implements sort.Interface over parallel resource and source slices.
+type resourcesWithSources struct {
+	resources []map[string]any
+	sources   []ResourceSource
+}
 
-		ns1, _ := meta1["namespace"].(string)
-		ns2, _ := meta2["namespace"].(string)
-		if ns1 != ns2 {
-			return ns1 < ns2
-		}
+func (r *resourcesWithSources) Len() int { return len(r.resources) }
 
-		name1, _ := meta1["name"].(string)
-		name2, _ := meta2["name"].(string)
-		return name1 < name2
-	})
+func (r *resourcesWithSources) Less(i, j int) bool {
+	return resourceLess(r.resources[i], r.resources[j])
+}
+
+func (r *resourcesWithSources) Swap(i, j int) {
+	r.resources[i], r.resources[j] = r.resources[j], r.resources[i]
+	r.sources[i], r.sources[j] = r.sources[j], r.sources[i]
+}
+
+// resourceLess orders two resources by kind, apiVersion, metadata.namespace and metadata.name.
+func resourceLess(a, b map[string]any) bool {
+	kind1, _ := a["kind"].(string)
+	kind2, _ := b["kind"].(string)
+	if kind1 != kind2 {
+		return kind1 < kind2
+	}
+
+	apiVersion1, _ := a["apiVersion"].(string)
+	apiVersion2, _ := b["apiVersion"].(string)
+	if apiVersion1 != apiVersion2 {
+		return apiVersion1 < apiVersion2
+	}
+
+	meta1, _ := a["metadata"].(map[string]any)
+	meta2, _ := b["metadata"].(map[string]any)
+
+	ns1, _ := meta1["namespace"].(string)
+	ns2, _ := meta2["namespace"].(string)
+	if ns1 != ns2 {
+		return ns1 < ns2
+	}
+
+	name1, _ := meta1["name"].(string)
+	name2, _ := meta2["name"].(string)
+	return name1 < name2
 }
@@ -7,13 +7,17 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
 // loadTestDataFile loads a file from the testdata directory
@@ -359,6 +363,15 @@ spec:
 			wantResourceYAML:     loadTestDataFile(t, "configurations-and-secrets/expected-resources.yaml"),
 			wantErr:              false,
 		},
+		{
+			name:                 "component with connections",
+			snapshotYAML:         loadTestDataFile(t, "connections/snapshot.yaml"),
+			environmentYAML:      devEnvironmentYAML,
+			dataplaneYAML:        devDataplaneYAML,
+			secretReferencesYAML: loadTestDataFile(t, "connections/secret-references.yaml"),
+			wantResourceYAML:     loadTestDataFile(t, "connections/expected-resources.yaml"),
+			wantErr:              false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -652,6 +665,323 @@ spec:
 	}
 }
 
+func TestPipeline_SourceTracking(t *testing.T) {
+	snapshotYAML := `
+apiVersion: core.choreo.dev/v1alpha1
+kind: ComponentEnvSnapshot
+spec:
+  environment: dev
+  component:
+    metadata:
+      name: test-app
+    spec:
+      parameters: {}
+      traits:
+        - name: monitoring
+          instanceName: mon-1
+        - name: mysql
+          instanceName: db-1
+  componentType:
+    spec:
+      resources:
+        - id: service
+          template:
+            apiVersion: v1
+            kind: Service
+            metadata:
+              name: app
+        - id: deployment
+          template:
+            apiVersion: apps/v1
+            kind: Deployment
+            metadata:
+              name: app
+  traits:
+    - metadata:
+        name: monitoring
+      spec:
+        patches:
+          - target:
+              kind: Deployment
+            operations:
+              - op: add
+                path: /metadata/annotations
+                value:
+                  monitoring: enabled
+    - metadata:
+        name: mysql
+      spec:
+        creates:
+          - template:
+              apiVersion: v1
+              kind: Secret
+              metadata:
+                name: ${trait.instanceName}-secret
+  workload: {}
+`
+	snapshot := &v1alpha1.ComponentEnvSnapshot{}
+	if err := yaml.Unmarshal([]byte(snapshotYAML), snapshot); err != nil {
+		t.Fatalf("Failed to parse snapshot YAML: %v", err)
+	}
+
+	input := &RenderInput{
+		ComponentType: &snapshot.Spec.ComponentType,
+		Component:     &snapshot.Spec.Component,
+		Traits:        snapshot.Spec.Traits,
+		Workload:      &snapshot.Spec.Workload,
+		Environment:   &v1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		DataPlane:     &v1alpha1.DataPlane{},
+		Metadata: context.MetadataContext{
+			Name:      "test-app-dev-12345678",
+			Namespace: "test-namespace",
+		},
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		output, err := NewPipeline().Render(input)
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		if output.Sources != nil {
+			t.Errorf("expected no sources, got %v", output.Sources)
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		output, err := NewPipeline(WithSourceTracking(true)).Render(input)
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+
+		// Resources are sorted by kind: Deployment, Secret, Service
+		want := []ResourceSource{
+			{TemplateID: "deployment", PatchedBy: []string{"monitoring/mon-1"}},
+			{Trait: "mysql", TraitInstance: "db-1"},
+			{TemplateID: "service"},
+		}
+		if diff := cmp.Diff(want, output.Sources); diff != "" {
+			t.Errorf("Sources mismatch (-want +got):\n%s", diff)
+		}
+		if got := output.Resources[1]["kind"]; got != "Secret" {
+			t.Errorf("expected sources to stay aligned with sorted resources, got kind %v at index 1", got)
+		}
+	})
+}
+
+func TestPipeline_Tracing(t *testing.T) {
+	snapshotYAML := `
+apiVersion: core.choreo.dev/v1alpha1
+kind: ComponentEnvSnapshot
+spec:
+  environment: dev
+  component:
+    metadata:
+      name: test-app
+    spec:
+      parameters:
+        replicas: 2
+      traits:
+        - name: mysql
+          instanceName: db-1
+  componentType:
+    spec:
+      schema:
+        parameters:
+          replicas: "integer | default=1"
+          autoscaling: "boolean | default=false"
+      resources:
+        - id: deployment
+          template:
+            apiVersion: apps/v1
+            kind: Deployment
+            metadata:
+              name: ${metadata.name}
+            spec:
+              replicas: ${parameters.replicas}
+        - id: hpa
+          includeWhen: ${parameters.autoscaling}
+          template:
+            apiVersion: autoscaling/v2
+            kind: HorizontalPodAutoscaler
+            metadata:
+              name: ${metadata.name}
+  traits:
+    - metadata:
+        name: mysql
+      spec:
+        creates:
+          - template:
+              apiVersion: v1
+              kind: Secret
+              metadata:
+                name: ${trait.instanceName}-secret
+  workload: {}
+`
+	snapshot := &v1alpha1.ComponentEnvSnapshot{}
+	if err := yaml.Unmarshal([]byte(snapshotYAML), snapshot); err != nil {
+		t.Fatalf("Failed to parse snapshot YAML: %v", err)
+	}
+
+	input := &RenderInput{
+		ComponentType: &snapshot.Spec.ComponentType,
+		Component:     &snapshot.Spec.Component,
+		Traits:        snapshot.Spec.Traits,
+		Workload:      &snapshot.Spec.Workload,
+		Environment:   &v1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		DataPlane:     &v1alpha1.DataPlane{},
+		Metadata: context.MetadataContext{
+			Name:      "test-app-dev-12345678",
+			Namespace: "test-namespace",
+		},
+	}
+
+	output, err := NewPipeline(WithTracing(true)).Render(input)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	type tracedExpression struct {
+		Path     string
+		Result   any
+		Excluded bool
+	}
+	var got []tracedExpression
+	for _, entry := range output.Trace {
+		got = append(got, tracedExpression{Path: entry.Path, Result: entry.Result, Excluded: entry.Excluded})
+	}
+	want := []tracedExpression{
+		{Path: "resources[deployment].template.metadata.name", Result: "test-app-dev-12345678"},
+		{Path: "resources[deployment].template.spec.replicas", Result: float64(2)},
+		{Path: "resources[hpa].includeWhen", Result: false, Excluded: true},
+		{Path: "traits[db-1].creates[0].template.metadata.name", Result: "db-1"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Trace mismatch (-want +got):\n%s", diff)
+	}
+
+	t.Run("trace is returned on failure", func(t *testing.T) {
+		failing := *input
+		failing.ComponentType = snapshot.Spec.ComponentType.DeepCopy()
+		failing.ComponentType.Spec.Resources[0].IncludeWhen = "${1 / 0 == 1}"
+
+		output, err := NewPipeline(WithTracing(true)).Render(&failing)
+		if err == nil {
+			t.Fatal("expected rendering to fail")
+		}
+		if output == nil || len(output.Trace) != 1 || output.Trace[0].Error == "" {
+			t.Fatalf("expected the failed expression to be traced, got %+v", output)
+		}
+	})
+}
+
+func TestPipeline_EvaluationBudget(t *testing.T) {
+	snapshotYAML := `
+apiVersion: core.choreo.dev/v1alpha1
+kind: ComponentEnvSnapshot
+spec:
+  environment: dev
+  component:
+    metadata:
+      name: test-app
+    spec:
+      parameters:
+        ports: [8080, 8081, 8082, 8083]
+      traits:
+        - name: mysql
+          instanceName: db-1
+  componentType:
+    spec:
+      schema:
+        parameters:
+          ports: "[]integer"
+      resources:
+        - id: service
+          template:
+            apiVersion: v1
+            kind: Service
+            metadata:
+              name: ${metadata.name}
+            spec:
+              ports: '${parameters.ports.map(p, {"port": p})}'
+  traits:
+    - metadata:
+        name: mysql
+      spec:
+        creates:
+          - template:
+              apiVersion: v1
+              kind: Secret
+              metadata:
+                name: ${trait.instanceName}-secret
+              stringData: '${{"hosts": ["a", "b", "c", "d", "e", "f"].map(h, h + "." + trait.instanceName).join(",")}}'
+  workload: {}
+`
+	snapshot := &v1alpha1.ComponentEnvSnapshot{}
+	if err := yaml.Unmarshal([]byte(snapshotYAML), snapshot); err != nil {
+		t.Fatalf("Failed to parse snapshot YAML: %v", err)
+	}
+
+	input := &RenderInput{
+		ComponentType: &snapshot.Spec.ComponentType,
+		Component:     &snapshot.Spec.Component,
+		Traits:        snapshot.Spec.Traits,
+		Workload:      &snapshot.Spec.Workload,
+		Environment:   &v1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		DataPlane:     &v1alpha1.DataPlane{},
+		Metadata: context.MetadataContext{
+			Name:      "test-app-dev-12345678",
+			Namespace: "test-namespace",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		options     []Option
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "within the default limits",
+		},
+		{
+			name:        "expression exceeds its limit",
+			options:     []Option{WithCostLimits(template.CostLimits{PerExpression: 10})},
+			wantErr:     true,
+			errContains: "exceeded the cost limit of 10",
+		},
+		{
+			name:        "render exceeds its limit in a trait",
+			options:     []Option{WithCostLimits(template.CostLimits{PerExpression: 1000, PerRender: 200})},
+			wantErr:     true,
+			errContains: "failed to process trait mysql/db-1: failed to render create template for trait mysql create #0: template exceeded evaluation budget: total cost exceeded the per-render limit of 200",
+		},
+		{
+			name:        "evaluation timeout",
+			options:     []Option{WithEvaluationTimeout(time.Nanosecond)},
+			wantErr:     true,
+			errContains: "context deadline exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPipeline(tt.options...).Render(input)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Render() error = %v", err)
+				}
+				return
+			}
+			if !template.IsEvaluationBudgetError(err) {
+				t.Fatalf("IsEvaluationBudgetError() = false for error: %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
+
 func TestValidateResources(t *testing.T) {
 	tests := []struct {
 		name      string
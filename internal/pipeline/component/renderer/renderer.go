@@ -21,6 +21,8 @@ import (
 // Renderer orchestrates the rendering of ResourceTemplates from ComponentTypes.
 type Renderer struct {
 	templateEngine *template.Engine
+	trace          *template.Trace
+	budget         *template.Budget
 }
 
 // NewRenderer creates a new ResourceTemplate renderer.
@@ -30,6 +32,31 @@ func NewRenderer(templateEngine *template.Engine) *Renderer {
 	}
 }
 
+// WithTrace records the expressions evaluated by the renderer into the trace.
+func (r *Renderer) WithTrace(trace *template.Trace) *Renderer {
+	r.trace = trace
+	return r
+}
+
+// WithBudget evaluates the expressions of the renderer against the budget of the render.
+func (r *Renderer) WithBudget(budget *template.Budget) *Renderer {
+	r.budget = budget
+	return r
+}
+
+// renderOptions returns the render options evaluating expressions against the budget and
+// tracing them under the given path.
+func (r *Renderer) renderOptions(path string) []template.RenderOption {
+	var opts []template.RenderOption
+	if r.budget != nil {
+		opts = append(opts, template.WithBudget(r.budget))
+	}
+	if r.trace != nil {
+		opts = append(opts, template.WithTrace(r.trace, path))
+	}
+	return opts
+}
+
 // RenderResources renders all resources from a ComponentType.
 //
 // The process:
@@ -46,13 +73,25 @@ func (r *Renderer) RenderResources(
 	templates []v1alpha1.ResourceTemplate,
 	context map[string]any,
 ) ([]map[string]any, error) {
+	resources, _, err := r.RenderResourcesWithIDs(templates, context)
+	return resources, err
+}
+
+// RenderResourcesWithIDs renders all resources from a ComponentType like RenderResources,
+// additionally returning the ID of the ResourceTemplate that produced each resource.
+// The returned ID slice is parallel to the returned resources.
+func (r *Renderer) RenderResourcesWithIDs(
+	templates []v1alpha1.ResourceTemplate,
+	context map[string]any,
+) ([]map[string]any, []string, error) {
 	resources := make([]map[string]any, 0, len(templates))
+	templateIDs := make([]string, 0, len(templates))
 
 	for _, tmpl := range templates {
 		// Check if resource should be included
 		include, err := r.shouldInclude(tmpl, context)
 		if err != nil {
-			return nil, fmt.Errorf("failed to evaluate includeWhen for resource %s: %w", tmpl.ID, err)
+			return nil, nil, fmt.Errorf("failed to evaluate includeWhen for resource %s: %w", tmpl.ID, err)
 		}
 		if !include {
 			continue
@@ -62,21 +101,25 @@ func (r *Renderer) RenderResources(
 		if tmpl.ForEach != "" {
 			rendered, err := r.renderWithForEach(tmpl, context)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			resources = append(resources, rendered...)
+			for range rendered {
+				templateIDs = append(templateIDs, tmpl.ID)
+			}
 			continue
 		}
 
 		// Render single resource
-		rendered, err := r.renderSingleResource(tmpl, context)
+		rendered, err := r.renderSingleResource(tmpl, context, resourcePath(tmpl.ID))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		resources = append(resources, rendered)
+		templateIDs = append(templateIDs, tmpl.ID)
 	}
 
-	return resources, nil
+	return resources, templateIDs, nil
 }
 
 // shouldInclude evaluates the ResourceTemplate.includeWhen condition.
@@ -92,10 +135,12 @@ func (r *Renderer) shouldInclude(tmpl v1alpha1.ResourceTemplate, context map[str
 		return true, nil
 	}
 
-	result, err := r.templateEngine.Render(tmpl.IncludeWhen, context)
+	traced := r.trace.Len()
+	result, err := r.templateEngine.Render(tmpl.IncludeWhen, context, r.renderOptions(resourcePath(tmpl.ID)+".includeWhen")...)
 	if err != nil {
 		// Gracefully handle missing data - treat as false
 		if template.IsMissingDataError(err) {
+			r.trace.MarkExcluded(traced)
 			return false, nil
 		}
 		return false, fmt.Errorf("failed to evaluate includeWhen expression: %w", err)
@@ -105,6 +150,9 @@ func (r *Renderer) shouldInclude(tmpl v1alpha1.ResourceTemplate, context map[str
 	if !ok {
 		return false, fmt.Errorf("includeWhen must evaluate to bool, got %T", result)
 	}
+	if !boolResult {
+		r.trace.MarkExcluded(traced)
+	}
 
 	return boolResult, nil
 }
@@ -123,7 +171,7 @@ func (r *Renderer) renderWithForEach(
 	context map[string]any,
 ) ([]map[string]any, error) {
 	// Evaluate forEach expression
-	result, err := r.templateEngine.Render(tmpl.ForEach, context)
+	result, err := r.templateEngine.Render(tmpl.ForEach, context, r.renderOptions(resourcePath(tmpl.ID)+".forEach")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to evaluate forEach expression for resource %s: %w", tmpl.ID, err)
 	}
@@ -148,7 +196,7 @@ func (r *Renderer) renderWithForEach(
 		itemContext[varName] = item
 
 		// Render resource with item context
-		rendered, err := r.renderSingleResource(tmpl, itemContext)
+		rendered, err := r.renderSingleResource(tmpl, itemContext, fmt.Sprintf("%s[%d]", resourcePath(tmpl.ID), i))
 		if err != nil {
 			return nil, fmt.Errorf("failed to render forEach iteration %d for resource %s: %w", i, tmpl.ID, err)
 		}
@@ -169,6 +217,7 @@ func (r *Renderer) renderWithForEach(
 func (r *Renderer) renderSingleResource(
 	tmpl v1alpha1.ResourceTemplate,
 	context map[string]any,
+	path string,
 ) (map[string]any, error) {
 	// Extract template structure
 	var templateData any
@@ -177,7 +226,7 @@ func (r *Renderer) renderSingleResource(
 	}
 
 	// Render template
-	rendered, err := r.templateEngine.Render(templateData, context)
+	rendered, err := r.templateEngine.Render(templateData, context, r.renderOptions(path+".template")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to render template for resource %s: %w", tmpl.ID, err)
 	}
@@ -255,3 +304,8 @@ func MustRawExtension(v any) runtime.RawExtension {
 	}
 	return runtime.RawExtension{Raw: data}
 }
+
+// resourcePath returns the trace path of a ResourceTemplate.
+func resourcePath(id string) string {
+	return fmt.Sprintf("resources[%s]", id)
+}
@@ -408,7 +408,7 @@ template:
 				t.Fatalf("Failed to parse template YAML: %v", err)
 			}
 
-			got, err := renderer.renderSingleResource(template, tt.context)
+			got, err := renderer.renderSingleResource(template, tt.context, resourcePath(template.ID))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("renderSingleResource() error = %v, wantErr %v", err, tt.wantErr)
 				return
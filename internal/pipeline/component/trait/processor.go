@@ -9,6 +9,7 @@ package trait
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"strings"
@@ -21,6 +22,9 @@ import (
 // Processor handles trait creates and patches.
 type Processor struct {
 	templateEngine *template.Engine
+	trace          *template.Trace
+	tracePath      string
+	budget         *template.Budget
 }
 
 // TargetSpec describes how to locate a resource when applying patches.
@@ -38,6 +42,42 @@ func NewProcessor(templateEngine *template.Engine) *Processor {
 	}
 }
 
+// WithTrace records the expressions evaluated by the processor into the trace,
+// under the given path identifying the trait instance being processed.
+func (p *Processor) WithTrace(trace *template.Trace, path string) *Processor {
+	p.trace = trace
+	p.tracePath = path
+	return p
+}
+
+// WithBudget evaluates the expressions of the processor against the budget of the render.
+func (p *Processor) WithBudget(budget *template.Budget) *Processor {
+	p.budget = budget
+	return p
+}
+
+// renderOptions returns the render options evaluating expressions against the budget and
+// tracing them under the given path, relative to the path of the trait instance.
+func (p *Processor) renderOptions(path string) []template.RenderOption {
+	var opts []template.RenderOption
+	if p.budget != nil {
+		opts = append(opts, template.WithBudget(p.budget))
+	}
+	if p.trace != nil {
+		opts = append(opts, template.WithTrace(p.trace, p.tracePath+"."+path))
+	}
+	return opts
+}
+
+// filterOptions returns the render options evaluating the path filters of patch operations
+// against the budget of the render.
+func (p *Processor) filterOptions() []template.RenderOption {
+	if p.budget == nil {
+		return nil
+	}
+	return []template.RenderOption{template.WithBudget(p.budget)}
+}
+
 // ProcessTraits applies all traits to the base resources.
 //
 // For each trait:
@@ -80,7 +120,7 @@ func (p *Processor) ApplyTraitCreates(
 		}
 
 		// Render template
-		rendered, err := p.templateEngine.Render(templateData, traitContext)
+		rendered, err := p.templateEngine.Render(templateData, traitContext, p.renderOptions(fmt.Sprintf("creates[%d].template", i))...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to render create template for trait %s create #%d: %w", trait.Name, i, err)
 		}
@@ -111,7 +151,7 @@ func (p *Processor) ApplyTraitCreates(
 //   - forEach iteration over collections
 //   - Resource targeting (finding which resources to patch)
 //   - CEL rendering of patch operations and where clauses
-//   - Delegating to patch.ApplyPatches for the actual patching
+//   - Delegating to patch.ApplyPatchesWithEngine for the actual patching
 //
 // The patch package itself only handles the low-level mechanics of applying
 // operations to a single resource.
@@ -144,7 +184,7 @@ func (p *Processor) applyPatch(
 	}
 
 	// No forEach - apply once with base context
-	return p.applyPatchOnce(resources, traitName, patchIndex, traitPatch, baseContext)
+	return p.applyPatchOnce(resources, traitName, patchIndex, traitPatch, baseContext, fmt.Sprintf("patches[%d]", patchIndex))
 }
 
 // applyPatchWithForEach handles forEach iteration for a patch.
@@ -156,7 +196,7 @@ func (p *Processor) applyPatchWithForEach(
 	baseContext map[string]any,
 ) error {
 	// Evaluate the forEach expression to get the list of items
-	itemsRaw, err := p.templateEngine.Render(traitPatch.ForEach, baseContext)
+	itemsRaw, err := p.templateEngine.Render(traitPatch.ForEach, baseContext, p.renderOptions(fmt.Sprintf("patches[%d].forEach", patchIndex))...)
 	if err != nil {
 		return fmt.Errorf("failed to evaluate forEach expression '%s' for trait %s patch #%d: %w", traitPatch.ForEach, traitName, patchIndex, err)
 	}
@@ -183,7 +223,8 @@ func (p *Processor) applyPatchWithForEach(
 		iterContext[varName] = item
 
 		// Apply patch operations with this iteration's context
-		if err := p.applyPatchOnce(resources, traitName, patchIndex, traitPatch, iterContext); err != nil {
+		iterPath := fmt.Sprintf("patches[%d][%d]", patchIndex, i)
+		if err := p.applyPatchOnce(resources, traitName, patchIndex, traitPatch, iterContext, iterPath); err != nil {
 			return fmt.Errorf("forEach iteration %d failed: %w", i, err)
 		}
 	}
@@ -198,6 +239,7 @@ func (p *Processor) applyPatchOnce(
 	patchIndex int,
 	traitPatch v1alpha1.TraitPatch,
 	context map[string]any,
+	path string,
 ) error {
 	// 1. Find target resources based on Kind/Group/Version
 	target := TargetSpec{
@@ -215,7 +257,7 @@ func (p *Processor) applyPatchOnce(
 
 	// 2. Filter targets using where clause if specified
 	if target.Where != "" {
-		filtered, err := p.filterTargets(targets, target.Where, context, traitName, patchIndex)
+		filtered, err := p.filterTargets(targets, target.Where, context, traitName, patchIndex, path+".target.where")
 		if err != nil {
 			return err
 		}
@@ -223,14 +265,18 @@ func (p *Processor) applyPatchOnce(
 	}
 
 	// 3. Render patch operations with CEL
-	renderedOps, err := p.renderOperations(traitPatch.Operations, context, traitName, patchIndex)
+	renderedOps, err := p.renderOperations(traitPatch.Operations, context, traitName, patchIndex, path)
 	if err != nil {
 		return err
 	}
 
 	// 4. Apply rendered operations to each target using the simple patch function
 	for _, target := range targets {
-		if err := patch.ApplyPatches(target, renderedOps); err != nil {
+		if err := patch.ApplyPatchesWithEngine(p.templateEngine, target, renderedOps, p.filterOptions()...); err != nil {
+			// A failed test leaves the target unchanged; skip it if the patch is conditional on its tests
+			if errors.Is(err, patch.ErrTestFailed) && traitPatch.OnTestFailure == v1alpha1.PatchTestFailurePolicySkip {
+				continue
+			}
 			// Extract resource identity for better error message
 			kind, _ := target["kind"].(string)
 			metadata, _ := target["metadata"].(map[string]any)
@@ -254,6 +300,7 @@ func (p *Processor) filterTargets(
 	baseContext map[string]any,
 	traitName string,
 	patchIndex int,
+	path string,
 ) ([]map[string]any, error) {
 	filtered := make([]map[string]any, 0, len(targets))
 
@@ -265,7 +312,7 @@ func (p *Processor) filterTargets(
 		baseContext["resource"] = target
 
 		// Evaluate the where clause
-		result, err := p.templateEngine.Render(whereClause, baseContext)
+		result, err := p.templateEngine.Render(whereClause, baseContext, p.renderOptions(path)...)
 		if err != nil {
 			// If this is a "missing data" error, treat as non-match
 			if template.IsMissingDataError(err) {
@@ -314,12 +361,14 @@ func (p *Processor) renderOperations(
 	context map[string]any,
 	traitName string,
 	patchIndex int,
+	path string,
 ) ([]patch.JSONPatchOperation, error) {
 	rendered := make([]patch.JSONPatchOperation, len(operations))
 
 	for i, op := range operations {
 		// Render the path (which may contain CEL expressions)
-		pathValue, err := p.templateEngine.Render(op.Path, context)
+		opPath := fmt.Sprintf("%s.operations[%d]", path, i)
+		pathValue, err := p.templateEngine.Render(op.Path, context, p.renderOptions(opPath+".path")...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to render path '%s' for trait %s patch #%d operation #%d: %w", op.Path, traitName, patchIndex, i, err)
 		}
@@ -329,6 +378,19 @@ func (p *Processor) renderOperations(
 			return nil, fmt.Errorf("path '%s' must evaluate to string for trait %s patch #%d operation #%d, got %T", op.Path, traitName, patchIndex, i, pathValue)
 		}
 
+		// Render the from path of move and copy operations
+		var fromStr string
+		if op.From != "" {
+			fromValue, err := p.templateEngine.Render(op.From, context, p.renderOptions(opPath+".from")...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render from '%s' for trait %s patch #%d operation #%d: %w", op.From, traitName, patchIndex, i, err)
+			}
+			fromStr, ok = fromValue.(string)
+			if !ok {
+				return nil, fmt.Errorf("from '%s' must evaluate to string for trait %s patch #%d operation #%d, got %T", op.From, traitName, patchIndex, i, fromValue)
+			}
+		}
+
 		// Render the value (unless this is a remove operation)
 		var value any
 		if op.Op != "remove" {
@@ -339,7 +401,7 @@ func (p *Processor) renderOperations(
 				}
 
 				// Render the value (which may contain CEL expressions)
-				value, err = p.templateEngine.Render(value, context)
+				value, err = p.templateEngine.Render(value, context, p.renderOptions(opPath+".value")...)
 				if err != nil {
 					return nil, fmt.Errorf("failed to render value for trait %s patch #%d operation #%d: %w", traitName, patchIndex, i, err)
 				}
@@ -349,6 +411,7 @@ func (p *Processor) renderOperations(
 		rendered[i] = patch.JSONPatchOperation{
 			Op:    op.Op,
 			Path:  pathStr,
+			From:  fromStr,
 			Value: value,
 		}
 	}
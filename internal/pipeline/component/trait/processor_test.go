@@ -330,6 +330,133 @@ spec:
                 mountPath: /data1
               - name: vol2
                 mountPath: /data2
+`,
+			wantErr: false,
+		},
+		{
+			name: "failed test skips the target when onTestFailure is Skip",
+			resourcesYAML: `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: web
+  spec:
+    replicas: 1
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: worker
+  spec:
+    replicas: 2
+`,
+			traitYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: single-replica-trait
+spec:
+  patches:
+    - target:
+        kind: Deployment
+        version: v1
+        group: apps
+      onTestFailure: Skip
+      operations:
+        - op: test
+          path: /spec/replicas
+          value: 1
+        - op: add
+          path: /metadata/labels
+          value:
+            single-replica: "true"
+`,
+			context: map[string]any{},
+			wantResourcesYAML: `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: web
+    labels:
+      single-replica: "true"
+  spec:
+    replicas: 1
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: worker
+  spec:
+    replicas: 2
+`,
+			wantErr: false,
+		},
+		{
+			name: "failed test aborts by default",
+			resourcesYAML: `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: worker
+  spec:
+    replicas: 2
+`,
+			traitYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: single-replica-trait
+spec:
+  patches:
+    - target:
+        kind: Deployment
+        version: v1
+        group: apps
+      operations:
+        - op: test
+          path: /spec/replicas
+          value: 1
+`,
+			context: map[string]any{},
+			wantErr: true,
+		},
+		{
+			name: "move and copy with rendered from paths",
+			resourcesYAML: `
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: config
+  data:
+    old-key: value
+`,
+			traitYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: rename-trait
+spec:
+  patches:
+    - target:
+        kind: ConfigMap
+        version: v1
+      operations:
+        - op: move
+          from: /data/${parameters.from}
+          path: /data/new-key
+        - op: copy
+          from: /data/new-key
+          path: /data/backup-key
+`,
+			context: map[string]any{
+				"parameters": map[string]any{"from": "old-key"},
+			},
+			wantResourcesYAML: `
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: config
+  data:
+    new-key: value
+    backup-key: value
 `,
 			wantErr: false,
 		},
@@ -4,6 +4,8 @@
 package component
 
 import (
+	"time"
+
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
 	"github.com/openchoreo/openchoreo/internal/template"
@@ -57,6 +59,11 @@ type RenderInput struct {
 	// Optional - can be nil if no secret references need to be resolved.
 	SecretReferences map[string]*v1alpha1.SecretReference
 
+	// ConnectionEndpoints are the resolved endpoints of the API connections of the workload.
+	// Keyed by connection name.
+	// Optional - API connections without a resolved endpoint are not injected.
+	ConnectionEndpoints map[string]pipelinecontext.ConnectionEndpoint
+
 	// Metadata provides structured naming information.
 	// Required - controller must compute and provide this.
 	Metadata pipelinecontext.MetadataContext
@@ -69,6 +76,32 @@ type RenderOutput struct {
 
 	// Metadata contains information about the rendering process.
 	Metadata *RenderMetadata
+
+	// Sources describes what produced each resource, parallel to Resources.
+	// Only populated when source tracking is enabled (see WithSourceTracking).
+	Sources []ResourceSource
+
+	// Trace records the evaluated template expressions in evaluation order.
+	// Only populated when tracing is enabled (see WithTracing).
+	Trace []template.TraceEntry
+}
+
+// ResourceSource describes where a rendered resource came from.
+type ResourceSource struct {
+	// TemplateID is the ID of the ComponentType resource template that rendered the resource.
+	// Empty for resources created by traits.
+	TemplateID string
+
+	// Trait is the name of the trait that created the resource.
+	// Empty for resources rendered from the ComponentType.
+	Trait string
+
+	// TraitInstance is the instance name of the trait that created the resource.
+	TraitInstance string
+
+	// PatchedBy lists the trait instances ("trait/instance") whose patches modified the resource,
+	// in the order they were applied.
+	PatchedBy []string
 }
 
 // RenderMetadata contains information about the rendering process.
@@ -100,6 +133,18 @@ type RenderOptions struct {
 
 	// ResourceAnnotations are additional annotations to add to all rendered resources.
 	ResourceAnnotations map[string]string
+
+	// TrackSources records which template or trait produced each resource in RenderOutput.Sources.
+	// Disabled by default as detecting trait patches requires copying resources for every trait.
+	TrackSources bool
+
+	// EnableTracing records every evaluated template expression in RenderOutput.Trace.
+	// Disabled by default as it is only needed to debug rendered resources.
+	EnableTracing bool
+
+	// EvaluationTimeout bounds the time spent evaluating the templates of a render.
+	// Zero disables the timeout; evaluation is then only bounded by the cost limits of the template engine.
+	EvaluationTimeout time.Duration
 }
 
 // DefaultRenderOptions returns the default rendering options.
@@ -0,0 +1,212 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// TemplateError describes an invalid expression in a ComponentType or Trait.
+type TemplateError struct {
+	// ResourceID identifies the template containing the expression.
+	// It is the resource ID for ComponentTypes, and "creates[i]" or "patches[i]" for Traits.
+	ResourceID string
+
+	// Path is the JSON path of the expression within the template.
+	// Example: "template.spec.replicas"
+	Path string
+
+	// Expression is the CEL expression without the ${...} delimiters.
+	Expression string
+
+	// Message describes why the expression is invalid.
+	Message string
+}
+
+func (e TemplateError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.ResourceID, e.Path, e.Message)
+}
+
+// ValidateComponentType statically checks every expression in the resources of a ComponentType
+// against the types declared by its schema, without rendering the resources.
+//
+// An error is returned when the schema itself is invalid and the expressions cannot be checked.
+func ValidateComponentType(ct *v1alpha1.ComponentType) ([]TemplateError, error) {
+	variables, err := context.ComponentContextVariables(ct)
+	if err != nil {
+		return nil, err
+	}
+	checker, err := template.NewChecker(variables...)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []TemplateError
+	for _, resource := range ct.Spec.Resources {
+		v := &templateValidator{resourceID: resource.ID}
+
+		if resource.IncludeWhen != "" {
+			v.checkExpression(checker, resource.IncludeWhen, "includeWhen", cel.BoolType)
+		}
+
+		resourceChecker := v.forEachChecker(checker, resource.ForEach, resource.Var)
+		if resourceChecker != nil {
+			v.checkTemplate(resourceChecker, resource.Template, "template")
+		}
+		errs = append(errs, v.errs...)
+	}
+	return errs, nil
+}
+
+// ValidateTrait statically checks every expression in the creates and patches of a Trait
+// against the types declared by its schema, without rendering them.
+//
+// An error is returned when the schema itself is invalid and the expressions cannot be checked.
+func ValidateTrait(trait *v1alpha1.Trait) ([]TemplateError, error) {
+	variables, err := context.TraitContextVariables(trait)
+	if err != nil {
+		return nil, err
+	}
+	checker, err := template.NewChecker(variables...)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []TemplateError
+	for i, create := range trait.Spec.Creates {
+		v := &templateValidator{resourceID: fmt.Sprintf("creates[%d]", i)}
+		v.checkTemplate(checker, create.Template, "template")
+		errs = append(errs, v.errs...)
+	}
+
+	for i, traitPatch := range trait.Spec.Patches {
+		v := &templateValidator{resourceID: fmt.Sprintf("patches[%d]", i)}
+
+		patchChecker := v.forEachChecker(checker, traitPatch.ForEach, traitPatch.Var)
+		if patchChecker == nil {
+			errs = append(errs, v.errs...)
+			continue
+		}
+
+		if traitPatch.Target.Where != "" {
+			// The where clause is evaluated with each target resource bound to "resource"
+			whereChecker, err := patchChecker.WithVariable("resource", cel.DynType)
+			if err != nil {
+				return nil, err
+			}
+			v.checkExpression(whereChecker, traitPatch.Target.Where, "target.where", cel.BoolType)
+		}
+
+		for j, op := range traitPatch.Operations {
+			opPath := fmt.Sprintf("operations[%d]", j)
+			v.checkExpression(patchChecker, op.Path, opPath+".path", cel.StringType)
+			if op.From != "" {
+				v.checkExpression(patchChecker, op.From, opPath+".from", cel.StringType)
+			}
+			if op.Op != "remove" {
+				v.checkTemplate(patchChecker, op.Value, opPath+".value")
+			}
+		}
+		errs = append(errs, v.errs...)
+	}
+	return errs, nil
+}
+
+// templateValidator collects the errors of the expressions of a single resource template.
+type templateValidator struct {
+	resourceID string
+	errs       []TemplateError
+}
+
+// checkTemplate checks all expressions in a raw template.
+func (v *templateValidator) checkTemplate(checker *template.Checker, raw *runtime.RawExtension, path string) {
+	if raw == nil || raw.Raw == nil {
+		return
+	}
+
+	var data any
+	if err := json.Unmarshal(raw.Raw, &data); err != nil {
+		v.addError(path, "", fmt.Sprintf("failed to unmarshal template: %v", err))
+		return
+	}
+	v.addCheckErrors(checker.Check(data, path))
+}
+
+// checkExpression checks an expression and that it renders to the expected type.
+// It returns the type the expression renders to, or nil if the expression is invalid.
+func (v *templateValidator) checkExpression(checker *template.Checker, expr, path string, expected *cel.Type) *cel.Type {
+	actual, checkErrs := checker.CheckType(expr, path)
+	if len(checkErrs) > 0 {
+		v.addCheckErrors(checkErrs)
+		return nil
+	}
+	if !template.IsAssignable(expected, actual) {
+		v.addError(path, expr, fmt.Sprintf("must evaluate to %s, got %s", expected, actual))
+		return nil
+	}
+	return actual
+}
+
+// forEachChecker returns the checker for the body of a template, declaring the loop variable
+// with the element type of the forEach list. It returns nil if the forEach expression is invalid.
+func (v *templateValidator) forEachChecker(checker *template.Checker, forEach, varName string) *template.Checker {
+	if forEach == "" {
+		return checker
+	}
+
+	listType := v.checkExpression(checker, forEach, "forEach", cel.ListType(cel.DynType))
+	if listType == nil {
+		return nil
+	}
+
+	if varName == "" {
+		varName = "item"
+	}
+	bodyChecker, err := checker.WithVariable(varName, template.ListElemType(listType))
+	if err != nil {
+		v.addError("var", varName, err.Error())
+		return nil
+	}
+	return bodyChecker
+}
+
+func (v *templateValidator) addCheckErrors(checkErrs []template.CheckError) {
+	for _, checkErr := range checkErrs {
+		v.addError(checkErr.Path, checkErr.Expression, checkErr.Message)
+	}
+}
+
+func (v *templateValidator) addError(path, expr, message string) {
+	v.errs = append(v.errs, TemplateError{
+		ResourceID: v.resourceID,
+		Path:       path,
+		Expression: expr,
+		Message:    message,
+	})
+}
+
+// maxReportedTemplateErrors limits the number of errors listed in a status message.
+const maxReportedTemplateErrors = 10
+
+// FormatTemplateErrors summarizes template errors in a single message, such as a condition message.
+func FormatTemplateErrors(errs []TemplateError) string {
+	messages := make([]string, 0, min(len(errs), maxReportedTemplateErrors)+1)
+	for i, err := range errs {
+		if i == maxReportedTemplateErrors {
+			messages = append(messages, fmt.Sprintf("and %d more", len(errs)-maxReportedTemplateErrors))
+			break
+		}
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
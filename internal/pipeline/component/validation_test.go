@@ -0,0 +1,221 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestValidateComponentType(t *testing.T) {
+	ctYAML := `
+    apiVersion: openchoreo.dev/v1alpha1
+    kind: ComponentType
+    metadata:
+      name: web-service
+    spec:
+      workloadType: deployment
+      schema:
+        parameters:
+          replicas: "integer | default=1"
+          ports: "array<integer> | default=[]"
+          labels: "map<string> | default={}"
+        envOverrides:
+          autoscaling:
+            enabled: "boolean | default=false"
+      resources:
+        - id: deployment
+          template:
+            apiVersion: apps/v1
+            kind: Deployment
+            metadata:
+              name: ${metadata.name}
+              labels: ${oc_merge(metadata.labels, parameters.labels)}
+            spec:
+              replicas: ${parameters.replicas}
+              selector:
+                matchLabels: ${metadata.podSelectors}
+              template:
+                spec:
+                  containers:
+                    - name: main
+                      image: ${workload.containers["main"].image}
+        - id: hpa
+          includeWhen: ${parameters.autoscaling.enabled}
+          template:
+            apiVersion: autoscaling/v2
+            kind: HorizontalPodAutoscaler
+            metadata:
+              name: ${metadata.name}
+        - id: public-route
+          includeWhen: ${workload.endpoints["http"].networkVisibilities.public}
+          template:
+            apiVersion: gateway.networking.k8s.io/v1
+            kind: HTTPRoute
+            metadata:
+              name: ${metadata.name}
+        - id: service
+          forEach: ${parameters.ports}
+          var: port
+          template:
+            apiVersion: v1
+            kind: Service
+            metadata:
+              name: ${metadata.name + "-" + string(port)}
+`
+	tests := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{
+			name: "valid component type",
+		},
+		{
+			name: "undefined parameter",
+			old:  "${parameters.replicas}",
+			new:  "${parameters.replcas}",
+			want: []string{"deployment: template.spec.replicas: undefined field 'replcas'"},
+		},
+		{
+			name: "undefined variable in map key",
+			old:  "name: main",
+			new:  "${spec.name}: main",
+			want: []string{`deployment: template.spec.template.spec.containers[0]["${spec.name}"]: undeclared reference to 'spec' (in container '')`},
+		},
+		{
+			name: "non boolean includeWhen",
+			old:  "${parameters.autoscaling.enabled}",
+			new:  "${parameters.replicas}",
+			want: []string{"hpa: includeWhen: must evaluate to bool, got double"},
+		},
+		{
+			name: "undefined endpoint visibility field",
+			old:  "networkVisibilities.public",
+			new:  "networkVisibilities.external",
+			want: []string{"public-route: includeWhen: undefined field 'external'"},
+		},
+		{
+			name: "non list forEach",
+			old:  "forEach: ${parameters.ports}",
+			new:  "forEach: ${parameters.labels}",
+			want: []string{"service: forEach: must evaluate to list(dyn), got map(string, string)"},
+		},
+		{
+			name: "loop variable is typed by the list elements",
+			old:  `string(port)`,
+			new:  `port`,
+			want: []string{"service: template.metadata.name: found no matching overload for '_+_' applied to '(string, double)'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ct := &v1alpha1.ComponentType{}
+			if err := yaml.Unmarshal([]byte(strings.Replace(ctYAML, tt.old, tt.new, 1)), ct); err != nil {
+				t.Fatalf("failed to parse component type: %v", err)
+			}
+
+			errs, err := ValidateComponentType(ct)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("ValidateComponentType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTrait(t *testing.T) {
+	traitYAML := `
+    apiVersion: openchoreo.dev/v1alpha1
+    kind: Trait
+    metadata:
+      name: volumes
+    spec:
+      schema:
+        parameters:
+          volumeName: "string"
+          mounts: "array<string> | default=[]"
+      creates:
+        - template:
+            apiVersion: v1
+            kind: PersistentVolumeClaim
+            metadata:
+              name: ${metadata.name}-${trait.instanceName}
+      patches:
+        - forEach: ${parameters.mounts}
+          var: mount
+          target:
+            group: apps
+            version: v1
+            kind: Deployment
+            where: ${resource.metadata.name == metadata.name}
+          operations:
+            - op: add
+              path: /spec/template/spec/volumes/-
+              value:
+                name: ${parameters.volumeName}
+                mountPath: ${mount}
+`
+	tests := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{
+			name: "valid trait",
+		},
+		{
+			name: "workload is not available to traits",
+			old:  "${metadata.name}-${trait.instanceName}",
+			new:  "${workload.name}",
+			want: []string{"creates[0]: template.metadata.name: undeclared reference to 'workload' (in container '')"},
+		},
+		{
+			name: "undefined parameter in patch value",
+			old:  "${parameters.volumeName}",
+			new:  "${parameters.volume}",
+			want: []string{"patches[0]: operations[0].value.name: undefined field 'volume'"},
+		},
+		{
+			name: "non boolean where clause",
+			old:  "${resource.metadata.name == metadata.name}",
+			new:  "${metadata.name}",
+			want: []string{"patches[0]: target.where: must evaluate to bool, got string"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trait := &v1alpha1.Trait{}
+			if err := yaml.Unmarshal([]byte(strings.Replace(traitYAML, tt.old, tt.new, 1)), trait); err != nil {
+				t.Fatalf("failed to parse trait: %v", err)
+			}
+
+			errs, err := ValidateTrait(trait)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("ValidateTrait() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservercel "k8s.io/apiserver/pkg/cel"
+)
+
+// ToDeclType converts a structural schema into the CEL type of the values it describes.
+//
+// Unlike the CEL types of Kubernetes validation rules, the types reflect the values as they
+// are exposed to templates after JSON decoding:
+//   - integer and number fields are doubles
+//   - formatted strings (date-time, duration, ...) remain strings
+//   - objects without properties, preserved unknown fields and int-or-string fields are dyn
+func ToDeclType(s *apiextschema.Structural) *apiservercel.DeclType {
+	if s == nil || s.XIntOrString || (s.XPreserveUnknownFields && len(s.Properties) == 0) {
+		return apiservercel.DynType
+	}
+
+	switch s.Type {
+	case "object":
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
+			return apiservercel.NewMapType(apiservercel.StringType, ToDeclType(s.AdditionalProperties.Structural), -1)
+		}
+		if len(s.Properties) == 0 {
+			return apiservercel.NewMapType(apiservercel.StringType, apiservercel.DynType, -1)
+		}
+		fields := make(map[string]*apiservercel.DeclField, len(s.Properties))
+		for name, prop := range s.Properties {
+			fieldName, ok := apiservercel.Escape(name)
+			if !ok {
+				continue
+			}
+			fields[fieldName] = apiservercel.NewDeclField(fieldName, ToDeclType(&prop), false, nil, nil)
+		}
+		return apiservercel.NewObjectType("object", fields)
+	case "array":
+		if s.Items == nil {
+			return apiservercel.NewListType(apiservercel.DynType, -1)
+		}
+		return apiservercel.NewListType(ToDeclType(s.Items), -1)
+	case "string":
+		return apiservercel.StringType
+	case "integer", "number":
+		return apiservercel.DoubleType
+	case "boolean":
+		return apiservercel.BoolType
+	default:
+		return apiservercel.DynType
+	}
+}
@@ -5,12 +5,15 @@ package schema
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"sort"
 
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/openchoreo/openchoreo/internal/clone"
 	"github.com/openchoreo/openchoreo/internal/schema/extractor"
@@ -76,16 +79,11 @@ func ToJSONSchema(def Definition) (*extv1.JSONSchemaProps, error) {
 //
 // This is primarily used with ApplyDefaults to populate default values.
 func ToStructural(def Definition) (*apiextschema.Structural, error) {
-	jsonSchemaV1, err := ToJSONSchema(def)
+	internal, err := toInternalJSONSchema(def)
 	if err != nil {
 		return nil, err
 	}
 
-	internal := new(apiext.JSONSchemaProps)
-	if err := extv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(jsonSchemaV1, internal, nil); err != nil {
-		return nil, fmt.Errorf("failed to convert schema: %w", err)
-	}
-
 	structural, err := apiextschema.NewStructural(internal)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build structural schema: %w", err)
@@ -93,6 +91,21 @@ func ToStructural(def Definition) (*apiextschema.Structural, error) {
 	return structural, nil
 }
 
+// toInternalJSONSchema converts the definition into the internal apiextensions JSON schema
+// representation used by the Kubernetes structural schema and validation packages.
+func toInternalJSONSchema(def Definition) (*apiext.JSONSchemaProps, error) {
+	jsonSchemaV1, err := ToJSONSchema(def)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := new(apiext.JSONSchemaProps)
+	if err := extv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(jsonSchemaV1, internal, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert schema: %w", err)
+	}
+	return internal, nil
+}
+
 // ApplyDefaults applies schema default values to a target object using Kubernetes defaulting logic.
 //
 // This function walks the structural schema and target object in parallel, filling in default
@@ -104,6 +117,7 @@ func ToStructural(def Definition) (*apiextschema.Structural, error) {
 //   - Existing fields are not overwritten (even if they differ from the default)
 //   - Nested objects are defaulted recursively
 //   - Array items are not defaulted (Kubernetes limitation)
+//   - Defaults of $oneOf/$anyOf variant fields are only added for the variants in use
 //
 // The target map is modified in place and also returned for convenience.
 //
@@ -120,9 +134,84 @@ func ApplyDefaults(target map[string]any, structural *apiextschema.Structural) m
 		target = map[string]any{}
 	}
 	defaulting.Default(target, structural)
+	applyVariantDefaults(target, structural)
 	return target
 }
 
+// applyVariantDefaults adds the defaults of the union variants that are in use.
+//
+// Kubernetes defaulting ignores oneOf/anyOf branches, so the defaults of variant fields are kept
+// in the branches only and applied here once the variant of an object is known. A oneOf variant
+// is only defaulted when it is the single variant in use, while every anyOf variant in use is.
+func applyVariantDefaults(x any, s *apiextschema.Structural) {
+	if s == nil {
+		return
+	}
+	switch x := x.(type) {
+	case map[string]any:
+		if s.ValueValidation != nil {
+			if branches := matchingBranches(x, s.ValueValidation.OneOf); len(branches) == 1 {
+				applyBranchDefaults(x, branches[0])
+			}
+			for _, branch := range matchingBranches(x, s.ValueValidation.AnyOf) {
+				applyBranchDefaults(x, branch)
+			}
+		}
+		for k, v := range x {
+			if prop, ok := s.Properties[k]; ok {
+				applyVariantDefaults(v, &prop)
+			} else if s.AdditionalProperties != nil {
+				applyVariantDefaults(v, s.AdditionalProperties.Structural)
+			}
+		}
+	case []any:
+		for _, v := range x {
+			applyVariantDefaults(v, s.Items)
+		}
+	}
+}
+
+// matchingBranches returns the union branches an object uses: all required fields of the branch
+// are set, and the fields constrained to an enum, like a discriminator, hold one of its values.
+func matchingBranches(obj map[string]any, branches []apiextschema.NestedValueValidation) []*apiextschema.NestedValueValidation {
+	var matches []*apiextschema.NestedValueValidation
+	for i := range branches {
+		branch := &branches[i]
+		if branchMatches(obj, branch) {
+			matches = append(matches, branch)
+		}
+	}
+	return matches
+}
+
+func branchMatches(obj map[string]any, branch *apiextschema.NestedValueValidation) bool {
+	for _, name := range branch.Required {
+		if _, ok := obj[name]; !ok {
+			return false
+		}
+	}
+	for name, prop := range branch.Properties {
+		value, ok := obj[name]
+		if !ok || len(prop.Enum) == 0 {
+			continue
+		}
+		if !slices.ContainsFunc(prop.Enum, func(e apiextschema.JSON) bool { return reflect.DeepEqual(e.Object, value) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// applyBranchDefaults adds the defaults of the fields of a branch that are not set.
+func applyBranchDefaults(obj map[string]any, branch *apiextschema.NestedValueValidation) {
+	for name, prop := range branch.Properties {
+		if _, ok := obj[name]; ok || prop.ForbiddenGenerics.Default.Object == nil {
+			continue
+		}
+		obj[name] = runtime.DeepCopyJSONValue(prop.ForbiddenGenerics.Default.Object)
+	}
+}
+
 // mergeFieldMaps combines multiple schema maps into a single unified schema.
 //
 // ComponentType separate schemas into logical groups:
@@ -170,6 +259,14 @@ func mergeInto(dst map[string]any, src map[string]any) {
 		return
 	}
 	for k, v := range src {
+		// Validation rules declared by several schemas for the same object all apply
+		if k == extractor.ValidationsKey {
+			existing, _ := dst[k].([]any)
+			if rules, ok := v.([]any); ok && existing != nil {
+				dst[k] = append(existing, clone.DeepCopy(rules).([]any)...)
+				continue
+			}
+		}
 		if vMap, ok := v.(map[string]any); ok {
 			existing, ok := dst[k].(map[string]any)
 			if !ok {
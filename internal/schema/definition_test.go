@@ -3,7 +3,10 @@
 
 package schema
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestApplyDefaults_ArrayFieldBehaviour(t *testing.T) {
 	def := Definition{
@@ -114,3 +117,74 @@ func TestApplyDefaults_ArrayItems(t *testing.T) {
 		t.Fatalf("expected subPath to be a string, got %T", mount["subPath"])
 	}
 }
+
+func TestApplyDefaults_UnionVariants(t *testing.T) {
+	def := Definition{
+		Schemas: []map[string]any{
+			{
+				"auth": map[string]any{
+					"type":           "string | default=none",
+					"$discriminator": "type",
+					"$oneOf": map[string]any{
+						"none": map[string]any{},
+						"oidc": map[string]any{
+							"issuer": "string",
+							"scopes": "[]string | default=[\"openid\"]",
+						},
+					},
+				},
+				"volumes": map[string]any{
+					"$anyOf": []any{
+						map[string]any{"cache": "string", "cacheSize": "string | default=1Gi"},
+						map[string]any{"data": "string", "dataSize": "string | default=10Gi"},
+					},
+				},
+			},
+		},
+	}
+
+	structural, err := ToStructural(def)
+	if err != nil {
+		t.Fatalf("ToStructural returned error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]any
+		want   map[string]any
+	}{
+		{
+			name:   "discriminator default selects a variant without defaults",
+			values: map[string]any{"auth": map[string]any{}, "volumes": map[string]any{"cache": "/cache"}},
+			want: map[string]any{
+				"auth":    map[string]any{"type": "none"},
+				"volumes": map[string]any{"cache": "/cache", "cacheSize": "1Gi"},
+			},
+		},
+		{
+			name:   "defaults of the selected variant are applied",
+			values: map[string]any{"auth": map[string]any{"type": "oidc", "issuer": "https://idp"}, "volumes": map[string]any{"cache": "/cache", "data": "/data"}},
+			want: map[string]any{
+				"auth":    map[string]any{"type": "oidc", "issuer": "https://idp", "scopes": []any{"openid"}},
+				"volumes": map[string]any{"cache": "/cache", "cacheSize": "1Gi", "data": "/data", "dataSize": "10Gi"},
+			},
+		},
+		{
+			name:   "unknown variants are not defaulted",
+			values: map[string]any{"auth": map[string]any{"type": "saml"}, "volumes": map[string]any{}},
+			want: map[string]any{
+				"auth":    map[string]any{"type": "saml"},
+				"volumes": map[string]any{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ApplyDefaults(tt.values, structural)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyDefaults() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package extractor
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -23,6 +24,19 @@ const (
 	typeArray   = "array"
 )
 
+// ValidationsKey is the reserved key that declares CEL validation rules on an object.
+// The rules are evaluated with self bound to the object, so they can express constraints
+// across fields, like x-kubernetes-validations in CRDs:
+//
+//	minReplicas: "integer | default=1"
+//	maxReplicas: "integer | default=3"
+//	$validations:
+//	  - rule: self.maxReplicas >= self.minReplicas
+//	    message: maxReplicas must be greater than or equal to minReplicas
+//
+// A rule can also be written as a plain string when the default message is sufficient.
+const ValidationsKey = "$validations"
+
 // Options configures schema extraction behavior.
 type Options struct {
 	// RequiredByDefault determines whether fields without explicit 'required' or 'default'
@@ -115,9 +129,23 @@ func (c *converter) buildObjectSchema(fields map[string]any) (*extv1.JSONSchemaP
 	}
 	sort.Strings(keys)
 
+	var rules extv1.ValidationRules
 	for _, name := range keys {
 		field := fields[name]
 
+		if name == ValidationsKey {
+			parsed, err := parseValidationRules(field)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", name, err)
+			}
+			rules = parsed
+			continue
+		}
+		if isUnionKey(name) {
+			// Unions are built once all fields of the object are known
+			continue
+		}
+
 		schema, requiredValue, requiredExplicit, err := c.buildFieldSchema(field)
 		if err != nil {
 			return nil, fmt.Errorf("field %q: %w", name, err)
@@ -137,15 +165,55 @@ func (c *converter) buildObjectSchema(fields map[string]any) (*extv1.JSONSchemaP
 	}
 
 	result := &extv1.JSONSchemaProps{
-		Type:       typeObject,
-		Properties: props,
+		Type:         typeObject,
+		Properties:   props,
+		XValidations: rules,
 	}
 	if len(required) > 0 {
 		result.Required = required
 	}
+	if err := c.buildUnion(result, fields); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// parseValidationRules converts the rules declared under ValidationsKey into CEL validation rules.
+// Each rule is either a CEL expression string or an object with the fields of a
+// x-kubernetes-validations rule (rule, message, messageExpression, reason, fieldPath).
+func parseValidationRules(raw any) (extv1.ValidationRules, error) {
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("validation rules must be a list, got %T", raw)
+	}
+
+	rules := make(extv1.ValidationRules, 0, len(items))
+	for i, item := range items {
+		switch typed := item.(type) {
+		case string:
+			rules = append(rules, extv1.ValidationRule{Rule: typed})
+		case map[string]any:
+			data, err := json.Marshal(typed)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+			var rule extv1.ValidationRule
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&rule); err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+			rules = append(rules, rule)
+		default:
+			return nil, fmt.Errorf("rule %d: must be a string or an object, got %T", i, item)
+		}
+		if strings.TrimSpace(rules[i].Rule) == "" {
+			return nil, fmt.Errorf("rule %d: rule must not be empty", i)
+		}
+	}
+	return rules, nil
+}
+
 // buildFieldSchema determines the schema for a field value that may itself be an object or shorthand string.
 func (c *converter) buildFieldSchema(raw any) (*extv1.JSONSchemaProps, bool, bool, error) {
 	switch typed := raw.(type) {
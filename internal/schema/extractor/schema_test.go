@@ -135,6 +135,231 @@ resources: Resources
 	assertConvertedSchema(t, typesYAML, schemaYAML, expected)
 }
 
+func TestConverter_ValidationRules(t *testing.T) {
+	const typesYAML = `
+Scaling:
+  min: 'integer | default=1'
+  max: 'integer | default=1'
+  $validations:
+    - self.max >= self.min
+`
+	const schemaYAML = `
+scaling: Scaling
+$validations:
+  - rule: "!has(self.scaling) || self.scaling.max <= 10"
+    message: at most 10 replicas are allowed
+    fieldPath: .scaling.max
+`
+	const expected = `{
+  "type": "object",
+  "required": [
+    "scaling"
+  ],
+  "properties": {
+    "scaling": {
+      "type": "object",
+      "properties": {
+        "max": {
+          "type": "integer",
+          "default": 1
+        },
+        "min": {
+          "type": "integer",
+          "default": 1
+        }
+      },
+      "x-kubernetes-validations": [
+        {
+          "rule": "self.max \u003e= self.min"
+        }
+      ]
+    }
+  },
+  "x-kubernetes-validations": [
+    {
+      "rule": "!has(self.scaling) || self.scaling.max \u003c= 10",
+      "message": "at most 10 replicas are allowed",
+      "fieldPath": ".scaling.max"
+    }
+  ]
+}`
+
+	assertConvertedSchema(t, typesYAML, schemaYAML, expected)
+}
+
+func TestConverter_OneOfUnion(t *testing.T) {
+	const schemaYAML = `
+$oneOf:
+  - pvc:
+      size: 'string | default=1Gi'
+  - emptyDir: 'map<string> | description="Scratch volume"'
+`
+	const expected = `{
+  "type": "object",
+  "oneOf": [
+    {
+      "required": [
+        "pvc"
+      ],
+      "not": {
+        "anyOf": [
+          {
+            "required": [
+              "emptyDir"
+            ]
+          }
+        ]
+      },
+      "properties": {
+        "pvc": {
+          "type": "object",
+          "properties": {
+            "size": {
+              "type": "string",
+              "default": "1Gi"
+            }
+          }
+        }
+      }
+    },
+    {
+      "required": [
+        "emptyDir"
+      ],
+      "not": {
+        "anyOf": [
+          {
+            "required": [
+              "pvc"
+            ]
+          }
+        ]
+      },
+      "properties": {
+        "emptyDir": {
+          "description": "Scratch volume",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    }
+  ],
+  "properties": {
+    "emptyDir": {
+      "description": "Scratch volume",
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "pvc": {
+      "type": "object",
+      "properties": {
+        "size": {
+          "type": "string",
+          "default": "1Gi"
+        }
+      }
+    }
+  }
+}`
+
+	assertConvertedSchema(t, "", schemaYAML, expected)
+}
+
+func TestConverter_DiscriminatedUnion(t *testing.T) {
+	const typesYAML = `
+Basic:
+  username: string
+`
+	const schemaYAML = `
+$discriminator: type
+$oneOf:
+  basic: Basic
+  oidc:
+    issuer: string
+`
+	const expected = `{
+  "type": "object",
+  "required": [
+    "type"
+  ],
+  "oneOf": [
+    {
+      "title": "basic",
+      "required": [
+        "type",
+        "username"
+      ],
+      "not": {
+        "anyOf": [
+          {
+            "required": [
+              "issuer"
+            ]
+          }
+        ]
+      },
+      "properties": {
+        "type": {
+          "enum": [
+            "basic"
+          ]
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    {
+      "title": "oidc",
+      "required": [
+        "issuer",
+        "type"
+      ],
+      "not": {
+        "anyOf": [
+          {
+            "required": [
+              "username"
+            ]
+          }
+        ]
+      },
+      "properties": {
+        "issuer": {
+          "type": "string"
+        },
+        "type": {
+          "enum": [
+            "oidc"
+          ]
+        }
+      }
+    }
+  ],
+  "properties": {
+    "issuer": {
+      "type": "string"
+    },
+    "type": {
+      "type": "string",
+      "enum": [
+        "basic",
+        "oidc"
+      ]
+    },
+    "username": {
+      "type": "string"
+    }
+  }
+}`
+
+	assertConvertedSchema(t, typesYAML, schemaYAML, expected)
+}
+
 func TestConverter_ArraySyntaxVariants(t *testing.T) {
 	const typesYAML = `
 Item:
@@ -486,6 +711,127 @@ field: "map[string][]object"
 `,
 			expectError: "'object' type is not allowed",
 		},
+		{
+			name: "validation rules not a list",
+			schemaYAML: `
+field: string
+$validations: self.field != ""
+`,
+			expectError: "validation rules must be a list",
+		},
+		{
+			name: "validation rule with unknown field",
+			schemaYAML: `
+field: string
+$validations:
+  - expression: self.field != ""
+`,
+			expectError: "unknown field",
+		},
+		{
+			name: "empty validation rule",
+			schemaYAML: `
+field: string
+$validations:
+  - message: missing rule
+`,
+			expectError: "rule must not be empty",
+		},
+		{
+			name: "oneOf and anyOf on the same object",
+			schemaYAML: `
+$oneOf: [{a: string}, {b: string}]
+$anyOf: [{a: string}, {b: string}]
+`,
+			expectError: "cannot be declared on the same object",
+		},
+		{
+			name: "discriminator without oneOf",
+			schemaYAML: `
+$discriminator: type
+`,
+			expectError: "$discriminator requires $oneOf",
+		},
+		{
+			name: "single variant",
+			schemaYAML: `
+$oneOf:
+  - a: string
+`,
+			expectError: "at least two variants are required",
+		},
+		{
+			name: "variant without required fields",
+			schemaYAML: `
+$anyOf:
+  - a: string
+  - b: 'string | default=x'
+`,
+			expectError: "variant 1: at least one field must be required",
+		},
+		{
+			name: "variant field declared on the enclosing object",
+			schemaYAML: `
+a: string
+$oneOf:
+  - a: string
+  - b: string
+`,
+			expectError: "field \"a\" of variant 0 is also declared on the enclosing object",
+		},
+		{
+			name: "shared variant field with different types",
+			schemaYAML: `
+$oneOf:
+  - a: string
+    c: string
+  - b: string
+    c: integer
+`,
+			expectError: "field \"c\" is declared with different types in several variants",
+		},
+		{
+			name: "discriminated variants as a list",
+			schemaYAML: `
+$discriminator: type
+$oneOf:
+  - a: string
+  - b: string
+`,
+			expectError: "must be a map of variant names",
+		},
+		{
+			name: "discriminator declared in a variant",
+			schemaYAML: `
+$discriminator: type
+$oneOf:
+  a:
+    type: string
+  b: {}
+`,
+			expectError: "cannot be declared in a variant",
+		},
+		{
+			name: "non-string discriminator",
+			schemaYAML: `
+type: integer
+$discriminator: type
+$oneOf:
+  a: {}
+  b: {}
+`,
+			expectError: "discriminator field \"type\" must be a string",
+		},
+		{
+			name: "validation rules in a variant",
+			schemaYAML: `
+$oneOf:
+  - a: string
+    $validations: [self.a != '']
+  - b: string
+`,
+			expectError: "validation rules are not supported in variants",
+		},
 	}
 
 	for _, tt := range tests {
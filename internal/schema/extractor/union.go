@@ -0,0 +1,342 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package extractor
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// Union keys declare alternative sets of fields on an object.
+//
+// $oneOf lists variants of which exactly one must be used, and $anyOf lists variants of which
+// at least one must be used. A variant is a field map or the name of a custom object type:
+//
+//	storage:
+//	  $oneOf:
+//	    - pvc:
+//	        size: "string | default=1Gi"
+//	        storageClass: "string | required=false"
+//	    - emptyDir:
+//	        medium: "string | default=''"
+//
+// A variant is used when all of its required fields are set, so every variant must have at
+// least one required field.
+//
+// With $discriminator, $oneOf maps variant names to their fields instead, and the named string
+// field selects the variant. The discriminator field is declared automatically with the variant
+// names as its enum, unless the object declares it itself, e.g. to give it a default:
+//
+//	auth:
+//	  $discriminator: type
+//	  $oneOf:
+//	    basic:
+//	      username: string
+//	      passwordSecret: string
+//	    oidc:
+//	      issuer: string
+//	      scopes: "[]string | default=[\"openid\"]"
+//
+// The fields of all variants are also declared on the enclosing object so that they can be
+// defaulted, pruned and referenced from templates; the defaults of variant fields are only
+// applied when their variant is used.
+const (
+	OneOfKey         = "$oneOf"
+	AnyOfKey         = "$anyOf"
+	DiscriminatorKey = "$discriminator"
+)
+
+// unionVariant is a variant of a union with its object schema.
+type unionVariant struct {
+	name   string
+	schema *extv1.JSONSchemaProps
+}
+
+// isUnionKey reports whether a field map key is one of the union keys.
+func isUnionKey(key string) bool {
+	return key == OneOfKey || key == AnyOfKey || key == DiscriminatorKey
+}
+
+// buildUnion adds the union declared in a field map, if any, to the schema of the object.
+func (c *converter) buildUnion(result *extv1.JSONSchemaProps, fields map[string]any) error {
+	oneOf, hasOneOf := fields[OneOfKey]
+	anyOf, hasAnyOf := fields[AnyOfKey]
+	discriminator, hasDiscriminator := fields[DiscriminatorKey]
+
+	switch {
+	case hasOneOf && hasAnyOf:
+		return fmt.Errorf("%s and %s cannot be declared on the same object", OneOfKey, AnyOfKey)
+	case hasDiscriminator && !hasOneOf:
+		return fmt.Errorf("%s requires %s", DiscriminatorKey, OneOfKey)
+	case hasDiscriminator:
+		name, ok := discriminator.(string)
+		if !ok || strings.TrimSpace(name) == "" {
+			return fmt.Errorf("%s must be the name of a field", DiscriminatorKey)
+		}
+		variants, err := c.buildNamedVariants(oneOf)
+		if err != nil {
+			return fmt.Errorf("%s: %w", OneOfKey, err)
+		}
+		return buildDiscriminatedUnion(result, strings.TrimSpace(name), variants)
+	case hasOneOf:
+		variants, err := c.buildVariantList(oneOf)
+		if err != nil {
+			return fmt.Errorf("%s: %w", OneOfKey, err)
+		}
+		return buildMemberUnion(result, variants, true)
+	case hasAnyOf:
+		variants, err := c.buildVariantList(anyOf)
+		if err != nil {
+			return fmt.Errorf("%s: %w", AnyOfKey, err)
+		}
+		return buildMemberUnion(result, variants, false)
+	default:
+		return nil
+	}
+}
+
+// buildVariantList builds the variants of a union declared as a list.
+func (c *converter) buildVariantList(raw any) ([]unionVariant, error) {
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("variants must be a list, got %T", raw)
+	}
+	if len(items) < 2 {
+		return nil, fmt.Errorf("at least two variants are required")
+	}
+
+	variants := make([]unionVariant, 0, len(items))
+	for i, item := range items {
+		schema, err := c.buildVariant(item)
+		if err != nil {
+			return nil, fmt.Errorf("variant %d: %w", i, err)
+		}
+		if len(schema.Required) == 0 {
+			return nil, fmt.Errorf("variant %d: at least one field must be required to tell the variants apart", i)
+		}
+		variants = append(variants, unionVariant{name: fmt.Sprintf("%d", i), schema: schema})
+	}
+	return variants, nil
+}
+
+// buildNamedVariants builds the variants of a discriminated union, sorted by name.
+func (c *converter) buildNamedVariants(raw any) ([]unionVariant, error) {
+	items, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("variants of a discriminated union must be a map of variant names, got %T", raw)
+	}
+	if len(items) < 2 {
+		return nil, fmt.Errorf("at least two variants are required")
+	}
+
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	variants := make([]unionVariant, 0, len(names))
+	for _, name := range names {
+		schema, err := c.buildVariant(items[name])
+		if err != nil {
+			return nil, fmt.Errorf("variant %q: %w", name, err)
+		}
+		variants = append(variants, unionVariant{name: name, schema: schema})
+	}
+	return variants, nil
+}
+
+// buildVariant builds the object schema of a variant from a field map or a custom type name.
+// A variant without fields can be declared with an empty map, e.g. for a discriminator value
+// that needs no further configuration.
+func (c *converter) buildVariant(raw any) (*extv1.JSONSchemaProps, error) {
+	var (
+		schema *extv1.JSONSchemaProps
+		err    error
+	)
+	switch typed := raw.(type) {
+	case nil:
+		schema, err = c.buildObjectSchema(map[string]any{})
+	case map[string]any:
+		schema, err = c.buildObjectSchema(typed)
+	case string:
+		schema, err = c.schemaFromCustomType(strings.TrimSpace(typed))
+	default:
+		return nil, fmt.Errorf("must be a field map or a type name, got %T", raw)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case schema.Type != typeObject || schema.Properties == nil:
+		return nil, fmt.Errorf("must be an object with declared fields")
+	case len(schema.XValidations) > 0:
+		return nil, fmt.Errorf("validation rules are not supported in variants; declare them on the enclosing object")
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		return nil, fmt.Errorf("unions cannot be nested directly in variants; declare them on a field of the variant")
+	}
+	return schema, nil
+}
+
+// buildMemberUnion adds a union whose variants are told apart by their required fields.
+// Exclusive unions reject the fields of the other variants in each variant.
+func buildMemberUnion(result *extv1.JSONSchemaProps, variants []unionVariant, exclusive bool) error {
+	if err := mergeVariantFields(result, variants); err != nil {
+		return err
+	}
+
+	branches := make([]extv1.JSONSchemaProps, 0, len(variants))
+	for _, variant := range variants {
+		branch := extv1.JSONSchemaProps{
+			Properties: variant.schema.Properties,
+			Required:   sortedCopy(variant.schema.Required),
+		}
+		if exclusive {
+			branch.Not = foreignFieldsSchema(variant, variants)
+		}
+		branches = append(branches, branch)
+	}
+
+	if exclusive {
+		result.OneOf = branches
+	} else {
+		result.AnyOf = branches
+	}
+	return nil
+}
+
+// buildDiscriminatedUnion adds a union whose variant is selected by the value of a discriminator field.
+func buildDiscriminatedUnion(result *extv1.JSONSchemaProps, discriminator string, variants []unionVariant) error {
+	names := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		if _, ok := variant.schema.Properties[discriminator]; ok {
+			return fmt.Errorf("variant %q: the discriminator field %q cannot be declared in a variant", variant.name, discriminator)
+		}
+		names = append(names, variant.name)
+	}
+
+	prop, declared := result.Properties[discriminator]
+	if declared && prop.Type != typeString {
+		return fmt.Errorf("discriminator field %q must be a string, got %q", discriminator, prop.Type)
+	}
+	if !declared {
+		prop = extv1.JSONSchemaProps{Type: typeString}
+		result.Required = append(result.Required, discriminator)
+		sort.Strings(result.Required)
+	}
+	if len(prop.Enum) == 0 {
+		prop.Enum = jsonEnum(names...)
+	}
+	result.Properties[discriminator] = prop
+
+	if err := mergeVariantFields(result, variants); err != nil {
+		return err
+	}
+
+	branches := make([]extv1.JSONSchemaProps, 0, len(variants))
+	for _, variant := range variants {
+		properties := make(map[string]extv1.JSONSchemaProps, len(variant.schema.Properties)+1)
+		for name, fieldSchema := range variant.schema.Properties {
+			properties[name] = fieldSchema
+		}
+		properties[discriminator] = extv1.JSONSchemaProps{Enum: jsonEnum(variant.name)}
+
+		branches = append(branches, extv1.JSONSchemaProps{
+			Title:      variant.name,
+			Properties: properties,
+			Required:   sortedCopy(append([]string{discriminator}, variant.schema.Required...)),
+			Not:        foreignFieldsSchema(variant, variants),
+		})
+	}
+	result.OneOf = branches
+	return nil
+}
+
+// mergeVariantFields declares the fields of all variants on the enclosing object.
+// A field shared by several variants must have the same schema in each of them. The default of
+// a variant field is not declared on the enclosing object, since it only applies to its variant.
+func mergeVariantFields(result *extv1.JSONSchemaProps, variants []unionVariant) error {
+	declared := make(map[string]bool, len(result.Properties))
+	for name := range result.Properties {
+		declared[name] = true
+	}
+
+	for _, variant := range variants {
+		names := make([]string, 0, len(variant.schema.Properties))
+		for name := range variant.schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if declared[name] {
+				return fmt.Errorf("field %q of variant %s is also declared on the enclosing object", name, variant.name)
+			}
+			fieldSchema := variant.schema.Properties[name]
+			shared := *fieldSchema.DeepCopy()
+			shared.Default = nil
+			if existing, ok := result.Properties[name]; ok {
+				if !reflect.DeepEqual(existing, shared) {
+					return fmt.Errorf("field %q is declared with different types in several variants", name)
+				}
+				continue
+			}
+			result.Properties[name] = shared
+		}
+	}
+	return nil
+}
+
+// foreignFieldsSchema returns a schema matching objects that set a field of another variant
+// that is not part of the given variant, or nil if there are no such fields.
+func foreignFieldsSchema(variant unionVariant, variants []unionVariant) *extv1.JSONSchemaProps {
+	foreign := map[string]bool{}
+	for _, other := range variants {
+		for name := range other.schema.Properties {
+			if _, own := variant.schema.Properties[name]; !own {
+				foreign[name] = true
+			}
+		}
+	}
+	if len(foreign) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(foreign))
+	for name := range foreign {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	anyOf := make([]extv1.JSONSchemaProps, 0, len(names))
+	for _, name := range names {
+		anyOf = append(anyOf, extv1.JSONSchemaProps{Required: []string{name}})
+	}
+	return &extv1.JSONSchemaProps{AnyOf: anyOf}
+}
+
+// jsonEnum converts string values into enum values.
+func jsonEnum(values ...string) []extv1.JSON {
+	enum := make([]extv1.JSON, 0, len(values))
+	for _, value := range values {
+		raw, _ := json.Marshal(value)
+		enum = append(enum, extv1.JSON{Raw: raw})
+	}
+	return enum
+}
+
+func sortedCopy(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := append([]string(nil), values...)
+	sort.Strings(result)
+	return result
+}
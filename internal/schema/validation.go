@@ -0,0 +1,134 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	schemacel "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiextvalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+
+	"github.com/openchoreo/openchoreo/internal/clone"
+)
+
+// Validate performs a full schema validation of values against the definition.
+//
+// Unlike ValidateAgainstSchema, which only reports unknown fields, Validate checks
+// types, required fields, enums and value constraints using the same validator the
+// Kubernetes API server uses for custom resources. Schema defaults are applied to a
+// copy of the values before validation so that required fields with defaults are
+// satisfied; the provided values are never modified.
+//
+// The returned error is non-nil only when the definition itself cannot be converted
+// into a schema. Validation failures are reported through the field.ErrorList, with
+// paths rooted at fldPath.
+//
+// Example:
+//
+//	Schema defines: {replicas: "integer | minimum=1"}
+//	Input:          {replicas: 0, extra: true}
+//	Errors:         [fldPath.replicas: should be greater than or equal to 1, fldPath.extra: Forbidden]
+func Validate(fldPath *field.Path, values map[string]any, def Definition) (field.ErrorList, error) {
+	return validate(fldPath, values, def, true)
+}
+
+// ValidateStructure performs the checks of Validate without evaluating the $validations rules.
+// It is meant for values that are only part of the object the rules apply to, such as component
+// parameters that are completed by environment overrides before rendering.
+func ValidateStructure(fldPath *field.Path, values map[string]any, def Definition) (field.ErrorList, error) {
+	return validate(fldPath, values, def, false)
+}
+
+func validate(fldPath *field.Path, values map[string]any, def Definition, evaluateRules bool) (field.ErrorList, error) {
+	internal, err := toInternalJSONSchema(def)
+	if err != nil {
+		return nil, err
+	}
+
+	structural, err := apiextschema.NewStructural(internal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build structural schema: %w", err)
+	}
+
+	validator, _, err := apiextvalidation.NewSchemaValidator(internal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build schema validator: %w", err)
+	}
+
+	target := clone.DeepCopyMap(values)
+	if target == nil {
+		target = map[string]any{}
+	}
+	ApplyDefaults(target, structural)
+
+	allErrs := apiextvalidation.ValidateCustomResource(fldPath, target, validator)
+	// The validator reports the fields of an object in map order, so the errors are sorted to report them
+	// in the same order on every validation
+	sort.SliceStable(allErrs, func(i, j int) bool { return allErrs[i].Field < allErrs[j].Field })
+
+	// Pruning removes the unknown fields from the copy and reports their paths
+	unknownFields := pruning.PruneWithOptions(target, structural, false, apiextschema.UnknownFieldPathOptions{
+		TrackUnknownFieldPaths: true,
+	})
+	sort.Strings(unknownFields)
+	for _, path := range unknownFields {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child(path), "field is not declared in the schema"))
+	}
+
+	// Rules can only be evaluated against values that match the declared types
+	if evaluateRules && len(allErrs) == 0 {
+		allErrs = append(allErrs, ValidateRules(fldPath, target, structural)...)
+	}
+
+	return allErrs, nil
+}
+
+// ValidateRules evaluates the CEL validation rules declared with $validations in the schema
+// against values, which are expected to be defaulted and to match the schema types.
+// Rule failures are reported with paths rooted at fldPath, or at the fieldPath of the rule.
+//
+// Example:
+//
+//	Schema defines: {min: "integer", max: "integer", $validations: [{rule: "self.max >= self.min"}]}
+//	Input:          {min: 3, max: 1}
+//	Errors:         [fldPath: Invalid value: failed rule: self.max >= self.min]
+func ValidateRules(fldPath *field.Path, values map[string]any, structural *apiextschema.Structural) field.ErrorList {
+	validator := schemacel.NewValidator(structural, false, celconfig.PerCallLimit)
+	if validator == nil {
+		return nil
+	}
+
+	// Integer fields must be int64 for the validator, while decoded values hold float64 numbers
+	obj, err := normalizeNumbers(values)
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+
+	errs, _ := validator.Validate(context.Background(), fldPath, structural, obj, nil, celconfig.RuntimeCELCostBudget)
+	return errs
+}
+
+// normalizeNumbers round trips values through JSON so that whole numbers are decoded as int64.
+func normalizeNumbers(values map[string]any) (map[string]any, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal values: %w", err)
+	}
+	var result map[string]any
+	if err := utiljson.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal values: %w", err)
+	}
+	if result == nil {
+		result = map[string]any{}
+	}
+	return result, nil
+}
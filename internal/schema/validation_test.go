@@ -0,0 +1,278 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidate(t *testing.T) {
+	def := Definition{
+		Types: map[string]any{
+			"Resources": map[string]any{
+				"cpu": "string | default=100m",
+			},
+		},
+		Schemas: []map[string]any{
+			{
+				"replicas":  "integer | default=1 minimum=1",
+				"image":     "string",
+				"tier":      "string | default=web enum=web,worker",
+				"resources": "Resources",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		values     map[string]any
+		wantErrors []string
+	}{
+		{
+			name:   "Valid values",
+			values: map[string]any{"replicas": float64(2), "image": "nginx", "resources": map[string]any{"cpu": "1"}},
+		},
+		{
+			name:       "Missing required field",
+			values:     map[string]any{"replicas": float64(2), "resources": map[string]any{}},
+			wantErrors: []string{"spec.parameters.image: Required value"},
+		},
+		{
+			name:       "Wrong type",
+			values:     map[string]any{"image": "nginx", "replicas": "two", "resources": map[string]any{}},
+			wantErrors: []string{"spec.parameters.replicas: Invalid value"},
+		},
+		{
+			name:       "Constraint violation",
+			values:     map[string]any{"image": "nginx", "replicas": float64(0), "tier": "batch", "resources": map[string]any{}},
+			wantErrors: []string{"spec.parameters.replicas: Invalid value", "spec.parameters.tier: Unsupported value"},
+		},
+		{
+			name:       "Unknown fields",
+			values:     map[string]any{"image": "nginx", "extra": true, "resources": map[string]any{"memory": "1Gi"}},
+			wantErrors: []string{"spec.parameters.extra: Forbidden", "spec.parameters.resources.memory: Forbidden"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := len(tt.values)
+			errs, err := Validate(field.NewPath("spec", "parameters"), tt.values, def)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+			if len(tt.values) != original {
+				t.Fatalf("Validate modified the input values: %v", tt.values)
+			}
+			if len(errs) != len(tt.wantErrors) {
+				t.Fatalf("Validate() returned %d errors, want %d: %v", len(errs), len(tt.wantErrors), errs)
+			}
+			for i, want := range tt.wantErrors {
+				if !strings.HasPrefix(errs[i].Error(), want) {
+					t.Errorf("error[%d] = %q, want prefix %q", i, errs[i].Error(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	def := Definition{
+		Schemas: []map[string]any{
+			{
+				"scaling": map[string]any{
+					"min": "integer | default=1",
+					"max": "integer | default=1",
+					"$validations": []any{
+						map[string]any{
+							"rule":    "self.max >= self.min",
+							"message": "max must be greater than or equal to min",
+						},
+					},
+				},
+				"exposed": "boolean | default=false",
+				"host":    "string | default=\"\"",
+				"$validations": []any{
+					map[string]any{
+						"rule":    "self.scaling.max <= 10",
+						"message": "at most 10 replicas are allowed",
+					},
+				},
+			},
+			{
+				"$validations": []any{
+					map[string]any{
+						"rule":      "!self.exposed || self.host != ''",
+						"message":   "host is required when exposed",
+						"fieldPath": ".host",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		values     map[string]any
+		wantErrors []string
+	}{
+		{
+			name:   "Rules satisfied",
+			values: map[string]any{"scaling": map[string]any{"min": float64(1), "max": float64(3)}, "exposed": true, "host": "example.com"},
+		},
+		{
+			name:   "Rules satisfied with defaults",
+			values: map[string]any{"scaling": map[string]any{}},
+		},
+		{
+			name:       "Nested rule violated",
+			values:     map[string]any{"scaling": map[string]any{"min": float64(3), "max": float64(1)}},
+			wantErrors: []string{"spec.parameters.scaling: Invalid value: \"object\": max must be greater than or equal to min"},
+		},
+		{
+			name:       "Rules from several schemas are combined",
+			values:     map[string]any{"scaling": map[string]any{"max": float64(20)}, "exposed": true},
+			wantErrors: []string{"spec.parameters: Invalid value: \"object\": at most 10 replicas are allowed", "spec.parameters.host: Invalid value: \"object\": host is required when exposed"},
+		},
+		{
+			name:       "Rules are skipped when types do not match",
+			values:     map[string]any{"scaling": map[string]any{"min": "three"}},
+			wantErrors: []string{"spec.parameters.scaling.min: Invalid value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := Validate(field.NewPath("spec", "parameters"), tt.values, def)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+			if len(errs) != len(tt.wantErrors) {
+				t.Fatalf("Validate() returned %d errors, want %d: %v", len(errs), len(tt.wantErrors), errs)
+			}
+			for i, want := range tt.wantErrors {
+				if !strings.HasPrefix(errs[i].Error(), want) {
+					t.Errorf("error[%d] = %q, want prefix %q", i, errs[i].Error(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStructure(t *testing.T) {
+	def := Definition{
+		Schemas: []map[string]any{
+			{
+				"replicas": "integer | minimum=1",
+				"$validations": []any{
+					map[string]any{"rule": "self.replicas <= self.maxReplicas"},
+				},
+			},
+		},
+	}
+
+	// Rules are not evaluated, so that they may refer to fields that are not part of the values
+	errs, err := ValidateStructure(field.NewPath("spec", "parameters"), map[string]any{"replicas": float64(2)}, def)
+	if err != nil {
+		t.Fatalf("ValidateStructure returned error: %v", err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("ValidateStructure() returned errors: %v", errs)
+	}
+
+	errs, err = ValidateStructure(field.NewPath("spec", "parameters"), map[string]any{"replicas": float64(0), "extra": true}, def)
+	if err != nil {
+		t.Fatalf("ValidateStructure returned error: %v", err)
+	}
+	if len(errs) != 2 {
+		t.Errorf("ValidateStructure() returned %d errors, want 2: %v", len(errs), errs)
+	}
+}
+
+func TestValidateUnions(t *testing.T) {
+	def := Definition{
+		Schemas: []map[string]any{
+			{
+				"storage": map[string]any{
+					"$oneOf": []any{
+						map[string]any{"pvc": map[string]any{"size": "string | default=1Gi"}},
+						map[string]any{"emptyDir": map[string]any{"medium": "string | default=\"\""}},
+					},
+				},
+				"auth": map[string]any{
+					"$discriminator": "type",
+					"$oneOf": map[string]any{
+						"basic": map[string]any{"username": "string"},
+						"oidc":  map[string]any{"issuer": "string"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		values     map[string]any
+		wantErrors []string
+	}{
+		{
+			name: "One variant of each union",
+			values: map[string]any{
+				"storage": map[string]any{"pvc": map[string]any{}},
+				"auth":    map[string]any{"type": "oidc", "issuer": "https://idp"},
+			},
+		},
+		{
+			name: "Several variants of a oneOf",
+			values: map[string]any{
+				"storage": map[string]any{"pvc": map[string]any{}, "emptyDir": map[string]any{}},
+				"auth":    map[string]any{"type": "basic", "username": "admin"},
+			},
+			wantErrors: []string{`spec.parameters: Invalid value: "": "storage" must validate one and only one schema (oneOf)`},
+		},
+		{
+			name: "Field of another variant",
+			values: map[string]any{
+				"storage": map[string]any{"emptyDir": map[string]any{}},
+				"auth":    map[string]any{"type": "basic", "username": "admin", "issuer": "https://idp"},
+			},
+			wantErrors: []string{`spec.parameters: Invalid value: "": "auth" must validate one and only one schema (oneOf)`},
+		},
+		{
+			name: "Unknown discriminator value",
+			values: map[string]any{
+				"storage": map[string]any{"emptyDir": map[string]any{}},
+				"auth":    map[string]any{"type": "saml"},
+			},
+			wantErrors: []string{`spec.parameters.auth.type: Unsupported value: "saml"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := Validate(field.NewPath("spec", "parameters"), tt.values, def)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+			for _, want := range tt.wantErrors {
+				found := false
+				for _, e := range errs {
+					if strings.HasPrefix(e.Error(), want) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Validate() errors %v do not include %q", errs, want)
+				}
+			}
+			if len(tt.wantErrors) == 0 && len(errs) > 0 {
+				t.Errorf("Validate() returned unexpected errors: %v", errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+// sortedMapAdapter adapts the inputs of templates to CEL values like the default adapter, except that
+// maps are iterated in the order of their keys. Comprehensions over maps, such as
+// connections.transformList(name, c, ...), then render the same resources on every reconcile.
+type sortedMapAdapter struct{}
+
+func (a sortedMapAdapter) NativeToValue(value any) ref.Val {
+	switch v := value.(type) {
+	case map[string]any:
+		return sortedMap{
+			Mapper: types.NewStringInterfaceMap(a, v),
+			keys:   types.NewStringList(a, slices.Sorted(maps.Keys(v))).(traits.Lister),
+		}
+	case []any:
+		// The elements of lists are adapted on access, so nested maps are sorted too
+		return types.NewDynamicList(a, v)
+	default:
+		return types.DefaultTypeAdapter.NativeToValue(value)
+	}
+}
+
+// sortedMap is a map of strings whose keys are iterated in sorted order
+type sortedMap struct {
+	traits.Mapper
+	keys traits.Lister
+}
+
+func (m sortedMap) Iterator() traits.Iterator {
+	return m.keys.Iterator()
+}
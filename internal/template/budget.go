@@ -0,0 +1,152 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/interpreter"
+)
+
+// Default cost limits. The units are CEL cost units, roughly one per evaluated operation, and
+// the defaults match the limits Kubernetes applies to CEL validation rules.
+const (
+	// DefaultExpressionCostLimit is the default maximum cost of evaluating a single expression.
+	DefaultExpressionCostLimit uint64 = 1_000_000
+	// DefaultRenderCostLimit is the default maximum cost of the expressions evaluated in a render.
+	DefaultRenderCostLimit uint64 = 10_000_000
+)
+
+// interruptCheckFrequency is the number of comprehension iterations after which evaluation
+// checks whether its context is done.
+const interruptCheckFrequency = 100
+
+// CostLimits bounds the cost of evaluating templates, so that expensive expressions such as
+// nested comprehensions over large lists cannot stall the callers rendering them.
+// A zero limit disables the corresponding check.
+type CostLimits struct {
+	// PerExpression is the maximum cost of evaluating a single expression.
+	// Evaluation is aborted as soon as the limit is exceeded.
+	PerExpression uint64
+
+	// PerRender is the maximum total cost of the expressions evaluated with the same Budget.
+	// It is checked after each expression, so a render can exceed it by at most the cost of
+	// one expression.
+	PerRender uint64
+}
+
+// DefaultCostLimits returns the cost limits used by engines created without WithCostLimits.
+func DefaultCostLimits() CostLimits {
+	return CostLimits{
+		PerExpression: DefaultExpressionCostLimit,
+		PerRender:     DefaultRenderCostLimit,
+	}
+}
+
+// WithCostLimits sets the cost limits of the engine.
+//
+// Example:
+//
+//	engine := template.NewEngineWithOptions(template.WithCostLimits(template.CostLimits{
+//		PerExpression: 100_000,
+//		PerRender:     1_000_000,
+//	}))
+func WithCostLimits(limits CostLimits) EngineOption {
+	return func(o *engineOptions) {
+		o.costLimits = limits
+	}
+}
+
+// Budget tracks the evaluation of a single render, which may span several Render calls such as
+// the resource templates of a ComponentType and the creates and patches of its traits.
+// It accumulates the cost of the evaluated expressions against the per-render cost limit and
+// interrupts evaluation once its context is done. A Budget is safe for concurrent use.
+type Budget struct {
+	ctx   context.Context
+	mu    sync.Mutex
+	spent uint64
+}
+
+// NewBudget creates a budget for a render that is interrupted when the context is done.
+func NewBudget(ctx context.Context) *Budget {
+	return &Budget{ctx: ctx}
+}
+
+// Spent returns the total cost of the expressions evaluated with the budget so far.
+func (b *Budget) Spent() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.spent
+}
+
+// charge adds the cost of an expression and reports whether the total exceeds the limit.
+func (b *Budget) charge(cost, limit uint64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.spent += cost
+	return limit > 0 && b.spent > limit
+}
+
+// WithBudget evaluates the expressions of the Render call against the budget.
+// Without a budget, each Render call is evaluated against a budget of its own that is never interrupted.
+func WithBudget(budget *Budget) RenderOption {
+	return func(c *renderConfig) {
+		c.budget = budget
+	}
+}
+
+// EvaluationBudgetError is returned when evaluating a template exceeds its cost limits or is
+// interrupted because the context of its budget is done.
+type EvaluationBudgetError struct {
+	// Expression is the expression being evaluated when the budget was exceeded.
+	Expression string
+
+	// Limit is the cost limit that was exceeded, or zero if the evaluation was interrupted.
+	Limit uint64
+
+	// PerRender indicates that the per-render limit was exceeded rather than the per-expression limit.
+	PerRender bool
+
+	// Cause is the context error when the evaluation was interrupted.
+	Cause error
+}
+
+func (e *EvaluationBudgetError) Error() string {
+	switch {
+	case e.Cause != nil:
+		return fmt.Sprintf("template exceeded evaluation budget: evaluation of expression '%s' was interrupted: %v", e.Expression, e.Cause)
+	case e.PerRender:
+		return fmt.Sprintf("template exceeded evaluation budget: total cost exceeded the per-render limit of %d at expression '%s'", e.Limit, e.Expression)
+	default:
+		return fmt.Sprintf("template exceeded evaluation budget: expression '%s' exceeded the cost limit of %d", e.Expression, e.Limit)
+	}
+}
+
+func (e *EvaluationBudgetError) Unwrap() error {
+	return e.Cause
+}
+
+// IsEvaluationBudgetError checks if an error was caused by a template exceeding its evaluation budget.
+func IsEvaluationBudgetError(err error) bool {
+	var budgetErr *EvaluationBudgetError
+	return errors.As(err, &budgetErr)
+}
+
+// budgetError converts an evaluation that was cancelled for exceeding the cost limit, or
+// interrupted because its context is done, into an EvaluationBudgetError.
+// It returns nil for any other evaluation error.
+func (e *Engine) budgetError(ctx context.Context, expression string, err error) error {
+	var cancelled interpreter.EvalCancelledError
+	if errors.As(err, &cancelled) && cancelled.Cause == interpreter.CostLimitExceeded {
+		return &EvaluationBudgetError{Expression: expression, Limit: e.costLimits.PerExpression}
+	}
+	// Comprehensions report interruptions as evaluation errors
+	if ctx.Err() != nil {
+		return &EvaluationBudgetError{Expression: expression, Cause: ctx.Err()}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEvaluationBudget(t *testing.T) {
+	t.Parallel()
+
+	items := make([]any, 0, 200)
+	for i := range 200 {
+		items = append(items, int64(i))
+	}
+	inputs := map[string]any{
+		"spec": map[string]any{"items": items, "name": "web"},
+	}
+
+	tests := []struct {
+		name          string
+		limits        CostLimits
+		templates     []any
+		ctx           func() context.Context
+		wantErr       string
+		wantPerRender bool
+		wantCause     error
+	}{
+		{
+			name:   "within limits",
+			limits: DefaultCostLimits(),
+			templates: []any{
+				map[string]any{"count": "${size(spec.items.map(x, x * 2))}"},
+			},
+		},
+		{
+			name:   "expression exceeds its limit",
+			limits: CostLimits{PerExpression: 10_000},
+			templates: []any{
+				map[string]any{"pairs": "${spec.items.map(x, spec.items.map(y, x * y))}"},
+			},
+			wantErr: "expression 'spec.items.map(x, spec.items.map(y, x * y))' exceeded the cost limit of 10000",
+		},
+		{
+			name:   "render exceeds its limit across Render calls",
+			limits: CostLimits{PerExpression: 10_000, PerRender: 5_000},
+			templates: []any{
+				map[string]any{"doubled": "${spec.items.map(x, x * 2)}"},
+				map[string]any{"tripled": "${spec.items.map(x, x * 3)}"},
+			},
+			wantErr:       "total cost exceeded the per-render limit of 5000 at expression 'spec.items.map(x, x * 3)'",
+			wantPerRender: true,
+		},
+		{
+			name:   "zero limits disable the checks",
+			limits: CostLimits{},
+			templates: []any{
+				map[string]any{"pairs": "${size(spec.items.map(x, spec.items.map(y, x * y)))}"},
+			},
+		},
+		{
+			name:   "cancelled context interrupts evaluation",
+			limits: DefaultCostLimits(),
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			templates: []any{
+				map[string]any{"name": "${spec.name}"},
+			},
+			wantErr:   "evaluation of expression 'spec.name' was interrupted: context canceled",
+			wantCause: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx()
+			}
+			engine := NewEngineWithOptions(WithCostLimits(tt.limits))
+			budget := NewBudget(ctx)
+
+			var err error
+			for _, tpl := range tt.templates {
+				if _, err = engine.Render(tpl, inputs, WithBudget(budget)); err != nil {
+					break
+				}
+			}
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Render() error = %v", err)
+				}
+				if budget.Spent() == 0 {
+					t.Errorf("Spent() = 0, want the cost of the evaluated expressions")
+				}
+				return
+			}
+
+			wrapped := fmt.Errorf("failed to render resources: %w", err)
+			if !IsEvaluationBudgetError(wrapped) {
+				t.Fatalf("IsEvaluationBudgetError() = false for error: %v", err)
+			}
+			if !strings.HasPrefix(err.Error(), "template exceeded evaluation budget: ") {
+				t.Errorf("error %q does not start with the budget message", err.Error())
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.wantErr)
+			}
+
+			var budgetErr *EvaluationBudgetError
+			errors.As(err, &budgetErr)
+			if budgetErr.PerRender != tt.wantPerRender {
+				t.Errorf("PerRender = %t, want %t", budgetErr.PerRender, tt.wantPerRender)
+			}
+			if tt.wantCause != nil && !errors.Is(err, tt.wantCause) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.wantCause)
+			}
+		})
+	}
+}
+
+func TestEvaluationBudgetInterruptsComprehensions(t *testing.T) {
+	t.Parallel()
+
+	items := make([]any, 0, 1000)
+	for i := range 1000 {
+		items = append(items, int64(i))
+	}
+
+	// Without cost limits, only the deadline stops the evaluation of the 1M iterations
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	engine := NewEngineWithOptions(WithCostLimits(CostLimits{}))
+	_, err := engine.Render("${items.map(x, items.map(y, x + y)).size()}", map[string]any{"items": items}, WithBudget(NewBudget(ctx)))
+	if !IsEvaluationBudgetError(err) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Render() error = %v, want interrupted evaluation", err)
+	}
+}
+
+func TestIsEvaluationBudgetError(t *testing.T) {
+	t.Parallel()
+
+	if IsEvaluationBudgetError(nil) {
+		t.Error("IsEvaluationBudgetError(nil) = true")
+	}
+	if IsEvaluationBudgetError(errors.New("CEL evaluation error")) {
+		t.Error("IsEvaluationBudgetError() = true for an unrelated error")
+	}
+}
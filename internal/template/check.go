@@ -0,0 +1,190 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	apiservercel "k8s.io/apiserver/pkg/cel"
+)
+
+// Variable declares the type of a top-level template variable for static checking.
+type Variable struct {
+	Name string
+	Type *apiservercel.DeclType
+}
+
+// CheckError describes a template expression that failed static checking.
+type CheckError struct {
+	// Path is the JSON path of the value containing the expression.
+	// Example: "spec.template.spec.containers[0].image"
+	Path string
+
+	// Expression is the CEL expression without the ${...} delimiters.
+	Expression string
+
+	// Message describes why the expression is invalid.
+	Message string
+}
+
+func (e CheckError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Checker compiles template expressions against declared variable types without evaluating them.
+// It catches mistakes like references to undefined fields (${parameters.replcas}) or mismatched
+// types before a template is rendered.
+type Checker struct {
+	env *cel.Env
+}
+
+// NewChecker creates a checker that declares the given variables with their types.
+// Object types must have unique type names across the variables.
+func NewChecker(variables ...Variable) (*Checker, error) {
+	declTypes := make([]*apiservercel.DeclType, 0, len(variables))
+	for _, variable := range variables {
+		declTypes = append(declTypes, variable.Type)
+	}
+
+	// The type provider resolves the fields of the declared object types
+	base, err := cel.NewEnv(libraryOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build CEL environment: %w", err)
+	}
+	providerOptions, err := apiservercel.NewDeclTypeProvider(declTypes...).EnvOptions(base.CELTypeProvider())
+	if err != nil {
+		return nil, fmt.Errorf("failed to register declared types: %w", err)
+	}
+
+	envOptions := append(providerOptions, cel.CrossTypeNumericComparisons(true))
+	for _, variable := range variables {
+		envOptions = append(envOptions, cel.Variable(variable.Name, variable.Type.CelType()))
+	}
+
+	env, err := base.Extend(envOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to declare template variables: %w", err)
+	}
+	return &Checker{env: env}, nil
+}
+
+// WithVariable returns a checker that additionally declares a variable, such as a forEach loop variable.
+func (c *Checker) WithVariable(name string, celType *cel.Type) (*Checker, error) {
+	env, err := c.env.Extend(cel.Variable(name, celType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to declare variable %q: %w", name, err)
+	}
+	return &Checker{env: env}, nil
+}
+
+// Check walks the provided structure like Render and statically checks every expression
+// found in strings and map keys. The path is used as the prefix of the reported JSON paths.
+func (c *Checker) Check(data any, path string) []CheckError {
+	switch v := data.(type) {
+	case string:
+		_, errs := c.CheckType(v, path)
+		return errs
+	case map[string]any:
+		var errs []CheckError
+		for _, key := range mapKeys(v, true) {
+			fieldPath := joinPath(path, key)
+			_, keyErrs := c.CheckType(key, fieldPath)
+			errs = append(errs, keyErrs...)
+			errs = append(errs, c.Check(v[key], fieldPath)...)
+		}
+		return errs
+	case []any:
+		var errs []CheckError
+		for i, item := range v {
+			errs = append(errs, c.Check(item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return errs
+	default:
+		return nil
+	}
+}
+
+// CheckType checks the expressions of a string and returns the type it renders to.
+// Standalone expressions return the checked type of the expression, while strings with
+// interpolated expressions always render to strings.
+func (c *Checker) CheckType(str, path string) (*cel.Type, []CheckError) {
+	expressions, err := findCELExpressions(str)
+	if err != nil {
+		return nil, []CheckError{{Path: path, Expression: str, Message: err.Error()}}
+	}
+	if len(expressions) == 0 {
+		return cel.StringType, nil
+	}
+
+	var errs []CheckError
+	var outputType *cel.Type
+	for _, match := range expressions {
+		ast, issues := c.env.Compile(match.innerExpr)
+		if issues != nil && issues.Err() != nil {
+			errs = append(errs, CheckError{
+				Path:       path,
+				Expression: match.innerExpr,
+				Message:    issueMessage(issues),
+			})
+			continue
+		}
+		outputType = ast.OutputType()
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	// Standalone expression: the native type of the expression is rendered
+	if len(expressions) == 1 && expressions[0].fullExpr == strings.TrimSpace(str) {
+		return outputType, nil
+	}
+	return cel.StringType, nil
+}
+
+// IsAssignable reports whether a checked type can be used where the expected type is required.
+// Dynamic types are only known at render time and are always accepted.
+func IsAssignable(expected, actual *cel.Type) bool {
+	if actual == nil || actual.Kind() == types.DynKind || actual.Kind() == types.AnyKind {
+		return true
+	}
+	return expected.IsAssignableType(actual)
+}
+
+// ListElemType returns the element type of a list type, or dyn when it is unknown.
+func ListElemType(listType *cel.Type) *cel.Type {
+	if listType == nil || listType.Kind() != types.ListKind || len(listType.Parameters()) == 0 {
+		return cel.DynType
+	}
+	return listType.Parameters()[0]
+}
+
+// issueMessage joins the messages of the compilation issues without the source snippets,
+// which are already identified by the path and expression of the CheckError.
+func issueMessage(issues *cel.Issues) string {
+	messages := make([]string, 0, len(issues.Errors()))
+	for _, issue := range issues.Errors() {
+		messages = append(messages, issue.Message)
+	}
+	if len(messages) == 0 {
+		return issues.Err().Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+var identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// joinPath appends a map key to a JSON path, quoting keys that are not plain identifiers.
+func joinPath(path, key string) string {
+	if !identifierPattern.MatchString(key) {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	apiservercel "k8s.io/apiserver/pkg/cel"
+)
+
+func newTestChecker(t *testing.T) *Checker {
+	t.Helper()
+	spec := apiservercel.NewObjectType("__spec_type", map[string]*apiservercel.DeclField{
+		"name":     apiservercel.NewDeclField("name", apiservercel.StringType, false, nil, nil),
+		"replicas": apiservercel.NewDeclField("replicas", apiservercel.DoubleType, false, nil, nil),
+	})
+	checker, err := NewChecker(Variable{Name: "spec", Type: spec})
+	if err != nil {
+		t.Fatalf("failed to create checker: %v", err)
+	}
+	return checker
+}
+
+func TestCheckerCheck(t *testing.T) {
+	checker := newTestChecker(t)
+
+	data := map[string]any{
+		"metadata": map[string]any{
+			"name": "${spec.name}",
+			"labels": map[string]any{
+				"app.kubernetes.io/name": "${spec.nmae}",
+			},
+		},
+		"spec": map[string]any{
+			"replicas": "${spec.replicas}",
+			"args":     []any{"--name=${spec.name}", "${oc_merge(spec.name)}"},
+		},
+	}
+
+	errs := checker.Check(data, "template")
+	want := []string{
+		`template.metadata.labels["app.kubernetes.io/name"]`,
+		"template.spec.args[1]",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), errs)
+	}
+	for i, err := range errs {
+		if err.Path != want[i] {
+			t.Errorf("error %d path = %q, want %q", i, err.Path, want[i])
+		}
+	}
+}
+
+func TestCheckerCheckType(t *testing.T) {
+	checker := newTestChecker(t)
+
+	tests := []struct {
+		expr string
+		want *cel.Type
+	}{
+		{expr: "${spec.replicas}", want: cel.DoubleType},
+		{expr: "  ${spec.replicas > 1}  ", want: cel.BoolType},
+		{expr: "replicas: ${spec.replicas}", want: cel.StringType},
+		{expr: "plain", want: cel.StringType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, errs := checker.CheckType(tt.expr, "field")
+			if len(errs) != 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if !got.IsExactType(tt.want) {
+				t.Errorf("CheckType() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
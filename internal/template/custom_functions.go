@@ -164,9 +164,30 @@ func (o *omitCELValue) Value() interface{} {
 //	oc_hash("test")  -> "4fdcca5d"  # Always produces this hash
 //	oc_hash("test")  -> "4fdcca5d"  # Same input, same output
 //
+// # Library Functions
+//
+// Encoding, hashing, versioning, resource quantity, URL, YAML and UUID helpers:
+//
+//	oc_base64_encode("hello")                  -> "aGVsbG8="
+//	oc_base64_decode("aGVsbG8=")               -> "hello"
+//	oc_sha256("hello")                         -> "2cf24dba5fb0a30e..."  # hex encoded
+//	oc_semver_compare("1.2.0", "v1.10")        -> -1
+//	oc_semver_matches("1.4.2", ">=1.2.0 <2.0.0") -> true
+//	oc_quantity_add("512Mi", "1Gi")            -> "1536Mi"
+//	oc_quantity_sub("1Gi", "512Mi")            -> "512Mi"
+//	oc_quantity_mul("1Gi", 1.5)                -> "1536Mi"
+//	oc_quantity_compare("500m", "1")           -> -1
+//	oc_url_parse("https://db:5432/app?ssl=1")  -> {"scheme": "https", "hostname": "db", "port": "5432", ...}
+//	oc_to_yaml({"a": 1})                       -> "a: 1\n"
+//	oc_from_yaml("a: 1")                       -> {"a": 1}
+//	oc_uuid5("dns", "example.com")             -> "cfbff0d1-9375-5685-968c-48ce8b15ae17"
+//
+// oc_base64_decode() and oc_from_yaml() reject inputs larger than 1 MiB. Decoding binary data
+// into a string is an error; use base64.decode() for bytes instead.
+//
 // All custom functions use the "oc_" prefix to avoid potential conflicts with upstream CEL-go.
 func CustomFunctions() []cel.EnvOption {
-	return []cel.EnvOption{
+	return append([]cel.EnvOption{
 		cel.Macros(generateNameMacro, mergeMacro),
 		cel.Function("oc_omit",
 			cel.Overload("oc_omit", []*cel.Type{}, cel.DynType,
@@ -206,7 +227,7 @@ func CustomFunctions() []cel.EnvOption {
 				}),
 			),
 		),
-	}
+	}, libraryFunctions()...)
 }
 
 // mergeMapFunction implements the binary oc_merge() CEL function.
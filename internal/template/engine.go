@@ -4,9 +4,11 @@
 package template
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/google/cel-go/cel"
@@ -17,18 +19,21 @@ import (
 
 // Engine evaluates CEL backed templates that can contain inline expressions, map keys, and nested structures.
 type Engine struct {
-	cache *EngineCache
+	cache      *EngineCache
+	costLimits CostLimits
 }
 
-// NewEngine creates a new CEL template engine with default cache settings.
+// NewEngine creates a new CEL template engine with default cache settings and cost limits.
 func NewEngine() *Engine {
 	return &Engine{
-		cache: NewEngineCache(),
+		cache:      NewEngineCache(),
+		costLimits: DefaultCostLimits(),
 	}
 }
 
-// NewEngineWithOptions creates a new CEL template engine with custom cache options.
-// Use this for testing and benchmarking different caching strategies.
+// NewEngineWithOptions creates a new CEL template engine with custom options.
+// Use this to configure cost limits (see WithCostLimits), and for testing and benchmarking
+// different caching strategies.
 //
 // Example:
 //
@@ -38,20 +43,51 @@ func NewEngine() *Engine {
 //	// Disable only program cache to measure its impact
 //	engine := template.NewEngineWithOptions(template.DisableProgramCacheOnly())
 func NewEngineWithOptions(opts ...EngineOption) *Engine {
+	options := newEngineOptions(opts)
 	return &Engine{
-		cache: NewEngineCacheWithOptions(opts...),
+		cache:      newEngineCache(options),
+		costLimits: options.costLimits,
 	}
 }
 
 // Render walks the provided structure and evaluates CEL expressions against the supplied inputs.
-func (e *Engine) Render(data any, inputs map[string]any) (any, error) {
+// Options can enable tracing of the evaluated expressions (see WithTrace) and evaluate them
+// against the budget of a larger render (see WithBudget).
+func (e *Engine) Render(data any, inputs map[string]any, opts ...RenderOption) (any, error) {
+	config := newRenderConfig(opts)
+	return e.render(data, inputs, config, config.path)
+}
+
+// Evaluate evaluates a single CEL expression, written without the ${...} delimiters, against the inputs.
+// It is used for expressions that are not embedded in templates, such as patch path filters.
+// Like Render, it evaluates the expression against the budget of a larger render given with WithBudget.
+func (e *Engine) Evaluate(expression string, inputs map[string]any, opts ...RenderOption) (any, error) {
+	config := newRenderConfig(opts)
+	return normalizeCELResult(e.evaluateCEL(expression, inputs, config.budget))
+}
+
+// newRenderConfig applies the render options, evaluating against a budget of its own unless one is given
+func newRenderConfig(opts []RenderOption) *renderConfig {
+	config := &renderConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.budget == nil {
+		config.budget = NewBudget(context.Background())
+	}
+	return config
+}
+
+func (e *Engine) render(data any, inputs map[string]any, config *renderConfig, path string) (any, error) {
 	switch v := data.(type) {
 	case string:
-		return e.renderString(v, inputs)
+		return e.renderString(v, inputs, config, path)
 	case map[string]any:
 		result := make(map[string]any, len(v))
-		for key, value := range v {
-			renderedKey, err := e.renderString(key, inputs)
+		for _, key := range mapKeys(v, config.trace != nil) {
+			value := v[key]
+			fieldPath := joinPath(path, key)
+			renderedKey, err := e.renderString(key, inputs, config, fieldPath)
 			if err != nil {
 				return nil, err
 			}
@@ -63,7 +99,7 @@ func (e *Engine) Render(data any, inputs map[string]any) (any, error) {
 				return nil, fmt.Errorf("dynamic map key '%s' must evaluate to a string, got %T: %v", key, renderedKey, renderedKey)
 			}
 
-			renderedValue, err := e.Render(value, inputs)
+			renderedValue, err := e.render(value, inputs, config, fieldPath)
 			if err != nil {
 				return nil, err
 			}
@@ -75,8 +111,8 @@ func (e *Engine) Render(data any, inputs map[string]any) (any, error) {
 		return result, nil
 	case []any:
 		result := make([]any, 0, len(v))
-		for _, item := range v {
-			rendered, err := e.Render(item, inputs)
+		for i, item := range v {
+			rendered, err := e.render(item, inputs, config, fmt.Sprintf("%s[%d]", path, i))
 			if err != nil {
 				return nil, err
 			}
@@ -108,7 +144,7 @@ func (e *Engine) Render(data any, inputs map[string]any) (any, error) {
 //   - Numbers: formatted with minimal precision (%d for integers, %g for floats)
 //   - Booleans: formatted as "true" or "false"
 //   - Objects/arrays: JSON-marshaled, falling back to %v formatting on error
-func (e *Engine) renderString(str string, inputs map[string]any) (any, error) {
+func (e *Engine) renderString(str string, inputs map[string]any, config *renderConfig, path string) (any, error) {
 	expressions, err := findCELExpressions(str)
 	if err != nil {
 		return nil, err
@@ -120,14 +156,16 @@ func (e *Engine) renderString(str string, inputs map[string]any) (any, error) {
 	// Standalone expression: return native type (e.g., ${spec.replicas} returns int, not "3")
 	trimmed := strings.TrimSpace(str)
 	if len(expressions) == 1 && expressions[0].fullExpr == trimmed {
-		result, err := e.evaluateCEL(expressions[0].innerExpr, inputs)
-		return normalizeCELResult(result, err)
+		result, err := normalizeCELResult(e.evaluateCEL(expressions[0].innerExpr, inputs, config.budget))
+		e.record(config, path, expressions[0].innerExpr, inputs, result, err)
+		return result, err
 	}
 
 	// Interpolation mode: substitute all expressions into the string
 	rendered := str
 	for _, match := range expressions {
-		value, err := e.evaluateCEL(match.innerExpr, inputs)
+		value, err := e.evaluateCEL(match.innerExpr, inputs, config.budget)
+		e.record(config, path, match.innerExpr, inputs, value, err)
 		if err != nil {
 			return nil, err
 		}
@@ -159,6 +197,18 @@ func (e *Engine) renderString(str string, inputs map[string]any) (any, error) {
 	return rendered, nil
 }
 
+// mapKeys returns the keys of a map, sorted when a deterministic order is needed such as for tracing.
+func mapKeys(m map[string]any, sorted bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	if sorted {
+		sort.Strings(keys)
+	}
+	return keys
+}
+
 type celMatch struct {
 	fullExpr  string
 	innerExpr string
@@ -269,7 +319,13 @@ func normalizeCELResult(result any, err error) (any, error) {
 	return result, nil
 }
 
-func (e *Engine) evaluateCEL(expression string, inputs map[string]any) (any, error) {
+// evaluateCEL evaluates an expression and charges its cost to the budget.
+// Exceeding the cost limits, or the context of the budget being done, fails with an EvaluationBudgetError.
+func (e *Engine) evaluateCEL(expression string, inputs map[string]any, budget *Budget) (any, error) {
+	if err := budget.ctx.Err(); err != nil {
+		return nil, &EvaluationBudgetError{Expression: expression, Cause: err}
+	}
+
 	env, err := e.getOrCreateEnv(inputs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build CEL environment: %w", err)
@@ -288,7 +344,7 @@ func (e *Engine) evaluateCEL(expression string, inputs map[string]any) (any, err
 			return nil, fmt.Errorf("CEL compilation error in expression '%s': %w", expression, issues.Err())
 		}
 
-		program, err = env.Program(ast)
+		program, err = env.Program(ast, e.programOptions()...)
 		if err != nil {
 			return nil, fmt.Errorf("CEL program creation error for expression '%s': %w", expression, err)
 		}
@@ -297,17 +353,38 @@ func (e *Engine) evaluateCEL(expression string, inputs map[string]any) (any, err
 		e.cache.SetProgram(envKey, expression, program)
 	}
 
-	result, _, err := program.Eval(inputs)
+	result, details, err := program.ContextEval(budget.ctx, inputs)
+	if details != nil && details.ActualCost() != nil {
+		if budget.charge(*details.ActualCost(), e.costLimits.PerRender) && err == nil {
+			return nil, &EvaluationBudgetError{Expression: expression, Limit: e.costLimits.PerRender, PerRender: true}
+		}
+	}
 	if err != nil {
 		if err.Error() == omitErrMsg {
 			return omitSentinel, nil
 		}
+		if budgetErr := e.budgetError(budget.ctx, expression, err); budgetErr != nil {
+			return nil, budgetErr
+		}
 		return nil, fmt.Errorf("CEL evaluation error in expression '%s': %w", expression, err)
 	}
 
 	return convertCELValue(result), nil
 }
 
+// programOptions returns the options of the programs compiled by the engine, which track the
+// cost of evaluation against the per-expression limit and can be interrupted in comprehensions.
+func (e *Engine) programOptions() []cel.ProgramOption {
+	options := []cel.ProgramOption{
+		cel.CostTracking(libraryCosts{}),
+		cel.InterruptCheckFrequency(interruptCheckFrequency),
+	}
+	if e.costLimits.PerExpression > 0 {
+		options = append(options, cel.CostLimit(e.costLimits.PerExpression))
+	}
+	return options
+}
+
 func (e *Engine) getOrCreateEnv(inputs map[string]any) (*cel.Env, error) {
 	cacheKey := envCacheKey(inputs)
 
@@ -330,15 +407,26 @@ func (e *Engine) getOrCreateEnv(inputs map[string]any) (*cel.Env, error) {
 // buildEnv wires up CEL with the helper surface area expected by our templating story so authors
 // can reuse common snippets like `omit`, `merge`, and `sanitizeK8sResourceName`.
 func buildEnv(inputs map[string]any) (*cel.Env, error) {
-	envOptions := []cel.EnvOption{
-		cel.OptionalTypes(),
-	}
+	envOptions := []cel.EnvOption{}
 
 	// Add variables for all inputs
 	for key := range inputs {
 		envOptions = append(envOptions, cel.Variable(key, cel.DynType))
 	}
 
+	envOptions = append(envOptions, libraryOptions()...)
+	envOptions = append(envOptions, cel.CustomTypeAdapter(sortedMapAdapter{}))
+
+	return cel.NewEnv(envOptions...)
+}
+
+// libraryOptions returns the CEL extensions and custom functions available to templates.
+// These are shared by the rendering and the static checking environments.
+func libraryOptions() []cel.EnvOption {
+	envOptions := []cel.EnvOption{
+		cel.OptionalTypes(),
+	}
+
 	// Add standard CEL extensions
 	envOptions = append(envOptions,
 		ext.Strings(),
@@ -350,9 +438,7 @@ func buildEnv(inputs map[string]any) (*cel.Env, error) {
 	)
 
 	// Add our custom functions
-	envOptions = append(envOptions, CustomFunctions()...)
-
-	return cel.NewEnv(envOptions...)
+	return append(envOptions, CustomFunctions()...)
 }
 
 // convertCELList converts a CEL list value to a native Go slice, filtering out omit markers.
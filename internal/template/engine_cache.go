@@ -12,9 +12,25 @@ import (
 	"github.com/google/cel-go/cel"
 )
 
-// EngineOption configures cache behavior for the template engine.
-// Primarily used for testing and benchmarking different cache strategies.
-type EngineOption func(*EngineCache)
+// EngineOption configures the template engine, such as its cost limits and cache behavior.
+// The cache options are primarily used for testing and benchmarking different cache strategies.
+type EngineOption func(*engineOptions)
+
+// engineOptions holds the settings applied by EngineOptions.
+type engineOptions struct {
+	envCacheDisabled  bool
+	progCacheDisabled bool
+	costLimits        CostLimits
+}
+
+// newEngineOptions applies the options to the default settings.
+func newEngineOptions(opts []EngineOption) *engineOptions {
+	options := &engineOptions{costLimits: DefaultCostLimits()}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
 
 // DisableCache disables all caching (both environment and program caches).
 // Use this for benchmarking to measure the cost of caching vs compilation.
@@ -23,9 +39,9 @@ type EngineOption func(*EngineCache)
 //
 //	engine := template.NewEngineWithOptions(template.DisableCache())
 func DisableCache() EngineOption {
-	return func(cache *EngineCache) {
-		cache.envCacheDisabled = true
-		cache.progCacheDisabled = true
+	return func(o *engineOptions) {
+		o.envCacheDisabled = true
+		o.progCacheDisabled = true
 	}
 }
 
@@ -36,8 +52,8 @@ func DisableCache() EngineOption {
 //
 //	engine := template.NewEngineWithOptions(template.DisableProgramCacheOnly())
 func DisableProgramCacheOnly() EngineOption {
-	return func(cache *EngineCache) {
-		cache.progCacheDisabled = true
+	return func(o *engineOptions) {
+		o.progCacheDisabled = true
 	}
 }
 
@@ -144,11 +160,13 @@ func NewEngineCache() *EngineCache {
 // NewEngineCacheWithOptions creates a new cache with custom options.
 // This is primarily used for benchmarking different cache strategies.
 func NewEngineCacheWithOptions(opts ...EngineOption) *EngineCache {
-	cache := &EngineCache{}
+	return newEngineCache(newEngineOptions(opts))
+}
 
-	// Apply options
-	for _, opt := range opts {
-		opt(cache)
+func newEngineCache(options *engineOptions) *EngineCache {
+	cache := &EngineCache{
+		envCacheDisabled:  options.envCacheDisabled,
+		progCacheDisabled: options.progCacheDisabled,
 	}
 
 	// Only create caches if they're not disabled
@@ -555,6 +555,29 @@ dynamicHash: ${oc_hash(metadata.value)}
 }`,
 			want: `hash: d58b3fa7
 dynamicHash: 578fbe87
+`,
+		},
+		{
+			name: "maps iterated in key order",
+			template: `
+names: ${connections.transformList(name, c, name + "=" + c.port)}
+nested: ${items[0].transformList(k, v, k)}
+`,
+			inputs: `{
+  "connections": {"orders": {"port": "1"}, "billing": {"port": "2"}, "stock": {"port": "3"}, "auth": {"port": "4"},
+    "cart": {"port": "5"}, "search": {"port": "6"}, "payments": {"port": "7"}, "mail": {"port": "8"}},
+  "items": [{"h": 1, "c": 2, "f": 3, "a": 4, "g": 5, "b": 6, "e": 7, "d": 8}]
+}`,
+			want: `names:
+- auth=4
+- billing=2
+- cart=5
+- mail=8
+- orders=1
+- payments=7
+- search=6
+- stock=3
+nested: [a, b, c, d, e, f, g, h]
 `,
 		},
 	}
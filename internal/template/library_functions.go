@@ -0,0 +1,407 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/blang/semver/v4"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter"
+	"github.com/google/uuid"
+	"gopkg.in/inf.v0"
+	"k8s.io/apimachinery/pkg/api/resource"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/yaml"
+)
+
+// maxLibraryInputSize limits the size of the strings decoded or parsed by the library functions,
+// so that a single call cannot allocate unbounded memory while a template is rendered.
+const maxLibraryInputSize = 1 << 20
+
+// libraryFunctions returns the encoding, hashing, versioning, quantity, URL, YAML and UUID functions
+// available to templates. See CustomFunctions for the list of functions.
+func libraryFunctions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("oc_base64_encode",
+			cel.Overload("oc_base64_encode_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					return types.String(base64.StdEncoding.EncodeToString([]byte(arg.(types.String))))
+				}),
+			),
+			cel.Overload("oc_base64_encode_bytes", []*cel.Type{cel.BytesType}, cel.StringType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					return types.String(base64.StdEncoding.EncodeToString(arg.(types.Bytes)))
+				}),
+			),
+		),
+		cel.Function("oc_base64_decode",
+			cel.Overload("oc_base64_decode_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(base64Decode),
+			),
+		),
+		cel.Function("oc_sha256",
+			cel.Overload("oc_sha256_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					return sha256Hex([]byte(arg.(types.String)))
+				}),
+			),
+			cel.Overload("oc_sha256_bytes", []*cel.Type{cel.BytesType}, cel.StringType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					return sha256Hex(arg.(types.Bytes))
+				}),
+			),
+		),
+		cel.Function("oc_semver_compare",
+			cel.Overload("oc_semver_compare_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+				cel.BinaryBinding(semverCompare),
+			),
+		),
+		cel.Function("oc_semver_matches",
+			cel.Overload("oc_semver_matches_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(semverMatches),
+			),
+		),
+		cel.Function("oc_quantity_add",
+			cel.Overload("oc_quantity_add_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+					return quantityArithmetic("oc_quantity_add", lhs, rhs, false)
+				}),
+			),
+		),
+		cel.Function("oc_quantity_sub",
+			cel.Overload("oc_quantity_sub_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+					return quantityArithmetic("oc_quantity_sub", lhs, rhs, true)
+				}),
+			),
+		),
+		cel.Function("oc_quantity_mul",
+			cel.Overload("oc_quantity_mul_string_double", []*cel.Type{cel.StringType, cel.DoubleType}, cel.StringType,
+				cel.BinaryBinding(quantityMultiply),
+			),
+			cel.Overload("oc_quantity_mul_string_int", []*cel.Type{cel.StringType, cel.IntType}, cel.StringType,
+				cel.BinaryBinding(quantityMultiply),
+			),
+		),
+		cel.Function("oc_quantity_compare",
+			cel.Overload("oc_quantity_compare_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+				cel.BinaryBinding(quantityCompare),
+			),
+		),
+		cel.Function("oc_url_parse",
+			cel.Overload("oc_url_parse_string", []*cel.Type{cel.StringType}, cel.MapType(cel.StringType, cel.DynType),
+				cel.UnaryBinding(urlParse),
+			),
+		),
+		cel.Function("oc_to_yaml",
+			cel.Overload("oc_to_yaml_dyn", []*cel.Type{cel.DynType}, cel.StringType,
+				cel.UnaryBinding(toYAML),
+			),
+		),
+		cel.Function("oc_from_yaml",
+			cel.Overload("oc_from_yaml_string", []*cel.Type{cel.StringType}, cel.DynType,
+				cel.UnaryBinding(fromYAML),
+			),
+		),
+		cel.Function("oc_uuid5",
+			cel.Overload("oc_uuid5_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(uuid5),
+			),
+		),
+	}
+}
+
+func base64Decode(arg ref.Val) ref.Val {
+	input := string(arg.(types.String))
+	if len(input) > maxLibraryInputSize {
+		return types.NewErr("oc_base64_decode: input exceeds %d bytes", maxLibraryInputSize)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		// Accept unpadded input as produced by some tools
+		var rawErr error
+		if decoded, rawErr = base64.RawStdEncoding.DecodeString(input); rawErr != nil {
+			return types.NewErr("oc_base64_decode: %v", err)
+		}
+	}
+	if !utf8.Valid(decoded) {
+		return types.NewErr("oc_base64_decode: decoded value is not valid UTF-8; use base64.decode() for binary data")
+	}
+	return types.String(decoded)
+}
+
+func sha256Hex(data []byte) ref.Val {
+	sum := sha256.Sum256(data)
+	return types.String(hex.EncodeToString(sum[:]))
+}
+
+// parseSemver parses a semantic version, tolerating a "v" prefix and missing minor or patch versions.
+func parseSemver(function string, arg ref.Val) (semver.Version, ref.Val) {
+	version, err := semver.ParseTolerant(string(arg.(types.String)))
+	if err != nil {
+		return semver.Version{}, types.NewErr("%s: invalid version %q: %v", function, arg.Value(), err)
+	}
+	return version, nil
+}
+
+func semverCompare(lhs, rhs ref.Val) ref.Val {
+	a, errVal := parseSemver("oc_semver_compare", lhs)
+	if errVal != nil {
+		return errVal
+	}
+	b, errVal := parseSemver("oc_semver_compare", rhs)
+	if errVal != nil {
+		return errVal
+	}
+	return types.Int(a.Compare(b))
+}
+
+func semverMatches(version, constraint ref.Val) ref.Val {
+	v, errVal := parseSemver("oc_semver_matches", version)
+	if errVal != nil {
+		return errVal
+	}
+	matches, err := semver.ParseRange(string(constraint.(types.String)))
+	if err != nil {
+		return types.NewErr("oc_semver_matches: invalid range %q: %v", constraint.Value(), err)
+	}
+	return types.Bool(matches(v))
+}
+
+func parseQuantity(function string, arg ref.Val) (resource.Quantity, ref.Val) {
+	q, err := resource.ParseQuantity(string(arg.(types.String)))
+	if err != nil {
+		return resource.Quantity{}, types.NewErr("%s: invalid quantity %q: %v", function, arg.Value(), err)
+	}
+	return q, nil
+}
+
+// quantityArithmetic adds or subtracts quantities. The result keeps the format of the first quantity.
+func quantityArithmetic(function string, lhs, rhs ref.Val, subtract bool) ref.Val {
+	a, errVal := parseQuantity(function, lhs)
+	if errVal != nil {
+		return errVal
+	}
+	b, errVal := parseQuantity(function, rhs)
+	if errVal != nil {
+		return errVal
+	}
+	if subtract {
+		a.Sub(b)
+	} else {
+		a.Add(b)
+	}
+	return types.String(a.String())
+}
+
+// quantityMultiply scales a quantity by an int or double factor, e.g. to derive a limit from a request.
+// The product is exact for decimal factors and keeps the format of the quantity.
+func quantityMultiply(lhs, rhs ref.Val) ref.Val {
+	q, errVal := parseQuantity("oc_quantity_mul", lhs)
+	if errVal != nil {
+		return errVal
+	}
+
+	var factor *inf.Dec
+	switch f := rhs.(type) {
+	case types.Int:
+		factor = inf.NewDec(int64(f), 0)
+	case types.Double:
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return types.NewErr("oc_quantity_mul: invalid factor %v", float64(f))
+		}
+		var ok bool
+		if factor, ok = new(inf.Dec).SetString(strconv.FormatFloat(float64(f), 'f', -1, 64)); !ok {
+			return types.NewErr("oc_quantity_mul: invalid factor %v", float64(f))
+		}
+	default:
+		return types.MaybeNoSuchOverloadErr(rhs)
+	}
+
+	product := new(inf.Dec).Mul(q.AsDec(), factor)
+	return types.String(resource.NewDecimalQuantity(*product, q.Format).String())
+}
+
+func quantityCompare(lhs, rhs ref.Val) ref.Val {
+	a, errVal := parseQuantity("oc_quantity_compare", lhs)
+	if errVal != nil {
+		return errVal
+	}
+	b, errVal := parseQuantity("oc_quantity_compare", rhs)
+	if errVal != nil {
+		return errVal
+	}
+	return types.Int(a.Cmp(b))
+}
+
+// urlParse splits a URL into its components. Query parameters map to the list of their values.
+func urlParse(arg ref.Val) ref.Val {
+	u, err := url.Parse(string(arg.(types.String)))
+	if err != nil {
+		return types.NewErr("oc_url_parse: %v", err)
+	}
+
+	query := make(map[string]any, len(u.Query()))
+	for key, values := range u.Query() {
+		items := make([]any, 0, len(values))
+		for _, value := range values {
+			items = append(items, value)
+		}
+		query[key] = items
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(map[string]any{
+		"scheme":   u.Scheme,
+		"host":     u.Host,
+		"hostname": u.Hostname(),
+		"port":     u.Port(),
+		"path":     u.Path,
+		"rawQuery": u.RawQuery,
+		"query":    query,
+		"fragment": u.Fragment,
+		"username": u.User.Username(),
+	})
+}
+
+func toYAML(arg ref.Val) ref.Val {
+	native := RemoveOmittedFields(convertCELValue(arg))
+	out, err := yaml.Marshal(native)
+	if err != nil {
+		return types.NewErr("oc_to_yaml: %v", err)
+	}
+	return types.String(out)
+}
+
+// fromYAML parses a YAML document. Whole numbers are decoded as ints so that they compare and
+// render like the numbers of template inputs.
+func fromYAML(arg ref.Val) ref.Val {
+	input := string(arg.(types.String))
+	if len(input) > maxLibraryInputSize {
+		return types.NewErr("oc_from_yaml: input exceeds %d bytes", maxLibraryInputSize)
+	}
+
+	data, err := yaml.YAMLToJSON([]byte(input))
+	if err != nil {
+		return types.NewErr("oc_from_yaml: %v", err)
+	}
+	var parsed any
+	if err := utiljson.Unmarshal(data, &parsed); err != nil {
+		return types.NewErr("oc_from_yaml: %v", err)
+	}
+	return types.DefaultTypeAdapter.NativeToValue(parsed)
+}
+
+// uuid5Namespaces are the well-known namespaces of RFC 4122 that can be referred to by name.
+var uuid5Namespaces = map[string]uuid.UUID{
+	"dns":  uuid.NameSpaceDNS,
+	"url":  uuid.NameSpaceURL,
+	"oid":  uuid.NameSpaceOID,
+	"x500": uuid.NameSpaceX500,
+}
+
+// uuid5 generates a name-based (SHA-1) UUID. The namespace is a UUID or one of dns, url, oid and x500.
+func uuid5(namespace, name ref.Val) ref.Val {
+	ns, ok := uuid5Namespaces[strings.ToLower(string(namespace.(types.String)))]
+	if !ok {
+		parsed, err := uuid.Parse(string(namespace.(types.String)))
+		if err != nil {
+			return types.NewErr("oc_uuid5: invalid namespace %q: must be a UUID or one of dns, url, oid, x500", namespace.Value())
+		}
+		ns = parsed
+	}
+	return types.String(uuid.NewSHA1(ns, []byte(name.(types.String))).String())
+}
+
+// libraryCosts estimates the cost of the library functions for CEL cost tracking.
+// The functions that process their input, like hashing, encoding and YAML conversion, cost
+// in proportion to the size of the input, like the string functions of the standard library.
+// Functions without an estimate have the default cost of a function call.
+type libraryCosts struct{}
+
+var (
+	_ checker.CostEstimator           = libraryCosts{}
+	_ interpreter.ActualCostEstimator = libraryCosts{}
+)
+
+// traversalCostFactor is the cost per byte of traversing a string, as used by CEL for strings.
+const traversalCostFactor = 0.1
+
+// sizeDependentFunctions are the functions whose cost depends on the size of their first argument.
+var sizeDependentFunctions = map[string]bool{
+	"oc_base64_encode": true,
+	"oc_base64_decode": true,
+	"oc_sha256":        true,
+	"oc_url_parse":     true,
+	"oc_from_yaml":     true,
+	"oc_to_yaml":       true,
+	"oc_uuid5":         true,
+}
+
+// CallCost returns the runtime cost of a call from the size of its input, or the size of the
+// rendered YAML for oc_to_yaml, whose input size is not known up front.
+func (libraryCosts) CallCost(function, _ string, args []ref.Val, result ref.Val) *uint64 {
+	if !sizeDependentFunctions[function] || len(args) == 0 {
+		return nil
+	}
+
+	var size int
+	switch function {
+	case "oc_to_yaml":
+		if s, ok := result.(types.String); ok {
+			size = len(s)
+		}
+	case "oc_uuid5":
+		size = valueSize(args[len(args)-1])
+	default:
+		size = valueSize(args[0])
+	}
+	cost := 1 + uint64(math.Ceil(float64(size)*traversalCostFactor))
+	return &cost
+}
+
+// EstimateCallCost returns the static cost estimate of a call from the size estimate of its input.
+func (libraryCosts) EstimateCallCost(function, _ string, _ *checker.AstNode, args []checker.AstNode) *checker.CallEstimate {
+	if !sizeDependentFunctions[function] || len(args) == 0 {
+		return nil
+	}
+
+	arg := args[0]
+	if function == "oc_uuid5" {
+		arg = args[len(args)-1]
+	}
+	size := checker.SizeEstimate{Min: 0, Max: math.MaxUint64}
+	if computed := arg.ComputedSize(); computed != nil {
+		size = *computed
+	}
+	cost := size.MultiplyByCostFactor(traversalCostFactor).Add(checker.CostEstimate{Min: 1, Max: 1})
+	return &checker.CallEstimate{CostEstimate: cost}
+}
+
+// EstimateSize has no size estimates beyond those computed by CEL.
+func (libraryCosts) EstimateSize(checker.AstNode) *checker.SizeEstimate {
+	return nil
+}
+
+func valueSize(val ref.Val) int {
+	switch v := val.(type) {
+	case types.String:
+		return len(v)
+	case types.Bytes:
+		return len(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"sigs.k8s.io/yaml"
+)
+
+func TestLibraryFunctions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		template string
+		inputs   string
+		want     string
+	}{
+		{
+			name: "base64 round trip",
+			template: `
+encoded: ${oc_base64_encode(spec.password)}
+encodedBytes: ${oc_base64_encode(b"hi")}
+decoded: ${oc_base64_decode("aGVsbG8=")}
+unpadded: ${oc_base64_decode("aGVsbG8")}
+`,
+			inputs: `{"spec": {"password": "s3cret"}}`,
+			want: `encoded: czNjcmV0
+encodedBytes: aGk=
+decoded: hello
+unpadded: hello
+`,
+		},
+		{
+			name: "sha256",
+			template: `
+digest: ${oc_sha256("hello")}
+bytesDigest: ${oc_sha256(b"hello")}
+`,
+			inputs: `{}`,
+			want: `digest: 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824
+bytesDigest: 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824
+`,
+		},
+		{
+			name: "semver comparison",
+			template: `
+older: ${oc_semver_compare("1.2.0", "v1.10")}
+equal: ${oc_semver_compare("v2", "2.0.0")}
+newer: ${oc_semver_compare(spec.version, "1.0.0-rc.1")}
+inRange: ${oc_semver_matches(spec.version, ">=1.0.0 <2.0.0")}
+outOfRange: ${oc_semver_matches("2.1.0", ">=1.0.0 <2.0.0")}
+`,
+			inputs: `{"spec": {"version": "1.4.2"}}`,
+			want: `older: -1
+equal: 0
+newer: 1
+inRange: true
+outOfRange: false
+`,
+		},
+		{
+			name: "quantity arithmetic",
+			template: `
+sum: ${oc_quantity_add(spec.memory, "1Gi")}
+difference: ${oc_quantity_sub("1Gi", spec.memory)}
+limit: ${oc_quantity_mul(spec.memory, 1.5)}
+doubled: ${oc_quantity_mul("250m", 2)}
+smaller: ${oc_quantity_compare("500m", "1")}
+larger: ${oc_quantity_compare("2Gi", "2G")}
+`,
+			inputs: `{"spec": {"memory": "512Mi"}}`,
+			want: `sum: 1536Mi
+difference: 512Mi
+limit: 768Mi
+doubled: 500m
+smaller: -1
+larger: 1
+`,
+		},
+		{
+			name: "url parsing",
+			template: `
+url: ${oc_url_parse(spec.endpoint)}
+`,
+			inputs: `{"spec": {"endpoint": "postgres://admin@db.internal:5432/orders?sslmode=require&opt=a&opt=b#primary"}}`,
+			want: `url:
+  scheme: postgres
+  host: db.internal:5432
+  hostname: db.internal
+  port: "5432"
+  path: /orders
+  rawQuery: sslmode=require&opt=a&opt=b
+  query:
+    sslmode: [require]
+    opt: [a, b]
+  fragment: primary
+  username: admin
+`,
+		},
+		{
+			name: "yaml round trip",
+			template: `
+config: '${oc_to_yaml({"replicas": spec.replicas, "name": metadata.name, "skipped": oc_omit()})}'
+parsed: '${oc_from_yaml("replicas: 3\nports: [80, 443]\nname: web")}'
+port: '${oc_from_yaml("port: 8080").port + 1}'
+`,
+			inputs: `{"metadata": {"name": "web"}, "spec": {"replicas": 2}}`,
+			want: `config: |
+  name: web
+  replicas: 2
+parsed:
+  replicas: 3
+  ports: [80, 443]
+  name: web
+port: 8081
+`,
+		},
+		{
+			name: "uuid5",
+			template: `
+dns: ${oc_uuid5("dns", "example.com")}
+custom: ${oc_uuid5("6ba7b810-9dad-11d1-80b4-00c04fd430c8", "example.com")}
+`,
+			inputs: `{}`,
+			want: `dns: cfbff0d1-9375-5685-968c-48ce8b15ae17
+custom: cfbff0d1-9375-5685-968c-48ce8b15ae17
+`,
+		},
+	}
+
+	engine := NewEngine()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tpl any
+			if err := yaml.Unmarshal([]byte(tt.template), &tpl); err != nil {
+				t.Fatalf("failed to unmarshal template: %v", err)
+			}
+
+			var input map[string]any
+			if err := json.Unmarshal([]byte(tt.inputs), &input); err != nil {
+				t.Fatalf("failed to unmarshal inputs: %v", err)
+			}
+
+			rendered, err := engine.Render(tpl, input)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			got, err := yaml.Marshal(RemoveOmittedFields(rendered))
+			if err != nil {
+				t.Fatalf("failed to marshal result: %v", err)
+			}
+
+			if err := compareYAML(tt.want, string(got)); err != nil {
+				t.Fatalf("rendered output mismatch: %v", err)
+			}
+		})
+	}
+}
+
+func TestLibraryFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		template    string
+		errContains string
+	}{
+		{
+			name:        "invalid base64",
+			template:    `value: ${oc_base64_decode("not base64!")}`,
+			errContains: "oc_base64_decode: illegal base64 data",
+		},
+		{
+			name:        "binary base64 content",
+			template:    `value: ${oc_base64_decode("/w==")}`,
+			errContains: "use base64.decode() for binary data",
+		},
+		{
+			name:        "invalid version",
+			template:    `value: ${oc_semver_compare("latest", "1.0.0")}`,
+			errContains: `oc_semver_compare: invalid version "latest"`,
+		},
+		{
+			name:        "invalid range",
+			template:    `value: ${oc_semver_matches("1.0.0", "~>1")}`,
+			errContains: `oc_semver_matches: invalid range "~>1"`,
+		},
+		{
+			name:        "invalid quantity",
+			template:    `value: ${oc_quantity_add("1Gi", "lots")}`,
+			errContains: `oc_quantity_add: invalid quantity "lots"`,
+		},
+		{
+			name:        "invalid multiplication factor",
+			template:    `value: ${oc_quantity_mul("1Gi", double("NaN"))}`,
+			errContains: "oc_quantity_mul: invalid factor",
+		},
+		{
+			name:        "invalid url",
+			template:    `value: ${oc_url_parse("http://[::1")}`,
+			errContains: "oc_url_parse:",
+		},
+		{
+			name:        "invalid yaml",
+			template:    `value: '${oc_from_yaml("a: [1")}'`,
+			errContains: "oc_from_yaml:",
+		},
+		{
+			name:        "invalid uuid namespace",
+			template:    `value: ${oc_uuid5("example", "name")}`,
+			errContains: "must be a UUID or one of dns, url, oid, x500",
+		},
+	}
+
+	engine := NewEngine()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tpl any
+			if err := yaml.Unmarshal([]byte(tt.template), &tpl); err != nil {
+				t.Fatalf("failed to unmarshal template: %v", err)
+			}
+
+			_, err := engine.Render(tpl, map[string]any{})
+			if err == nil {
+				t.Fatalf("expected error containing %q but got none", tt.errContains)
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
+
+func TestLibraryInputSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	tpl := map[string]any{
+		"decoded": "${oc_base64_decode(spec.data)}",
+	}
+	input := map[string]any{
+		"spec": map[string]any{"data": strings.Repeat("A", maxLibraryInputSize+4)},
+	}
+
+	_, err := NewEngine().Render(tpl, input)
+	if err == nil || !strings.Contains(err.Error(), "input exceeds") {
+		t.Fatalf("Render() error = %v, want input size error", err)
+	}
+}
+
+func TestLibraryCosts(t *testing.T) {
+	t.Parallel()
+
+	env, err := cel.NewEnv(append(libraryOptions(), cel.Variable("data", cel.StringType))...)
+	if err != nil {
+		t.Fatalf("failed to create environment: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		data     string
+		limit    uint64
+		wantErr  bool
+		wantCost uint64
+	}{
+		{
+			name:     "small input",
+			expr:     `oc_sha256(data)`,
+			data:     strings.Repeat("a", 100),
+			limit:    1000,
+			wantCost: 12,
+		},
+		{
+			name:    "large input exceeds the limit",
+			expr:    `oc_sha256(data)`,
+			data:    strings.Repeat("a", 100000),
+			limit:   1000,
+			wantErr: true,
+		},
+		{
+			name:     "cost of yaml output",
+			expr:     `oc_to_yaml({"key": data})`,
+			data:     strings.Repeat("a", 200),
+			limit:    1000,
+			wantCost: 53,
+		},
+		{
+			name:     "functions without size dependent cost",
+			expr:     `oc_semver_compare(data, "1.0.0")`,
+			data:     "1.2.3",
+			limit:    1000,
+			wantCost: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ast, iss := env.Compile(tt.expr)
+			if iss.Err() != nil {
+				t.Fatalf("failed to compile %q: %v", tt.expr, iss.Err())
+			}
+			if _, err := env.EstimateCost(ast, libraryCosts{}); err != nil {
+				t.Fatalf("EstimateCost() error = %v", err)
+			}
+
+			program, err := env.Program(ast,
+				cel.CostTracking(libraryCosts{}),
+				cel.CostLimit(tt.limit),
+			)
+			if err != nil {
+				t.Fatalf("failed to create program: %v", err)
+			}
+
+			_, details, err := program.Eval(map[string]any{"data": tt.data})
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "actual cost limit exceeded") {
+					t.Fatalf("Eval() error = %v, want cost limit error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Eval() error = %v", err)
+			}
+			if got := *details.ActualCost(); got != tt.wantCost {
+				t.Errorf("ActualCost() = %d, want %d", got, tt.wantCost)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/common/ast"
+)
+
+// Trace records the evaluation of template expressions during rendering.
+// It is used to debug rendered resources by showing what each expression evaluated to.
+type Trace struct {
+	Entries []TraceEntry
+}
+
+// TraceEntry records the evaluation of a single expression.
+type TraceEntry struct {
+	// Path is the JSON path of the node containing the expression.
+	// Example: "resources[deployment].template.spec.replicas"
+	Path string `json:"path"`
+
+	// Expression is the CEL expression without the ${...} delimiters.
+	Expression string `json:"expression"`
+
+	// Inputs are the paths of the input variables the expression refers to.
+	// Example: ["parameters.replicas"]
+	Inputs []string `json:"inputs,omitempty"`
+
+	// Result is the value the expression evaluated to.
+	Result any `json:"result,omitempty"`
+
+	// Omitted indicates that the expression evaluated to omit() and the node was dropped.
+	Omitted bool `json:"omitted,omitempty"`
+
+	// Excluded indicates that the expression is an includeWhen condition that excluded the resource.
+	Excluded bool `json:"excluded,omitempty"`
+
+	// Error is the evaluation error, if the expression failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Len returns the number of recorded entries. A nil trace has no entries.
+func (t *Trace) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.Entries)
+}
+
+// MarkExcluded marks the entries recorded since the given length as excluding a resource.
+// Callers evaluating includeWhen conditions use it when the condition drops the resource.
+func (t *Trace) MarkExcluded(since int) {
+	if t == nil {
+		return
+	}
+	for i := since; i < len(t.Entries); i++ {
+		t.Entries[i].Excluded = true
+	}
+}
+
+// RenderOption configures a single Render call.
+type RenderOption func(*renderConfig)
+
+// WithTrace records every evaluated expression into the trace.
+// The path is used as the prefix of the JSON paths of the recorded entries.
+func WithTrace(trace *Trace, path string) RenderOption {
+	return func(c *renderConfig) {
+		c.trace = trace
+		c.path = path
+	}
+}
+
+type renderConfig struct {
+	trace  *Trace
+	path   string
+	budget *Budget
+}
+
+// record adds the evaluation of an expression to the trace, if tracing is enabled.
+func (e *Engine) record(config *renderConfig, path, expression string, inputs map[string]any, result any, err error) {
+	if config.trace == nil {
+		return
+	}
+
+	entry := TraceEntry{
+		Path:       path,
+		Expression: expression,
+		Inputs:     e.inputPaths(expression, inputs),
+	}
+	switch {
+	case err != nil:
+		entry.Error = err.Error()
+	case result == omitSentinel:
+		entry.Omitted = true
+	default:
+		entry.Result = result
+	}
+	config.trace.Entries = append(config.trace.Entries, entry)
+}
+
+// inputPaths returns the paths of the input variables an expression refers to.
+// Expressions that fail to parse have no inputs; the evaluation error is recorded instead.
+func (e *Engine) inputPaths(expression string, inputs map[string]any) []string {
+	env, err := e.getOrCreateEnv(inputs)
+	if err != nil {
+		return nil
+	}
+	parsed, issues := env.Parse(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil
+	}
+
+	paths := make(map[string]struct{})
+	collectInputPaths(parsed.NativeRep().Expr(), inputs, paths)
+
+	result := make([]string, 0, len(paths))
+	for path := range paths {
+		result = append(result, path)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// collectInputPaths walks an expression and collects the field selections rooted at input variables.
+func collectInputPaths(expr ast.Expr, inputs map[string]any, paths map[string]struct{}) {
+	switch expr.Kind() {
+	case ast.IdentKind:
+		if _, ok := inputs[expr.AsIdent()]; ok {
+			paths[expr.AsIdent()] = struct{}{}
+		}
+	case ast.SelectKind:
+		if path, root, ok := selectPath(expr); ok {
+			if _, isInput := inputs[root]; isInput {
+				paths[path] = struct{}{}
+			}
+			return
+		}
+		collectInputPaths(expr.AsSelect().Operand(), inputs, paths)
+	case ast.CallKind:
+		call := expr.AsCall()
+		if call.IsMemberFunction() {
+			collectInputPaths(call.Target(), inputs, paths)
+		}
+		for _, arg := range call.Args() {
+			collectInputPaths(arg, inputs, paths)
+		}
+	case ast.ListKind:
+		for _, element := range expr.AsList().Elements() {
+			collectInputPaths(element, inputs, paths)
+		}
+	case ast.MapKind:
+		for _, entry := range expr.AsMap().Entries() {
+			collectInputPaths(entry.AsMapEntry().Key(), inputs, paths)
+			collectInputPaths(entry.AsMapEntry().Value(), inputs, paths)
+		}
+	case ast.StructKind:
+		for _, field := range expr.AsStruct().Fields() {
+			collectInputPaths(field.AsStructField().Value(), inputs, paths)
+		}
+	case ast.ComprehensionKind:
+		comprehension := expr.AsComprehension()
+		collectInputPaths(comprehension.IterRange(), inputs, paths)
+		collectInputPaths(comprehension.AccuInit(), inputs, paths)
+		collectInputPaths(comprehension.LoopCondition(), inputs, paths)
+		collectInputPaths(comprehension.LoopStep(), inputs, paths)
+		collectInputPaths(comprehension.Result(), inputs, paths)
+	}
+}
+
+// selectPath returns the dotted path of a chain of field selections ending in an identifier,
+// e.g. "parameters.resources.limits" and its root identifier "parameters".
+func selectPath(expr ast.Expr) (path, root string, ok bool) {
+	var fields []string
+	for expr.Kind() == ast.SelectKind {
+		fields = append(fields, expr.AsSelect().FieldName())
+		expr = expr.AsSelect().Operand()
+	}
+	if expr.Kind() != ast.IdentKind {
+		return "", "", false
+	}
+
+	root = expr.AsIdent()
+	var b strings.Builder
+	b.WriteString(root)
+	for i := len(fields) - 1; i >= 0; i-- {
+		fmt.Fprintf(&b, ".%s", fields[i])
+	}
+	return b.String(), root, true
+}
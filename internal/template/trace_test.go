@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRenderWithTrace(t *testing.T) {
+	engine := NewEngine()
+	inputs := map[string]any{
+		"metadata":   map[string]any{"name": "app"},
+		"parameters": map[string]any{"replicas": int64(2), "debug": false},
+	}
+	data := map[string]any{
+		"name":     "${metadata.name}-svc",
+		"replicas": "${parameters.replicas * 2}",
+		"args": []any{
+			"${parameters.debug ? '--debug' : oc_omit()}",
+		},
+	}
+
+	trace := &Trace{}
+	if _, err := engine.Render(data, inputs, WithTrace(trace, "template")); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := []TraceEntry{
+		{Path: "template.args[0]", Expression: "parameters.debug ? '--debug' : oc_omit()", Inputs: []string{"parameters.debug"}, Omitted: true},
+		{Path: "template.name", Expression: "metadata.name", Inputs: []string{"metadata.name"}, Result: "app"},
+		{Path: "template.replicas", Expression: "parameters.replicas * 2", Inputs: []string{"parameters.replicas"}, Result: int64(4)},
+	}
+	if len(trace.Entries) != len(want) {
+		t.Fatalf("expected %d trace entries, got %+v", len(want), trace.Entries)
+	}
+	for i, entry := range trace.Entries {
+		w := want[i]
+		if entry.Path != w.Path || entry.Expression != w.Expression || entry.Result != w.Result ||
+			entry.Omitted != w.Omitted || !slices.Equal(entry.Inputs, w.Inputs) {
+			t.Errorf("entry %d = %+v, want %+v", i, entry, w)
+		}
+	}
+}
+
+func TestRenderWithTrace_Error(t *testing.T) {
+	engine := NewEngine()
+	trace := &Trace{}
+
+	_, err := engine.Render("${parameters.missing}", map[string]any{"parameters": map[string]any{}}, WithTrace(trace, "value"))
+	if err == nil {
+		t.Fatal("expected an evaluation error")
+	}
+	if len(trace.Entries) != 1 || trace.Entries[0].Error == "" {
+		t.Fatalf("expected the failed evaluation to be traced, got %+v", trace.Entries)
+	}
+}
+
+func TestInputPaths(t *testing.T) {
+	engine := NewEngine()
+	inputs := map[string]any{"parameters": map[string]any{}, "workload": map[string]any{}}
+
+	got := engine.inputPaths(`has(parameters.ports) ? parameters.ports.map(p, p.port) : workload.containers["main"].image`, inputs)
+	want := []string{"parameters.ports", "workload.containers"}
+	if !slices.Equal(got, want) {
+		t.Errorf("inputPaths() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+)
+
+// componentlog is for logging in this package.
+var componentlog = logf.Log.WithName("component-resource")
+
+// SetupComponentWebhookWithManager registers the webhook for Component in the manager.
+func SetupComponentWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreov1alpha1.Component{}).
+		WithValidator(&ComponentCustomValidator{client: mgr.GetClient()}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-component,mutating=false,failurePolicy=ignore,sideEffects=None,groups=openchoreo.dev,resources=components,verbs=delete,versions=v1alpha1,name=vcomponent-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ComponentCustomValidator struct is responsible for validating the Component resource
+// when it is deleted.
+//
+// Deleting a component that other components connect to is allowed, but the dependents are
+// reported as warnings since their connections can no longer be resolved.
+type ComponentCustomValidator struct {
+	client client.Reader
+}
+
+var _ webhook.CustomValidator = &ComponentCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Component.
+func (v *ComponentCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Component.
+func (v *ComponentCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Component.
+func (v *ComponentCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	component, ok := obj.(*openchoreov1alpha1.Component)
+	if !ok {
+		return nil, fmt.Errorf("expected a Component object but got %T", obj)
+	}
+
+	dependents, err := releasebinding.FindConnectionDependents(ctx, v.client, component.Namespace,
+		component.Spec.Owner.ProjectName, component.Name, "")
+	if err != nil {
+		componentlog.Error(err, "Failed to find connection dependents", "name", component.Name)
+		return nil, nil
+	}
+	return dependentWarnings("component", component.Name, dependents), nil
+}
+
+// dependentWarnings reports the ReleaseBindings that connect to a deleted resource
+func dependentWarnings(kind, name string, dependents []openchoreov1alpha1.ReleaseBinding) admission.Warnings {
+	warnings := make(admission.Warnings, 0, len(dependents))
+	for _, dependent := range dependents {
+		warnings = append(warnings, fmt.Sprintf(
+			"%s %q is used by the connections of component %q of project %q in environment %q",
+			kind, name, dependent.Spec.Owner.ComponentName, dependent.Spec.Owner.ProjectName, dependent.Spec.Environment))
+	}
+	if len(warnings) == 0 {
+		return nil
+	}
+	return warnings
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// componentreleaselog is for logging in this package.
+var componentreleaselog = logf.Log.WithName("componentrelease-resource")
+
+// SetupComponentReleaseWebhookWithManager registers the webhook for ComponentRelease in the manager.
+func SetupComponentReleaseWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreov1alpha1.ComponentRelease{}).
+		WithValidator(&ComponentReleaseCustomValidator{}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-componentrelease,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=componentreleases,verbs=update,versions=v1alpha1,name=vcomponentrelease-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ComponentReleaseCustomValidator struct is responsible for validating the ComponentRelease resource
+// when it is updated.
+//
+// A ComponentRelease is an immutable snapshot of a component, so any change to its spec is rejected.
+// Metadata and status changes are still allowed so that labels, finalizers and conditions can be managed.
+type ComponentReleaseCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ComponentReleaseCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ComponentRelease.
+func (v *ComponentReleaseCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ComponentRelease.
+func (v *ComponentReleaseCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRelease, ok := oldObj.(*openchoreov1alpha1.ComponentRelease)
+	if !ok {
+		return nil, fmt.Errorf("expected a ComponentRelease object for the oldObj but got %T", oldObj)
+	}
+	newRelease, ok := newObj.(*openchoreov1alpha1.ComponentRelease)
+	if !ok {
+		return nil, fmt.Errorf("expected a ComponentRelease object for the newObj but got %T", newObj)
+	}
+
+	if !equality.Semantic.DeepEqual(oldRelease.Spec, newRelease.Spec) {
+		componentreleaselog.Info("Rejected spec mutation of ComponentRelease", "name", newRelease.GetName())
+		return nil, fmt.Errorf("component release '%s' is immutable: spec cannot be changed after creation", newRelease.Name)
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ComponentRelease.
+func (v *ComponentReleaseCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("ComponentRelease Webhook", func() {
+	var (
+		oldObj    *openchoreov1alpha1.ComponentRelease
+		validator ComponentReleaseCustomValidator
+	)
+
+	BeforeEach(func() {
+		oldObj = &openchoreov1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-component-abc123",
+				Namespace: testNamespace,
+			},
+			Spec: openchoreov1alpha1.ComponentReleaseSpec{
+				Owner: openchoreov1alpha1.ComponentReleaseOwner{
+					ProjectName:   "my-project",
+					ComponentName: "my-component",
+				},
+				ComponentType: openchoreov1alpha1.ComponentTypeSpec{
+					WorkloadType: "deployment",
+				},
+				ComponentProfile: openchoreov1alpha1.ComponentProfile{
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"replicas":1}`)},
+				},
+				Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+					Containers: map[string]openchoreov1alpha1.Container{
+						"app": {Image: "nginx:1.25"},
+					},
+				},
+			},
+		}
+		validator = ComponentReleaseCustomValidator{}
+	})
+
+	Context("When validating ComponentRelease updates", func() {
+		It("Should allow metadata and status changes", func() {
+			obj := oldObj.DeepCopy()
+			obj.Labels = map[string]string{"example.com/team": "payments"}
+			obj.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Valid"}}
+
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny changes to the workload snapshot", func() {
+			obj := oldObj.DeepCopy()
+			obj.Spec.Workload.Containers["app"] = openchoreov1alpha1.Container{Image: "nginx:1.26"}
+
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("immutable"))
+		})
+
+		It("Should deny changes to the owner", func() {
+			obj := oldObj.DeepCopy()
+			obj.Spec.Owner.ComponentName = "other-component"
+
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,70 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+)
+
+// releasebindinglog is for logging in this package.
+var releasebindinglog = logf.Log.WithName("releasebinding-resource")
+
+// SetupReleaseBindingWebhookWithManager registers the webhook for ReleaseBinding in the manager.
+func SetupReleaseBindingWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreov1alpha1.ReleaseBinding{}).
+		WithValidator(&ReleaseBindingCustomValidator{client: mgr.GetClient()}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-releasebinding,mutating=false,failurePolicy=ignore,sideEffects=None,groups=openchoreo.dev,resources=releasebindings,verbs=delete,versions=v1alpha1,name=vreleasebinding-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ReleaseBindingCustomValidator struct is responsible for validating the ReleaseBinding resource
+// when it is deleted.
+//
+// Removing a component from an environment in which other components connect to it is allowed,
+// but the dependents in the environment are reported as warnings.
+type ReleaseBindingCustomValidator struct {
+	client client.Reader
+}
+
+var _ webhook.CustomValidator = &ReleaseBindingCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ReleaseBinding.
+func (v *ReleaseBindingCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ReleaseBinding.
+func (v *ReleaseBindingCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ReleaseBinding.
+func (v *ReleaseBindingCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	binding, ok := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ReleaseBinding object but got %T", obj)
+	}
+
+	dependents, err := releasebinding.FindConnectionDependents(ctx, v.client, binding.Namespace,
+		binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName, binding.Spec.Environment)
+	if err != nil {
+		releasebindinglog.Error(err, "Failed to find connection dependents", "name", binding.Name)
+		return nil, nil
+	}
+	return dependentWarnings("release binding", binding.Name, dependents), nil
+}
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -106,6 +107,19 @@ var _ = BeforeSuite(func() {
 	err = SetupProjectWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = SetupComponentReleaseWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupComponentWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupReleaseBindingWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	// The Component and ReleaseBinding webhooks find connection dependents through the indexes of the controller
+	err = releasebinding.SetupConnectionIndexes(ctx, mgr.GetFieldIndexer())
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:webhook
 
 	go func() {
@@ -5,6 +5,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +37,21 @@ type Context struct {
 	Component    string `yaml:"component,omitempty"`
 	Environment  string `yaml:"environment,omitempty"`
 	DataPlane    string `yaml:"dataPlane,omitempty"`
+	// Credentials holds the tokens obtained by "choreoctl login" for this context
+	Credentials *Credentials `yaml:"credentials,omitempty"`
+}
+
+// Credentials holds the OAuth2 tokens of a context and the authorization server endpoints
+// needed to refresh and revoke them without discovering the authorization server again.
+type Credentials struct {
+	ClientID           string    `yaml:"clientId"`
+	Issuer             string    `yaml:"issuer"`
+	TokenEndpoint      string    `yaml:"tokenEndpoint"`
+	RevocationEndpoint string    `yaml:"revocationEndpoint,omitempty"`
+	AccessToken        string    `yaml:"accessToken"`
+	RefreshToken       string    `yaml:"refreshToken,omitempty"`
+	TokenType          string    `yaml:"tokenType,omitempty"`
+	Expiry             time.Time `yaml:"expiry,omitempty"`
 }
 
 func NewConfigCmd(impl api.CommandImplementationInterface) *cobra.Command {
@@ -0,0 +1,39 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+func NewDiffCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Diff,
+		Flags: []flags.Flag{
+			flags.Organization,
+			flags.Project,
+			flags.Component,
+			flags.Environment,
+			flags.Release,
+			flags.AgainstRelease,
+			flags.DiffOutput,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.Diff(api.DiffParams{
+				Organization:   fg.GetString(flags.Organization),
+				Project:        fg.GetString(flags.Project),
+				Component:      fg.GetString(flags.Component),
+				Environment:    fg.GetString(flags.Environment),
+				Release:        fg.GetString(flags.Release),
+				AgainstRelease: fg.GetString(flags.AgainstRelease),
+				OutputFormat:   fg.GetString(flags.DiffOutput),
+			})
+		},
+	}).Build()
+}
@@ -17,13 +17,13 @@ func NewLoginCmd(impl api.CommandImplementationInterface) *cobra.Command {
 	return (&builder.CommandBuilder{
 		Command: constants.Login,
 		Flags: []flags.Flag{
-			flags.Kubeconfig,
-			flags.KubeContext,
+			flags.ClientID,
+			flags.DeviceCode,
 		},
 		RunE: func(fg *builder.FlagGetter) error {
 			return impl.Login(api.LoginParams{
-				KubeconfigPath: fg.GetString(flags.Kubeconfig),
-				Kubecontext:    fg.GetString(flags.KubeContext),
+				ClientID:   fg.GetString(flags.ClientID),
+				DeviceCode: fg.GetBool(flags.DeviceCode),
 			})
 		},
 	}).Build()
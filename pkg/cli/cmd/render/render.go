@@ -0,0 +1,35 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+func NewRenderCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Render,
+		Flags: []flags.Flag{
+			flags.RenderFileFlag,
+			flags.Component,
+			flags.Environment,
+			flags.RenderOutput,
+			flags.ShowSources,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.Render(api.RenderParams{
+				FilePath:     fg.GetString(flags.RenderFileFlag),
+				Component:    fg.GetString(flags.Component),
+				Environment:  fg.GetString(flags.Environment),
+				OutputFormat: fg.GetString(flags.RenderOutput),
+				ShowSources:  fg.GetBool(flags.ShowSources),
+			})
+		},
+	}).Build()
+}
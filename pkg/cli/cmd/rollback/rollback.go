@@ -0,0 +1,35 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+func NewRollbackCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Rollback,
+		Flags: []flags.Flag{
+			flags.Organization,
+			flags.Project,
+			flags.Component,
+			flags.Environment,
+			flags.RollbackRelease,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.Rollback(api.RollbackParams{
+				Organization: fg.GetString(flags.Organization),
+				Project:      fg.GetString(flags.Project),
+				Component:    fg.GetString(flags.Component),
+				Environment:  fg.GetString(flags.Environment),
+				Release:      fg.GetString(flags.RollbackRelease),
+			})
+		},
+	}).Build()
+}
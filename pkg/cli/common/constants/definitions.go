@@ -21,12 +21,15 @@ var (
 	Login = Command{
 		Use:   "login",
 		Short: "Login to Choreo",
-		Long:  "Login to Choreo using your kubeconfig file's context.",
+		Long: "Login to Choreo through the authorization server of the configured OpenChoreo API server. " +
+			"The tokens are stored in the current context and refreshed when they expire. " +
+			"When CHOREO_CLIENT_SECRET is set, the client ID logs in as itself with the client credentials flow.",
 	}
 
 	Logout = Command{
 		Use:   "logout",
 		Short: "Logout from Choreo",
+		Long:  "Logout from Choreo by revoking the tokens of the current context and removing them from the config.",
 	}
 
 	Version = Command{
@@ -84,6 +87,67 @@ Examples:
 			messages.DefaultCLIName),
 	}
 
+	Render = Command{
+		Use:   "render",
+		Short: "Render a component's resources from local files",
+		Long: fmt.Sprintf(`Render the Kubernetes resources of a component without a control plane.
+
+The given files are loaded and the ComponentType, Component, Traits, Workload,
+Environment, DataPlane and (optionally) ReleaseBinding they contain are run
+through the component rendering pipeline. If the files contain more than one
+Component or Environment, use --component or --environment to select one.
+
+Examples:
+  # Render all resources found in a directory
+  %[1]s render -f ./product-catalog
+
+  # Render for a specific environment in JSON format
+  %[1]s render -f ./product-catalog,./platform --environment production -o json
+
+  # Show which template or trait produced each resource
+  %[1]s render -f ./product-catalog --show-sources`,
+			messages.DefaultCLIName),
+	}
+
+	Diff = Command{
+		Use:   "diff",
+		Short: "Show what a component release changes in an environment",
+		Long: fmt.Sprintf(`Compare two component releases as they would be deployed to an environment.
+
+Both releases are rendered with the environment's release binding overrides and the
+resulting Kubernetes resources are compared, listing added, removed and changed
+resources together with their changed fields.
+
+Examples:
+  # Compare a new release against the one currently deployed to production
+  %[1]s diff --organization acme-corp --project online-store --component product-catalog \
+    --release product-catalog-20250102-1 --against product-catalog-20250101-1 --environment production
+
+  # Output the diff as JSON
+  %[1]s diff --component product-catalog --release product-catalog-20250102-1 \
+    --against product-catalog-20250101-1 --environment production -o json`,
+			messages.DefaultCLIName),
+	}
+
+	Rollback = Command{
+		Use:   "rollback",
+		Short: "Roll back a component to a previously deployed release",
+		Long: fmt.Sprintf(`Rebind an environment to a component release that was deployed before.
+
+Without --release the environment is rolled back to the release that was deployed
+before the current one, as recorded in the release binding's history.
+
+Examples:
+  # Roll production back to the previously deployed release
+  %[1]s rollback --organization acme-corp --project online-store --component product-catalog \
+    --environment production
+
+  # Roll production back to a specific release
+  %[1]s rollback --component product-catalog --environment production \
+    --release product-catalog-20250101-1`,
+			messages.DefaultCLIName),
+	}
+
 	CreateProject = Command{
 		Use:     "project",
 		Aliases: []string{"proj", "projects"},
@@ -246,6 +310,10 @@ This command allows you to:
   # Stream logs from a specific build
   choreoctl logs --type build --build product-catalog-build-01 --organization acme-corp --project online-store \
    --component product-catalog --follow
+
+  # Stream logs of a component in an environment as they are written
+  choreoctl logs --type deployment --organization acme-corp --project online-store --component product-catalog \
+  --environment development --follow
   `,
 	}
 
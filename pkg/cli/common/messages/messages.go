@@ -63,6 +63,13 @@ const (
 	FlagDeploymentDesc         = "Name of the deployment (e.g., product-catalog-dev-01)"
 	DeleteFileFlag             = "Path to the configuration file to delete (e.g., manifests/deployment.yaml)"
 	WorkloadDescriptorFlag     = "Path to the workload descriptor file (e.g., workload.yaml)"
+	RenderFileFlag             = "Comma-separated files or directories with the resources to render (e.g., ./component,traits.yaml)"
+	FlagRenderOutputDesc       = "Output format [yaml|json]"
+	FlagShowSourcesDesc        = "Show which template or trait produced each rendered resource"
+	FlagReleaseDesc            = "Name of the component release (e.g., product-catalog-20250101-1)"
+	FlagAgainstReleaseDesc     = "Name of the component release to compare against, usually the one currently deployed"
+	FlagDiffOutputDesc         = "Output format [text|yaml|json]"
+	FlagRollbackReleaseDesc    = "Name of the component release to roll back to (defaults to the previously deployed release)"
 	FlagWaitDesc               = "Wait for resources to be deleted before returning"
 	FlagEnvironmentOrderDesc   = "Comma-separated list of environment names in promotion order (e.g., dev,staging,prod)"
 	FlagDeploymentPipelineDesc = "Name of the deployment pipeline (e.g., dev-prod-pipeline)"
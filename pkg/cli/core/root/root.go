@@ -10,6 +10,12 @@ import (
 	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/create"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/delete"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/diff"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/login"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/logout"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/logs"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/render"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/rollback"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/version"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
@@ -27,10 +33,13 @@ func BuildRootCmd(config *config.CLIConfig, impl api.CommandImplementationInterf
 	rootCmd.AddCommand(
 		apply.NewApplyCmd(impl),
 		create.NewCreateCmd(impl),
+		render.NewRenderCmd(impl),
+		diff.NewDiffCmd(impl),
+		rollback.NewRollbackCmd(impl),
 		// get.NewListCmd(impl),
-		// login.NewLoginCmd(impl), // Removed login and logout until we finalize the user experience
-		// logout.NewLogoutCmd(impl),
-		// logs.NewLogsCmd(impl),
+		login.NewLoginCmd(impl),
+		logout.NewLogoutCmd(impl),
+		logs.NewLogsCmd(impl),
 		configContext.NewConfigCmd(impl),
 		delete.NewDeleteCmd(impl),
 		version.NewVersionCmd(),
@@ -295,6 +295,45 @@ var (
 		Usage: messages.WorkloadDescriptorFlag,
 	}
 
+	RenderFileFlag = Flag{
+		Name:      "file",
+		Shorthand: "f",
+		Usage:     messages.RenderFileFlag,
+	}
+
+	RenderOutput = Flag{
+		Name:      "output",
+		Shorthand: "o",
+		Usage:     messages.FlagRenderOutputDesc,
+	}
+
+	ShowSources = Flag{
+		Name:  "show-sources",
+		Usage: messages.FlagShowSourcesDesc,
+		Type:  "bool",
+	}
+
+	Release = Flag{
+		Name:  "release",
+		Usage: messages.FlagReleaseDesc,
+	}
+
+	AgainstRelease = Flag{
+		Name:  "against",
+		Usage: messages.FlagAgainstReleaseDesc,
+	}
+
+	RollbackRelease = Flag{
+		Name:  "release",
+		Usage: messages.FlagRollbackReleaseDesc,
+	}
+
+	DiffOutput = Flag{
+		Name:      "output",
+		Shorthand: "o",
+		Usage:     messages.FlagDiffOutputDesc,
+	}
+
 	EnvironmentOrder = Flag{
 		Name:  "environment-order",
 		Usage: messages.FlagEnvironmentOrderDesc,
@@ -316,6 +355,19 @@ var (
 		Name:  "token",
 		Usage: "Authentication token for remote OpenChoreo API server",
 	}
+
+	// Login flags
+
+	ClientID = Flag{
+		Name:  "client-id",
+		Usage: "OAuth2 client ID registered for choreoctl at the authorization server",
+	}
+
+	DeviceCode = Flag{
+		Name:  "device-code",
+		Usage: "Log in with a code entered on another device instead of a browser on this machine",
+		Type:  "bool",
+	}
 )
 
 // AddFlags adds the specified flags to the given command.
@@ -12,6 +12,9 @@ type CommandImplementationInterface interface {
 	DeployableArtifactAPI
 	DeploymentAPI
 	ApplyAPI
+	RenderAPI
+	DiffAPI
+	RollbackAPI
 	DeleteAPI
 	LoginAPI
 	LogoutAPI
@@ -65,6 +68,21 @@ type ApplyAPI interface {
 	Apply(params ApplyParams) error
 }
 
+// RenderAPI defines methods for rendering components offline from local files
+type RenderAPI interface {
+	Render(params RenderParams) error
+}
+
+// DiffAPI defines methods for comparing component releases
+type DiffAPI interface {
+	Diff(params DiffParams) error
+}
+
+// RollbackAPI defines methods for rolling back component releases
+type RollbackAPI interface {
+	Rollback(params RollbackParams) error
+}
+
 // DeleteAPI defines methods for deleting resources from configuration files
 type DeleteAPI interface {
 	Delete(params DeleteParams) error
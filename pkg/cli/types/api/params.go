@@ -76,6 +76,35 @@ type ApplyParams struct {
 	FilePath string
 }
 
+// RenderParams defines parameters for rendering a component from local resource files
+type RenderParams struct {
+	FilePath     string
+	Component    string
+	Environment  string
+	OutputFormat string
+	ShowSources  bool
+}
+
+// DiffParams defines parameters for diffing two component releases in an environment
+type DiffParams struct {
+	Organization   string
+	Project        string
+	Component      string
+	Environment    string
+	Release        string
+	AgainstRelease string
+	OutputFormat   string
+}
+
+// RollbackParams defines parameters for rolling back the release deployed to an environment
+type RollbackParams struct {
+	Organization string
+	Project      string
+	Component    string
+	Environment  string
+	Release      string
+}
+
 type DeleteParams struct {
 	FilePath string
 	Wait     bool
@@ -83,8 +112,8 @@ type DeleteParams struct {
 
 // LoginParams defines parameters for login
 type LoginParams struct {
-	KubeconfigPath string
-	Kubecontext    string
+	ClientID   string
+	DeviceCode bool
 }
 
 type LogParams struct {
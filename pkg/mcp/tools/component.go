@@ -198,6 +198,35 @@ func (t *Toolsets) RegisterGetComponentRelease(s *mcp.Server) {
 	})
 }
 
+func (t *Toolsets) RegisterDiffComponentReleases(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "diff_component_releases",
+		Description: "Show what deploying a component release would change in an environment. Both releases are " +
+			"rendered with the environment's release binding overrides and the resulting Kubernetes resources are " +
+			"compared, listing added, removed and changed resources with their changed fields.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"release_name":   stringProperty("Release to inspect. Use list_component_releases to discover valid names"),
+			"against_release": stringProperty(
+				"Release to compare against, typically the one currently deployed in the environment"),
+			"environment": stringProperty("Environment whose overrides are used for rendering both releases"),
+		}, []string{"org_name", "project_name", "component_name", "release_name", "against_release", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName        string `json:"org_name"`
+		ProjectName    string `json:"project_name"`
+		ComponentName  string `json:"component_name"`
+		ReleaseName    string `json:"release_name"`
+		AgainstRelease string `json:"against_release"`
+		Environment    string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ComponentToolset.DiffComponentReleases(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.ReleaseName, args.AgainstRelease, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterListReleaseBindings(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "list_release_bindings",
@@ -372,6 +401,36 @@ func (t *Toolsets) RegisterPromoteComponent(s *mcp.Server) {
 	})
 }
 
+func (t *Toolsets) RegisterRollbackReleaseBinding(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "rollback_release_binding",
+		Description: "Roll back a component in an environment to a previously deployed release. Without a " +
+			"release_name the environment is rebound to the release that was deployed before the current one. " +
+			"The release binding records the rollback in its release history and conditions.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Environment to roll back (e.g., 'production')"),
+			"release_name": stringProperty("Optional: the release to roll back to. " +
+				"Use list_release_bindings to see the release history of the environment"),
+		}, []string{"org_name", "project_name", "component_name", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		ReleaseName   string `json:"release_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		rollbackReq := &models.RollbackReleaseBindingRequest{
+			ReleaseName: args.ReleaseName,
+		}
+		result, err := t.ComponentToolset.RollbackReleaseBinding(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment, rollbackReq)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterCreateWorkload(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "create_workload",
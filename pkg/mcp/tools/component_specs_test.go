@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 const testReleaseName = "release-1"
@@ -228,6 +230,30 @@ func componentReleaseSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "diff_component_releases",
+			toolset:             "component",
+			descriptionKeywords: []string{"release", "environment"},
+			descriptionMinLen:   10,
+			requiredParams: []string{
+				"org_name", "project_name", "component_name", "release_name", "against_release", "environment",
+			},
+			testArgs: map[string]any{
+				"org_name":        testOrgName,
+				"project_name":    testProjectName,
+				"component_name":  testComponentName,
+				"release_name":    testReleaseName,
+				"against_release": "release-0",
+				"environment":     testEnvName,
+			},
+			expectedMethod: "DiffComponentReleases",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[3] != testReleaseName || args[4] != "release-0" || args[5] != testEnvName {
+					t.Errorf("Expected (%s, release-0, %s), got (%v, %v, %v)",
+						testReleaseName, testEnvName, args[3], args[4], args[5])
+				}
+			},
+		},
 		{
 			name:                "list_release_bindings",
 			toolset:             "component",
@@ -326,6 +352,34 @@ func componentBindingSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "rollback_release_binding",
+			toolset:             "component",
+			descriptionKeywords: []string{"roll back", "release"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+			optionalParams:      []string{"release_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"environment":    testEnvName,
+				"release_name":   testReleaseName,
+			},
+			expectedMethod: "RollbackReleaseBinding",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName ||
+					args[2] != testComponentName || args[3] != testEnvName {
+					t.Errorf("Expected (%s, %s, %s, %s), got (%v, %v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, testEnvName,
+						args[0], args[1], args[2], args[3])
+				}
+				req, ok := args[4].(*models.RollbackReleaseBindingRequest)
+				if !ok || req.ReleaseName != testReleaseName {
+					t.Errorf("Expected rollback request for release %s, got %v", testReleaseName, args[4])
+				}
+			},
+		},
 		{
 			name:                "create_workload",
 			toolset:             "component",
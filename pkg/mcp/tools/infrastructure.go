@@ -103,8 +103,10 @@ func (t *Toolsets) RegisterListDataPlanes(s *mcp.Server) {
 func (t *Toolsets) RegisterGetDataPlane(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "get_dataplane",
-		Description: "Get detailed information about a data plane including cluster details, capacity, health " +
-			"status, associated environments, and network configuration.",
+		Description: "Get detailed information about a data plane including cluster details, health status, " +
+			"network configuration, and the result of the latest cluster probe: Kubernetes server version, " +
+			"installed capabilities (Gateway API, Envoy Gateway, Cilium, External Secrets) and the " +
+			"ClusterReachable, CapabilitiesAvailable and GatewayResolvable conditions.",
 		InputSchema: createSchema(map[string]any{
 			"org_name": defaultStringProperty(),
 			"dp_name":  stringProperty("Use list_dataplanes to discover valid names"),
@@ -200,6 +200,13 @@ func (m *MockCoreToolsetHandler) GetComponentRelease(
 	return `{"name":"release-1"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) DiffComponentReleases(
+	ctx context.Context, orgName, projectName, componentName, releaseName, againstRelease, environment string,
+) (any, error) {
+	m.recordCall("DiffComponentReleases", orgName, projectName, componentName, releaseName, againstRelease, environment)
+	return `{"releaseName":"release-1","resources":[]}`, nil
+}
+
 func (m *MockCoreToolsetHandler) ListReleaseBindings(
 	ctx context.Context, orgName, projectName, componentName string, environments []string,
 ) (any, error) {
@@ -229,6 +236,14 @@ func (m *MockCoreToolsetHandler) PromoteComponent(
 	return `{"environment":"staging"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) RollbackReleaseBinding(
+	ctx context.Context, orgName, projectName, componentName, environmentName string,
+	req *models.RollbackReleaseBindingRequest,
+) (any, error) {
+	m.recordCall("RollbackReleaseBinding", orgName, projectName, componentName, environmentName, req)
+	return `{"environment":"production"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) CreateWorkload(
 	ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{},
 ) (any, error) {
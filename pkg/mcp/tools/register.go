@@ -36,12 +36,14 @@ func (t *Toolsets) componentToolRegistrations() []RegisterFunc {
 		t.RegisterListComponentReleases,
 		t.RegisterCreateComponentRelease,
 		t.RegisterGetComponentRelease,
+		t.RegisterDiffComponentReleases,
 		t.RegisterGetComponentSchema,
 		t.RegisterGetComponentReleaseSchema,
 		t.RegisterListReleaseBindings,
 		t.RegisterPatchReleaseBinding,
 		t.RegisterDeployRelease,
 		t.RegisterPromoteComponent,
+		t.RegisterRollbackReleaseBinding,
 		t.RegisterCreateWorkload,
 	}
 }
@@ -67,6 +67,9 @@ type ComponentToolsetHandler interface {
 	ListComponentReleases(ctx context.Context, orgName, projectName, componentName string) (any, error)
 	CreateComponentRelease(ctx context.Context, orgName, projectName, componentName, releaseName string) (any, error)
 	GetComponentRelease(ctx context.Context, orgName, projectName, componentName, releaseName string) (any, error)
+	DiffComponentReleases(
+		ctx context.Context, orgName, projectName, componentName, releaseName, againstRelease, environment string,
+	) (any, error)
 	// Release binding operations
 	ListReleaseBindings(
 		ctx context.Context, orgName, projectName, componentName string, environments []string,
@@ -82,6 +85,10 @@ type ComponentToolsetHandler interface {
 	PromoteComponent(
 		ctx context.Context, orgName, projectName, componentName string, req *models.PromoteComponentRequest,
 	) (any, error)
+	RollbackReleaseBinding(
+		ctx context.Context, orgName, projectName, componentName, environmentName string,
+		req *models.RollbackReleaseBindingRequest,
+	) (any, error)
 	// Workload operations
 	CreateWorkload(ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{}) (any, error)
 	// Schema operations